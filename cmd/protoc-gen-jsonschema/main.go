@@ -5,6 +5,9 @@
 // usage:
 //
 //	$ bin/protoc --jsonschema_out=path/to/outdir foo.proto
+//	$ bin/protoc-gen-jsonschema reverse -package foo path/to/outdir/*.json > foo.proto
+//	$ bin/protoc-gen-jsonschema selftest
+//	$ bin/protoc-gen-jsonschema --help
 package main
 
 import (
@@ -21,22 +24,43 @@ import (
 
 const version = "v1.4.0"
 
-func init() {
+func main() {
+
+	// Flags are parsed here rather than in init() (so that tests of this package can have flags of their own):
+	helpFlag := flag.Bool("help", false, "prints usage (including every generator parameter)")
 	versionFlag := flag.Bool("version", false, "prints current version")
+	flag.Usage = func() { printUsage(flag.CommandLine.Output()) }
 	flag.Parse()
+	if *helpFlag {
+		printUsage(os.Stdout)
+		os.Exit(0)
+	}
 	if *versionFlag {
 		fmt.Println(version)
 		os.Exit(0)
 	}
-}
-
-func main() {
 
-	// Make a Logrus logger (default to INFO):
+	// Make a Logrus logger (default to WARN, so that builds with lots of protos aren't flooded - see the "log_level" parameter):
 	logger := logrus.New()
-	logger.SetLevel(logrus.InfoLevel)
+	logger.SetLevel(logrus.WarnLevel)
 	logger.SetOutput(os.Stderr)
 
+	// Turn JSON-Schemas back into a proto skeleton (instead of handling a code generator request):
+	if flag.Arg(0) == reverseCommand {
+		os.Exit(runReverse(logger, flag.Args()[1:]))
+	}
+
+	// Check that this binary works (instead of handling a code generator request):
+	if flag.Arg(0) == selfTestCommand {
+		os.Exit(runSelfTest(logger))
+	}
+
+	// Run by hand, there's no code generator request to wait for (so explain how to use this instead):
+	if stdinIsTerminal() {
+		printUsage(os.Stderr)
+		os.Exit(2)
+	}
+
 	// Use the logger to make a Converter:
 	protoConverter := converter.New(logger)
 
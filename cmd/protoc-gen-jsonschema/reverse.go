@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/chrusty/protoc-gen-jsonschema/internal/reverse"
+)
+
+const reverseCommand = "reverse"
+
+// runReverse writes a best-effort .proto skeleton for some JSON-Schema files to STDOUT (returning an exit code):
+//
+//	$ protoc-gen-jsonschema reverse -package samples jsonschemas/*.json > skeleton.proto
+func runReverse(logger *logrus.Logger, args []string) int {
+	flags := flag.NewFlagSet(reverseCommand, flag.ContinueOnError)
+	pkgName := flags.String("package", "", "proto package of the skeleton")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		logger.Error("Usage: protoc-gen-jsonschema reverse [-package name] schema.json...")
+		return 2
+	}
+
+	skeleton := reverse.New(*pkgName)
+	for _, schemaFileName := range flags.Args() {
+		schemaJSON, err := ioutil.ReadFile(schemaFileName)
+		if err != nil {
+			logger.WithError(err).WithField("schema_file", schemaFileName).Error("Unable to read schema")
+			return 1
+		}
+
+		// Root schemas without a title are named after their files:
+		fallbackName := strings.TrimSuffix(filepath.Base(schemaFileName), filepath.Ext(schemaFileName))
+		if err := skeleton.AddSchema(fallbackName, schemaJSON); err != nil {
+			logger.WithError(err).WithField("schema_file", schemaFileName).Error("Unable to reverse schema")
+			return 1
+		}
+	}
+
+	if _, err := os.Stdout.Write(skeleton.Proto()); err != nil {
+		logger.WithError(err).Error("Failed to write skeleton")
+		return 1
+	}
+	return 0
+}
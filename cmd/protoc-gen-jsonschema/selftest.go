@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/chrusty/protoc-gen-jsonschema/internal/converter"
+)
+
+const selfTestCommand = "selftest"
+
+// selfTestRequest is a code generator request for a small sample proto, as protoc would send it:
+//
+//	syntax = "proto3";
+//	package selftest;
+//
+//	message Order {
+//	    string id = 1;
+//	    int32 quantity = 2;
+//	    repeated string tags = 3;
+//	    Status status = 4;
+//	}
+//
+//	enum Status {
+//	    STATUS_UNSPECIFIED = 0;
+//	    STATUS_PLACED = 1;
+//	}
+func selfTestRequest() *plugin.CodeGeneratorRequest {
+	field := func(name string, number int32, fieldType descriptor.FieldDescriptorProto_Type, label descriptor.FieldDescriptorProto_Label, jsonName string) *descriptor.FieldDescriptorProto {
+		return &descriptor.FieldDescriptorProto{Name: proto.String(name), Number: proto.Int32(number), Type: fieldType.Enum(), Label: label.Enum(), JsonName: proto.String(jsonName)}
+	}
+	status := field("status", 4, descriptor.FieldDescriptorProto_TYPE_ENUM, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, "status")
+	status.TypeName = proto.String(".selftest.Status")
+
+	return &plugin.CodeGeneratorRequest{
+		FileToGenerate: []string{"selftest/order.proto"},
+		ProtoFile: []*descriptor.FileDescriptorProto{
+			{
+				Name:    proto.String("selftest/order.proto"),
+				Package: proto.String("selftest"),
+				MessageType: []*descriptor.DescriptorProto{
+					{
+						Name: proto.String("Order"),
+						Field: []*descriptor.FieldDescriptorProto{
+							field("id", 1, descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, "id"),
+							field("quantity", 2, descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, "quantity"),
+							field("tags", 3, descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_LABEL_REPEATED, "tags"),
+							status,
+						},
+					},
+				},
+				EnumType: []*descriptor.EnumDescriptorProto{
+					{
+						Name: proto.String("Status"),
+						Value: []*descriptor.EnumValueDescriptorProto{
+							{Name: proto.String("STATUS_UNSPECIFIED"), Number: proto.Int32(0)},
+							{Name: proto.String("STATUS_PLACED"), Number: proto.Int32(1)},
+						},
+					},
+				},
+				Syntax: proto.String("proto3"),
+			},
+		},
+	}
+}
+
+// selfTestGoldens are the schemas we expect to generate from the sample:
+var selfTestGoldens = map[string]string{
+	"Order.json": `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "status": {
+                    "enum": [
+                        "STATUS_UNSPECIFIED",
+                        0,
+                        "STATUS_PLACED",
+                        1
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                },
+                "tags": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                }
+            },
+            "type": "object"
+        }
+    }
+}
+`,
+}
+
+// runSelfTest converts the sample (the same way as a request from protoc) and checks the schemas against the goldens,
+// to confirm that a binary behaves correctly where it has been installed (returning an exit code):
+//
+//	$ protoc-gen-jsonschema selftest
+func runSelfTest(logger *logrus.Logger) int {
+	logger.SetLevel(logrus.WarnLevel)
+
+	requestData, err := proto.Marshal(selfTestRequest())
+	if err != nil {
+		logger.WithError(err).Error("Unable to encode the sample request")
+		return 1
+	}
+	response, err := converter.New(logger).ConvertFrom(bytes.NewReader(requestData))
+	if err != nil {
+		logger.WithError(err).Error("Unable to convert the sample")
+		return 1
+	}
+
+	// Every golden should have been generated, exactly as we expect:
+	var failures int
+	generated := make(map[string]bool)
+	for _, file := range response.GetFile() {
+		generated[file.GetName()] = true
+		golden, ok := selfTestGoldens[file.GetName()]
+		if !ok {
+			logger.WithField("jsonschema_filename", file.GetName()).Error("Generated an unexpected schema")
+			failures++
+			continue
+		}
+		if file.GetContent() != golden {
+			logger.WithField("jsonschema_filename", file.GetName()).Error("Generated schema doesn't match")
+			fmt.Fprintf(os.Stderr, "expected:\n%s\ngenerated:\n%s\n", golden, file.GetContent())
+			failures++
+		}
+	}
+	for fileName := range selfTestGoldens {
+		if !generated[fileName] {
+			logger.WithField("jsonschema_filename", fileName).Error("Didn't generate an expected schema")
+			failures++
+		}
+	}
+
+	if failures > 0 {
+		fmt.Fprintf(os.Stderr, "selftest FAILED (%d problems)\n", failures)
+		return 1
+	}
+	fmt.Printf("selftest passed (%s)\n", version)
+	return 0
+}
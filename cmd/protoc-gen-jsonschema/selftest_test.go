@@ -0,0 +1,19 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelfTest(t *testing.T) {
+
+	// Make a Logrus logger:
+	logger := logrus.New()
+	logger.SetOutput(os.Stderr)
+
+	// The goldens have to keep up with the converter:
+	assert.Equal(t, 0, runSelfTest(logger))
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/chrusty/protoc-gen-jsonschema/internal/converter"
+)
+
+const usageText = `protoc-gen-jsonschema converts proto messages and enums into JSON-Schemas.
+
+As a protoc plugin, it reads a code generator request from STDIN (so it is run by protoc rather than by hand):
+
+	protoc --jsonschema_out=[<parameter>,...:]<output directory> [-I <include path>] <file.proto>...
+
+Standalone:
+
+	protoc-gen-jsonschema reverse [-package <name>] <schema.json>...	Write a .proto skeleton for JSON-Schemas to STDOUT
+	protoc-gen-jsonschema selftest	Check that this binary works
+	protoc-gen-jsonschema -version	Print the version
+	protoc-gen-jsonschema -help	Print this usage
+
+Parameters (for --jsonschema_out, separated by commas):
+
+`
+
+// printUsage describes the plugin and standalone modes, along with every generator parameter:
+func printUsage(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprint(tw, usageText)
+	for _, parameter := range converter.Parameters() {
+		fmt.Fprintf(tw, "\t%s\t%s\n", parameter.Name, parameter.Usage)
+	}
+	tw.Flush()
+}
+
+// stdinIsTerminal tells us whether we've been run by hand (in which case there is no code generator request coming).
+// The null device is a character device too, but reading from it doesn't hang:
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+	if devNull, err := os.Stat(os.DevNull); err == nil && os.SameFile(info, devNull) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintUsage(t *testing.T) {
+	var usage bytes.Buffer
+	printUsage(&usage)
+
+	// Both modes are described, along with the generator parameters (from the converter's flags and the rest):
+	assert.Contains(t, usage.String(), "protoc --jsonschema_out=")
+	assert.Contains(t, usage.String(), "protoc-gen-jsonschema reverse")
+	assert.Contains(t, usage.String(), "protoc-gen-jsonschema selftest")
+	assert.Contains(t, usage.String(), "  allow_null_values ")
+	assert.Contains(t, usage.String(), "  max_depth=<depth> ")
+}
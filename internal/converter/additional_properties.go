@@ -0,0 +1,55 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/alecthomas/jsonschema"
+)
+
+// additionalPropertiesKeyword is the extra we keep a schema's additionalProperties under (rather than marshaling them into
+// jsonschema.Type's raw field straight away, as a schema for map values may not be complete yet):
+const additionalPropertiesKeyword = "additionalProperties"
+
+// additionalProperties either allow (or disallow) properties which a schema doesn't declare, or constrain them with a
+// schema of their own (eg the values of a map). Every draft we generate accepts either a boolean or a schema here, so
+// they're marshaled the same way for all of them (along with the rest of the schema they belong to):
+type additionalProperties struct {
+	allowed bool
+	schema  *jsonschema.Type
+}
+
+// MarshalJSON renders additionalProperties as a boolean, or as their schema:
+func (a additionalProperties) MarshalJSON() ([]byte, error) {
+	if a.schema != nil {
+		return json.Marshal(a.schema)
+	}
+	return json.Marshal(a.allowed)
+}
+
+// setAdditionalProperties says whether a schema allows properties it doesn't declare:
+func setAdditionalProperties(jsonSchemaType *jsonschema.Type, allowed bool) {
+	setExtras(jsonSchemaType, map[string]interface{}{additionalPropertiesKeyword: additionalProperties{allowed: allowed}})
+}
+
+// setAdditionalPropertiesSchema constrains the properties a schema doesn't declare (eg the values of a map):
+func setAdditionalPropertiesSchema(jsonSchemaType, additionalPropertiesJSONSchemaType *jsonschema.Type) {
+	setExtras(jsonSchemaType, map[string]interface{}{additionalPropertiesKeyword: additionalProperties{schema: additionalPropertiesJSONSchemaType}})
+}
+
+// clearAdditionalProperties leaves additionalProperties out of a schema (eg for types which aren't objects):
+func clearAdditionalProperties(jsonSchemaType *jsonschema.Type) {
+	delete(jsonSchemaType.Extras, additionalPropertiesKeyword)
+	jsonSchemaType.AdditionalProperties = nil
+}
+
+// disallowsAdditionalProperties tells us whether a schema rejects properties it doesn't declare:
+func disallowsAdditionalProperties(jsonSchemaType *jsonschema.Type) bool {
+	value, ok := jsonSchemaType.Extras[additionalPropertiesKeyword].(additionalProperties)
+	return ok && value.schema == nil && !value.allowed
+}
+
+// additionalPropertiesSchema returns the schema which constrains the properties a schema doesn't declare (if it has one):
+func additionalPropertiesSchema(jsonSchemaType *jsonschema.Type) *jsonschema.Type {
+	value, _ := jsonSchemaType.Extras[additionalPropertiesKeyword].(additionalProperties)
+	return value.schema
+}
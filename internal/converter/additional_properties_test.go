@@ -0,0 +1,44 @@
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdditionalProperties(t *testing.T) {
+
+	// A map of objects (which don't allow additional properties themselves):
+	valueJSONSchemaType := &jsonschema.Type{Type: "object"}
+	mapJSONSchemaType := &jsonschema.Type{Type: "object"}
+	setAdditionalPropertiesSchema(mapJSONSchemaType, valueJSONSchemaType)
+	setAdditionalProperties(valueJSONSchemaType, false)
+
+	rootJSONSchemaType := &jsonschema.Type{Type: "object", Properties: orderedmap.New()}
+	rootJSONSchemaType.Properties.Set("labels", mapJSONSchemaType)
+	setAdditionalProperties(rootJSONSchemaType, true)
+	assert.False(t, disallowsAdditionalProperties(rootJSONSchemaType))
+	assert.False(t, disallowsAdditionalProperties(mapJSONSchemaType))
+	assert.True(t, disallowsAdditionalProperties(valueJSONSchemaType))
+	assert.Equal(t, valueJSONSchemaType, additionalPropertiesSchema(mapJSONSchemaType))
+	assert.Nil(t, additionalPropertiesSchema(rootJSONSchemaType))
+
+	// They're marshaled along with their schemas (including changes made to map values after they were set):
+	schemaJSON, err := json.Marshal(rootJSONSchemaType)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "object",
+		"properties": {"labels": {"type": "object", "additionalProperties": {"type": "object", "additionalProperties": false}}},
+		"additionalProperties": true
+	}`, string(schemaJSON))
+
+	// Clearing them removes them altogether:
+	clearAdditionalProperties(rootJSONSchemaType)
+	schemaJSON, err = json.Marshal(rootJSONSchemaType)
+	require.NoError(t, err)
+	assert.NotContains(t, string(schemaJSON), `"additionalProperties":true`)
+}
@@ -0,0 +1,87 @@
+package converter
+
+import (
+	"github.com/alecthomas/jsonschema"
+	"github.com/xeipuuv/gojsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	fieldMaskFormat      = "google-fieldmask"
+	fieldMaskDescription = "A comma-separated list of fully qualified field names (eg \"title,author.name\")."
+	fieldMaskTypeName    = ".google.protobuf.FieldMask"
+	pageSizeFieldName    = "page_size"
+	pageSizeDescription  = "The maximum number of results to return (the service may return fewer than this, and chooses a default when unset)."
+	pageTokenFieldName   = "page_token"
+	pageTokenDescription = "A page token received from a previous list call (provide this to retrieve the subsequent page)."
+)
+
+// applyAIPConventions annotates the standard fields described by Google's API Improvement Proposals
+// (pagination in AIP-158, partial responses and updates in AIP-157/AIP-134) with canonical descriptions, bounds and formats:
+func (c *Converter) applyAIPConventions(fieldDesc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type, messageFlags ConverterFlags) *jsonschema.Type {
+
+	// Repeated fields are never one of the standard fields:
+	if fieldDesc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return jsonSchemaType
+	}
+
+	switch {
+
+	// Field masks are represented in JSON as a single comma-separated string:
+	case fieldDesc.GetTypeName() == fieldMaskTypeName:
+		fieldMaskJSONSchemaType := &jsonschema.Type{
+			Title:       jsonSchemaType.Title,
+			Description: jsonSchemaType.Description,
+		}
+		if messageFlags.AllowNullValues {
+			fieldMaskJSONSchemaType.OneOf = []*jsonschema.Type{
+				{Type: gojsonschema.TYPE_NULL},
+				{Type: gojsonschema.TYPE_STRING, Format: fieldMaskFormat},
+			}
+		} else {
+			fieldMaskJSONSchemaType.Type = gojsonschema.TYPE_STRING
+			fieldMaskJSONSchemaType.Format = fieldMaskFormat
+		}
+		setDefaultDescription(fieldMaskJSONSchemaType, fieldMaskDescription)
+		setExtras(fieldMaskJSONSchemaType, jsonSchemaType.Extras)
+		return fieldMaskJSONSchemaType
+
+	// Page sizes can't be negative:
+	case isIntegerField(fieldDesc) && fieldDesc.GetName() == pageSizeFieldName:
+		setDefaultDescription(jsonSchemaType, pageSizeDescription)
+		setExtras(jsonSchemaType, map[string]interface{}{"minimum": 0})
+
+	// Page tokens are opaque strings:
+	case fieldDesc.GetType() == descriptor.FieldDescriptorProto_TYPE_STRING && fieldDesc.GetName() == pageTokenFieldName:
+		setDefaultDescription(jsonSchemaType, pageTokenDescription)
+	case fieldDesc.GetType() == descriptor.FieldDescriptorProto_TYPE_STRING && fieldDesc.GetName() == nextPageTokenFieldName:
+		setDefaultDescription(jsonSchemaType, nextPageTokenDescription)
+	}
+
+	return jsonSchemaType
+}
+
+// setDefaultDescription sets a description, unless one has already been taken from comments:
+func setDefaultDescription(jsonSchemaType *jsonschema.Type, description string) {
+	if jsonSchemaType.Description == "" {
+		jsonSchemaType.Description = description
+	}
+}
+
+// isIntegerField tells us whether a field holds a (32-bit or 64-bit) integer:
+func isIntegerField(fieldDesc *descriptor.FieldDescriptorProto) bool {
+	switch fieldDesc.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return true
+	}
+	return false
+}
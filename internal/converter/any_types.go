@@ -0,0 +1,119 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+const (
+	anyTypeName      = ".google.protobuf.Any"
+	anyTypeProperty  = "@type"
+	anyTypeURLPrefix = "type.googleapis.com/"
+
+	// Suffixes the names of copies of payloads' definitions which declare "@type":
+	typedPayloadSuffix = "@type"
+)
+
+// anyFieldTypes returns the payload types an Any field has been restricted to (as proto type names, eg ".acme.Dog"):
+func anyFieldTypes(desc *descriptor.FieldDescriptorProto) []string {
+	if desc.GetTypeName() != anyTypeName {
+		return nil
+	}
+
+	opt := proto.GetExtension(desc.GetOptions(), protoc_gen_jsonschema.E_FieldOptions)
+	fieldOptions, ok := opt.(*protoc_gen_jsonschema.FieldOptions)
+	if !ok {
+		return nil
+	}
+
+	// Types can be given as names ("acme.Dog") or as full type URLs ("type.googleapis.com/acme.Dog"):
+	var typeNames []string
+	for _, anyType := range fieldOptions.GetAnyTypes() {
+		typeName := anyType[strings.LastIndex(anyType, "/")+1:]
+		typeNames = append(typeNames, "."+strings.TrimPrefix(typeName, "."))
+	}
+	return typeNames
+}
+
+// convertAnyTypes builds a list of alternatives for an Any field, one for each permitted payload type:
+// the JSON mapping of Any inlines the payload's fields alongside an "@type" URL, which picks the alternative.
+// Each alternative refers to the payload's definition (so that payloads which can hold themselves don't nest forever)
+// and requires its "@type". Payloads which don't allow additional properties wouldn't accept "@type" though, so those
+// refer to a copy of their definition which declares it (see addTypedPayloadDefinitions):
+func (c *Converter) convertAnyTypes(curPkg *ProtoPackage, desc *descriptor.FieldDescriptorProto, duplicatedMessages map[*descriptor.DescriptorProto]string) ([]*jsonschema.Type, error) {
+	var alternatives []*jsonschema.Type
+
+	for _, typeName := range anyFieldTypes(desc) {
+		recordType, pkgName, ok := c.lookupType(curPkg, typeName)
+		if !ok {
+			c.logger.WithField("field_name", desc.GetName()).WithField("any_type", typeName).Warn("Unable to find Any payload type")
+			continue
+		}
+
+		typeURLJSONSchemaType := &jsonschema.Type{Type: gojsonschema.TYPE_STRING}
+		setExtras(typeURLJSONSchemaType, map[string]interface{}{"const": anyTypeURLPrefix + strings.TrimPrefix(typeName, ".")})
+		c.schemaVersion = versionDraft06 // Const requires draft-06
+
+		typeURLProperties := orderedmap.New()
+		typeURLProperties.Set(anyTypeProperty, typeURLJSONSchemaType)
+		typeURL := &jsonschema.Type{Properties: typeURLProperties, Required: []string{anyTypeProperty}}
+
+		// Payloads without definitions of their own (eg well-known types) are converted in place:
+		definitionName, ok := duplicatedMessages[recordType]
+		if !ok {
+			payloadJSONSchemaType, err := c.recursiveConvertMessageType(curPkg, recordType, pkgName, duplicatedMessages, true)
+			if err != nil {
+				return nil, err
+			}
+			alternatives = append(alternatives, &jsonschema.Type{AllOf: []*jsonschema.Type{payloadJSONSchemaType, typeURL}})
+			continue
+		}
+
+		payloadRef := c.definitionRef(definitionName)
+		if c.messageFlags(recordType).DisallowAdditionalProperties {
+			payloadRef += typedPayloadSuffix
+		}
+		alternatives = append(alternatives, &jsonschema.Type{AllOf: []*jsonschema.Type{{Ref: payloadRef}, typeURL}})
+	}
+
+	return alternatives, nil
+}
+
+// typedPayloadDefinition copies the definition of an Any payload which doesn't allow additional properties, declaring
+// "@type" too (so that it can be used alongside the payload's fields):
+func typedPayloadDefinition(definition *jsonschema.Type) *jsonschema.Type {
+	typedDefinition := copyType(definition)
+	if typedDefinition.Properties == nil {
+		typedDefinition.Properties = orderedmap.New()
+	}
+	typedDefinition.Properties.Set(anyTypeProperty, &jsonschema.Type{Type: gojsonschema.TYPE_STRING})
+	return typedDefinition
+}
+
+// addTypedPayloadDefinitions adds the copies of Any payloads' definitions which a schema refers to (declaring "@type").
+// These are made once everything has been converted, because definitions (even cached ones) only refer to them:
+func (c *Converter) addTypedPayloadDefinitions(definitions jsonschema.Definitions, jsonSchemaTypes ...*jsonschema.Type) {
+	refs := make(map[string]bool)
+	collectRefs(map[string]*jsonschema.Type(definitions), refs)
+	collectRefs(jsonSchemaTypes, refs)
+
+	for ref := range refs {
+		if !strings.HasPrefix(ref, c.refPrefix) || !strings.HasSuffix(ref, typedPayloadSuffix) {
+			continue
+		}
+		typedName := strings.TrimPrefix(ref, c.refPrefix)
+		if _, ok := definitions[typedName]; ok {
+			continue
+		}
+		if definition, ok := definitions[strings.TrimSuffix(typedName, typedPayloadSuffix)]; ok {
+			definitions[typedName] = typedPayloadDefinition(definition)
+		}
+	}
+}
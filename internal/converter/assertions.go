@@ -0,0 +1,211 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/iancoleman/orderedmap"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+// messageAssertions returns the assertions a message makes about its schema (with the assert_* message options), or nil
+// if it doesn't make any:
+func messageAssertions(msgDesc *descriptor.DescriptorProto) *protoc_gen_jsonschema.MessageOptions {
+	if opt := proto.GetExtension(msgDesc.GetOptions(), protoc_gen_jsonschema.E_MessageOptions); opt != nil {
+		if messageOptions, ok := opt.(*protoc_gen_jsonschema.MessageOptions); ok {
+			if messageOptions.GetAssertMaxSchemaDepth() > 0 || messageOptions.GetAssertNoAdditionalProperties() || messageOptions.GetAssertAllFieldsDocumented() {
+				return messageOptions
+			}
+		}
+	}
+	return nil
+}
+
+// assertSchema checks the schema generated for a message (as it is rendered, after any conversion or post-processing)
+// against the assertions it makes about it, returning an error describing each one it fails (so that authors find out
+// when they generate schemas, rather than from their consumers):
+func (c *Converter) assertSchema(msgDesc *descriptor.DescriptorProto, jsonSchemaJSON []byte) error {
+	assertions := messageAssertions(msgDesc)
+	if assertions == nil {
+		return nil
+	}
+
+	decoded, err := decodeOrderedJSON(json.NewDecoder(bytes.NewReader(jsonSchemaJSON)))
+	if err != nil {
+		return err
+	}
+	root, ok := decoded.(*orderedmap.OrderedMap)
+	if !ok {
+		return nil
+	}
+
+	var failures []lintWarning
+
+	// The assertions can only be checked against what's in the document, so references which leave it fail:
+	externalRefs := map[string]bool{}
+	walkSchema(root, func(schema *orderedmap.OrderedMap) {
+		if ref, ok := schema.Get("$ref"); ok {
+			if ref, _ := ref.(string); !externalRefs[ref] {
+				if target, _ := followAssertionRef(root, schema, ""); target == schema {
+					externalRefs[ref] = true
+					failures = append(failures, lintWarning{"#", fmt.Sprintf("Schema refers to %q outside of itself, so its assertions can't be checked", ref)})
+				}
+			}
+		}
+	})
+
+	// Objects can only be nested so deep (and not at all recursively):
+	if maxDepth := int(assertions.GetAssertMaxSchemaDepth()); maxDepth > 0 {
+		depth, recursive := assertionDepth(root, root, map[string]bool{})
+		switch {
+		case recursive:
+			failures = append(failures, lintWarning{"#", fmt.Sprintf("Schema nests objects recursively (assert_max_schema_depth is %d)", maxDepth)})
+		case depth > maxDepth:
+			failures = append(failures, lintWarning{"#", fmt.Sprintf("Schema nests objects %d levels deep (assert_max_schema_depth is %d)", depth, maxDepth)})
+		}
+	}
+
+	// Objects can't accept properties they don't declare:
+	if assertions.GetAssertNoAdditionalProperties() {
+		assertNoAdditionalProperties(root, root, "#", map[string]bool{}, &failures)
+	}
+
+	// Fields must be described (by comments or options), or refer to messages which are:
+	if assertions.GetAssertAllFieldsDocumented() {
+		schema, path := followAssertionRef(root, root, "#")
+		if properties, ok := schema.Get("properties"); ok {
+			if properties, ok := properties.(*orderedmap.OrderedMap); ok {
+				for _, name := range properties.Keys() {
+					property, _ := properties.Get(name)
+					if property, ok := property.(*orderedmap.OrderedMap); ok && !isDocumented(root, property) {
+						failures = append(failures, lintWarning{path + "/properties/" + name, "Field is undocumented (assert_all_fields_documented)"})
+					}
+				}
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		var messages []string
+		for _, failure := range failures {
+			messages = append(messages, fmt.Sprintf("%s: %s", failure.path, failure.message))
+		}
+		return fmt.Errorf("%s failed %d schema assertion(s): %s", msgDesc.GetName(), len(failures), strings.Join(messages, "; "))
+	}
+
+	return nil
+}
+
+// assertionDepth returns how many levels of objects a schema nests (following references within the document), and
+// whether it nests them recursively:
+func assertionDepth(root, schema *orderedmap.OrderedMap, following map[string]bool) (int, bool) {
+	if target, ref := followAssertionRef(root, schema, ""); ref != "" {
+		if following[ref] {
+			return 0, true
+		}
+		following[ref] = true
+		defer delete(following, ref)
+		return assertionDepth(root, target, following)
+	}
+
+	var depth int
+	var recursive bool
+	assertionSubschemas(schema, "", func(subschema *orderedmap.OrderedMap, _ string) {
+		subschemaDepth, subschemaRecursive := assertionDepth(root, subschema, following)
+		if subschemaDepth > depth {
+			depth = subschemaDepth
+		}
+		recursive = recursive || subschemaRecursive
+	})
+	if _, ok := schema.Get("properties"); ok {
+		depth++
+	}
+	return depth, recursive
+}
+
+// assertNoAdditionalProperties records every object in a schema (following references within the document) which
+// accepts additional properties. Maps (whose additionalProperties are the schema of their values) don't:
+func assertNoAdditionalProperties(root, schema *orderedmap.OrderedMap, path string, visited map[string]bool, failures *[]lintWarning) {
+	if target, ref := followAssertionRef(root, schema, ""); ref != "" {
+		if !visited[ref] {
+			visited[ref] = true
+			assertNoAdditionalProperties(root, target, ref, visited, failures)
+		}
+		return
+	}
+
+	_, hasProperties := schema.Get("properties")
+	if hasProperties || schemaType(schema) == "object" {
+		if additionalProperties, ok := schema.Get("additionalProperties"); !ok || additionalProperties == true {
+			*failures = append(*failures, lintWarning{path, "Object allows additional properties (assert_no_additional_properties)"})
+		}
+	}
+
+	assertionSubschemas(schema, path, func(subschema *orderedmap.OrderedMap, subschemaPath string) {
+		assertNoAdditionalProperties(root, subschema, subschemaPath, visited, failures)
+	})
+}
+
+// assertionSubschemas visits the subschemas of a schema which describe (parts of) instances, along with their paths.
+// Definitions are left to the references which use them:
+func assertionSubschemas(schema *orderedmap.OrderedMap, path string, visit func(subschema *orderedmap.OrderedMap, path string)) {
+	for _, keyword := range subschemaKeywords {
+		value, _ := schema.Get(keyword)
+		switch value := value.(type) {
+		case *orderedmap.OrderedMap:
+			visit(value, path+"/"+keyword)
+		case []interface{}:
+			for index, item := range value {
+				if item, ok := item.(*orderedmap.OrderedMap); ok {
+					visit(item, fmt.Sprintf("%s/%s/%d", path, keyword, index))
+				}
+			}
+		}
+	}
+	for _, keyword := range subschemaMapKeywords {
+		if keyword == "$defs" || keyword == "definitions" {
+			continue
+		}
+		if subschemas, ok := schema.Get(keyword); ok {
+			if subschemas, ok := subschemas.(*orderedmap.OrderedMap); ok {
+				for _, name := range subschemas.Keys() {
+					if subschema, _ := subschemas.Get(name); subschema != nil {
+						if subschema, ok := subschema.(*orderedmap.OrderedMap); ok {
+							visit(subschema, path+"/"+keyword+"/"+name)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// followAssertionRef returns the schema a reference within the document refers to (and its path), or the schema itself
+// if it isn't one:
+func followAssertionRef(root, schema *orderedmap.OrderedMap, path string) (*orderedmap.OrderedMap, string) {
+	if ref, ok := schema.Get("$ref"); ok {
+		ref, _ := ref.(string)
+		if target := resolveSchemaRef(root, ref); strings.HasPrefix(ref, "#/") && target != nil {
+			return target, ref
+		}
+	}
+	return schema, path
+}
+
+// isDocumented tells us whether a property has a description (or proto comments), or refers to a definition which does:
+func isDocumented(root, property *orderedmap.OrderedMap) bool {
+	definition, _ := followAssertionRef(root, property, "")
+	for _, schema := range []*orderedmap.OrderedMap{property, definition} {
+		for _, keyword := range []string{"description", protoCommentKeyword} {
+			if description, _ := schema.Get(keyword); description != nil && description != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+func TestSchemaAssertions(t *testing.T) {
+
+	// Convert a file with these assertions made by one of its messages:
+	convert := func(protoFileName, messageName string, parameters string, assertions *protoc_gen_jsonschema.MessageOptions) error {
+		request := sampleRequest(t, parameters, protoFileName)
+		for _, file := range request.GetProtoFile() {
+			for _, msgDesc := range file.GetMessageType() {
+				if file.GetName() == protoFileName && msgDesc.GetName() == messageName {
+					if msgDesc.Options == nil {
+						msgDesc.Options = &descriptor.MessageOptions{}
+					}
+					messageOptions := &protoc_gen_jsonschema.MessageOptions{}
+					if existing, ok := proto.GetExtension(msgDesc.Options, protoc_gen_jsonschema.E_MessageOptions).(*protoc_gen_jsonschema.MessageOptions); ok && existing != nil {
+						messageOptions = proto.Clone(existing).(*protoc_gen_jsonschema.MessageOptions)
+					}
+					proto.Merge(messageOptions, assertions)
+					proto.SetExtension(msgDesc.Options, protoc_gen_jsonschema.E_MessageOptions, messageOptions)
+				}
+			}
+		}
+		_, err := convertTestRequest(request)
+		return err
+	}
+
+	// Objects can only be nested so deep (and not recursively at all):
+	assert.NoError(t, convert("OptionSchemaAssertions.proto", "OptionSchemaAssertions", "", &protoc_gen_jsonschema.MessageOptions{}))
+	err := convert("OptionSchemaAssertions.proto", "OptionSchemaAssertions", "", &protoc_gen_jsonschema.MessageOptions{AssertMaxSchemaDepth: 1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "OptionSchemaAssertions failed 1 schema assertion(s): #: Schema nests objects 2 levels deep (assert_max_schema_depth is 1)")
+	err = convert("RecursiveMaps.proto", "Node", "", &protoc_gen_jsonschema.MessageOptions{AssertMaxSchemaDepth: 10})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Schema nests objects recursively")
+
+	// Objects (other than maps) can't allow additional properties, wherever they are:
+	err = convert("NestedMessage.proto", "NestedMessage", "", &protoc_gen_jsonschema.MessageOptions{AssertNoAdditionalProperties: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "#/definitions/NestedMessage: Object allows additional properties")
+	assert.Contains(t, err.Error(), "#/definitions/samples.PayloadMessage: Object allows additional properties")
+	assert.NoError(t, convert("NestedMessage.proto", "NestedMessage", "disallow_additional_properties", &protoc_gen_jsonschema.MessageOptions{AssertNoAdditionalProperties: true}))
+
+	// The assertions are checked against the schema as it is rendered (post-processing and all):
+	err = convert("NestedMessage.proto", "NestedMessage", "disallow_additional_properties,post_process_cmd=sed s/false/true/", &protoc_gen_jsonschema.MessageOptions{AssertNoAdditionalProperties: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "#/definitions/NestedMessage: Object allows additional properties")
+
+	// Which can't refer to anything outside of itself (because the assertions couldn't be checked there):
+	err = convert("NestedMessage.proto", "NestedMessage", "disallow_additional_properties,shared_messages=samples.PayloadMessage", &protoc_gen_jsonschema.MessageOptions{AssertNoAdditionalProperties: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `#: Schema refers to "common.json#/definitions/samples.PayloadMessage" outside of itself`)
+
+	// Fields have to be described (or refer to messages which are):
+	err = convert("PayloadMessage.proto", "PayloadMessage", "", &protoc_gen_jsonschema.MessageOptions{AssertAllFieldsDocumented: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "#/definitions/PayloadMessage/properties/name: Field is undocumented (assert_all_fields_documented)")
+	assert.NoError(t, convert("OptionFieldDocs.proto", "OptionFieldDocs", "", &protoc_gen_jsonschema.MessageOptions{AssertAllFieldsDocumented: true}))
+}
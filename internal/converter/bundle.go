@@ -0,0 +1,149 @@
+package converter
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	bundleFile    = "file"
+	bundlePackage = "package"
+)
+
+// schemaBundle is a single schema carrying every message of a proto file (or package) as a definition:
+type schemaBundle struct {
+	file          *descriptor.FileDescriptorProto // The (first) file the bundle came from
+	fileExtension string
+	messageNames  []string // Fully-qualified names of the bundled messages
+	name          string
+	rootMessage   string
+	schema        *jsonschema.Schema
+}
+
+// convertBundle converts a file's messages into a bundle, which is generated straight away when bundling by file
+// (bundles of packages are generated once every file has been converted):
+func (c *Converter) convertBundle(pkg *ProtoPackage, file *descriptor.FileDescriptorProto, fileExtension, rootMessage string) ([]*plugin.CodeGeneratorResponse_File, error) {
+	bundleJSONSchema, messageNames, err := c.bundleMessages(pkg, file)
+	if err != nil || bundleJSONSchema == nil {
+		return nil, err
+	}
+
+	// Add the messages to their package's bundle:
+	if c.bundle == bundlePackage {
+		c.addToPackageBundle(file, fileExtension, rootMessage, messageNames, bundleJSONSchema)
+		return nil, nil
+	}
+
+	resFile, err := c.generateBundle(&schemaBundle{
+		file:          file,
+		fileExtension: fileExtension,
+		messageNames:  messageNames,
+		name:          strings.TrimSuffix(path.Base(file.GetName()), ".proto"),
+		rootMessage:   rootMessage,
+		schema:        bundleJSONSchema,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []*plugin.CodeGeneratorResponse_File{resFile}, nil
+}
+
+// bundleMessages converts the first message of a file, with the rest of the file's messages added to its definitions:
+func (c *Converter) bundleMessages(pkg *ProtoPackage, file *descriptor.FileDescriptorProto) (*jsonschema.Schema, []string, error) {
+	var bundleJSONSchema *jsonschema.Schema
+	var messageNames []string
+	for _, msgDesc := range file.GetMessageType() {
+
+		// "Ignored" messages are left out:
+		if opt := proto.GetExtension(msgDesc.GetOptions(), protoc_gen_jsonschema.E_MessageOptions); opt != nil {
+			if messageOptions, ok := opt.(*protoc_gen_jsonschema.MessageOptions); ok && messageOptions.GetIgnore() {
+				continue
+			}
+		}
+
+		// So are alpha (or restricted) messages:
+		if c.excludedMessage(msgDesc) {
+			continue
+		}
+
+		messageNames = append(messageNames, qualifiedName(file.GetPackage(), msgDesc.GetName()))
+		c.reportMessage(qualifiedName(file.GetPackage(), msgDesc.GetName()))
+		if bundleJSONSchema != nil {
+			continue
+		}
+
+		messageJSONSchema, err := c.convertMessageType(pkg, msgDesc)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := c.addFileDefinitions(pkg, file, msgDesc, messageJSONSchema); err != nil {
+			return nil, nil, err
+		}
+		bundleJSONSchema = messageJSONSchema
+	}
+
+	return bundleJSONSchema, messageNames, nil
+}
+
+// addToPackageBundle merges a file's bundle into the bundle for its package:
+func (c *Converter) addToPackageBundle(file *descriptor.FileDescriptorProto, fileExtension, rootMessage string, messageNames []string, bundleJSONSchema *jsonschema.Schema) {
+	for _, bundle := range c.packageBundles {
+		if bundle.file.GetPackage() != file.GetPackage() {
+			continue
+		}
+		for name, definition := range bundleJSONSchema.Definitions {
+			if _, ok := bundle.schema.Definitions[name]; !ok {
+				bundle.schema.Definitions[name] = definition
+			}
+		}
+		bundle.messageNames = append(bundle.messageNames, messageNames...)
+		if rootMessage != "" {
+			bundle.rootMessage = rootMessage
+		}
+		return
+	}
+
+	// Files without a package are bundled together under the name of the first of them:
+	name := file.GetPackage()
+	if name == "" {
+		name = strings.TrimSuffix(path.Base(file.GetName()), ".proto")
+	}
+	c.packageBundles = append(c.packageBundles, &schemaBundle{
+		file:          file,
+		fileExtension: fileExtension,
+		messageNames:  messageNames,
+		name:          name,
+		rootMessage:   rootMessage,
+		schema:        bundleJSONSchema,
+	})
+}
+
+// generateBundle generates the response file for a bundle, whose root is a $ref to the root message (if it has one):
+func (c *Converter) generateBundle(bundle *schemaBundle) (*plugin.CodeGeneratorResponse_File, error) {
+	bundle.schema.Type = &jsonschema.Type{Version: c.schemaVersion}
+	if bundle.rootMessage != "" {
+		bundle.schema.Type.Ref = fmt.Sprintf("%s%s", c.refPrefix, bundle.rootMessage)
+	}
+
+	jsonSchemaFileName := c.generateSchemaFilename(bundle.file, bundle.fileExtension, bundle.name)
+	c.logger.WithField("bundle", bundle.name).WithField("messages", len(bundle.messageNames)).WithField("jsonschema_filename", jsonSchemaFileName).Info("Generating JSON-schema bundle")
+
+	c.stampDeprecated(bundle.file, false, bundle.schema.Type)
+	c.embedPackageVersion(bundle.file.GetPackage(), jsonSchemaFileName, bundle.schema.Type, bundle.schema.Definitions)
+	c.applySchemaTemplates(bundle.file.GetPackage(), bundle.name, jsonSchemaFileName, bundle.schema.Type, bundle.schema.Definitions)
+	resFile, err := c.generateResponseFile(jsonSchemaFileName, bundle.schema)
+	if err != nil {
+		return nil, err
+	}
+	for _, messageName := range bundle.messageNames {
+		c.schemaIndex[messageName] = jsonSchemaFileName
+	}
+	return resFile, nil
+}
@@ -0,0 +1,17 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundleParameter(t *testing.T) {
+
+	// Invalid modes are ignored (see the Bundle* samples for those which work):
+	protoConverter := New(newTestLogger())
+	protoConverter.parseGeneratorParameters("bundle=everything")
+	assert.Equal(t, "", protoConverter.bundle)
+	protoConverter.parseGeneratorParameters("bundle=package")
+	assert.Equal(t, bundlePackage, protoConverter.bundle)
+}
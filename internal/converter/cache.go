@@ -0,0 +1,176 @@
+package converter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+// generationCache maps proto filenames to the outputs previously generated for them (keyed by a digest of their descriptors):
+type generationCache struct {
+	Files map[string]*generationCacheEntry `json:"files"`
+}
+
+type generationCacheEntry struct {
+	Digest  string                `json:"digest"`
+	Index   map[string]string     `json:"index,omitempty"`
+	Outputs []generationCacheFile `json:"outputs"`
+}
+
+type generationCacheFile struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// loadGenerationCache reads a cache file (a missing file simply gives an empty cache):
+func loadGenerationCache(fileName string) (*generationCache, error) {
+	cache := &generationCache{Files: make(map[string]*generationCacheEntry)}
+
+	cacheJSON, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(cacheJSON, cache); err != nil {
+		return nil, err
+	}
+	if cache.Files == nil {
+		cache.Files = make(map[string]*generationCacheEntry)
+	}
+
+	return cache, nil
+}
+
+// save writes the cache back to disk:
+func (g *generationCache) save(fileName string) error {
+	cacheJSON, err := json.Marshal(g)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomically(fileName, cacheJSON)
+}
+
+// writeFileAtomically writes to a temporary file first (which then replaces the file), so an interrupted run can never
+// leave a half-written file behind:
+func writeFileAtomically(fileName string, content []byte) error {
+	tempFile, err := ioutil.TempFile(filepath.Dir(fileName), filepath.Base(fileName)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name()) // Fails harmlessly once it has been renamed
+
+	if _, err := tempFile.Write(content); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err := tempFile.Sync(); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tempFile.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tempFile.Name(), fileName)
+}
+
+// lookup returns previously generated outputs for a proto file (if its digest hasn't changed):
+func (g *generationCache) lookup(protoFileName, digest string) (*generationCacheEntry, bool) {
+	entry, ok := g.Files[protoFileName]
+	if !ok || entry.Digest != digest {
+		return nil, false
+	}
+	return entry, true
+}
+
+// store records the outputs generated for a proto file:
+func (g *generationCache) store(protoFileName, digest string, index map[string]string, outputs []*plugin.CodeGeneratorResponse_File) {
+	entry := &generationCacheEntry{
+		Digest: digest,
+		Index:  make(map[string]string),
+	}
+
+	outputNames := make(map[string]bool)
+	for _, output := range outputs {
+		outputNames[output.GetName()] = true
+		entry.Outputs = append(entry.Outputs, generationCacheFile{Name: output.GetName(), Content: output.GetContent()})
+	}
+
+	// Only keep the index entries which belong to this file's outputs:
+	for fullName, schemaFileName := range index {
+		if outputNames[schemaFileName] {
+			entry.Index[fullName] = schemaFileName
+		}
+	}
+
+	g.Files[protoFileName] = entry
+}
+
+// responseFiles turns cached outputs back into generator response files:
+func (e *generationCacheEntry) responseFiles() []*plugin.CodeGeneratorResponse_File {
+	var files []*plugin.CodeGeneratorResponse_File
+	for _, output := range e.Outputs {
+		files = append(files, &plugin.CodeGeneratorResponse_File{
+			Name:    proto.String(output.Name),
+			Content: proto.String(output.Content),
+		})
+	}
+	return files
+}
+
+// cacheSettings describes everything (besides the proto files) which goes into the generated schemas, so that cached
+// ones go stale when any of it changes: the parameters (including those given to NewWithOptions), the flags they end up
+// setting, the source revision schemas get stamped with, and the contents of the config and rules files:
+func (c *Converter) cacheSettings(parameters string) (string, error) {
+	settings := []string{c.defaultParameters, parameters, fmt.Sprintf("%+v", c.Flags), c.sourceRevision}
+	for _, fileName := range []string{c.configFileName, c.rulesFileName} {
+		var contents []byte
+		if fileName != "" {
+			var err error
+			if contents, err = ioutil.ReadFile(fileName); err != nil {
+				return "", err
+			}
+		}
+		settings = append(settings, string(contents))
+	}
+	return strings.Join(settings, "\x00"), nil
+}
+
+// digestFileDescriptors returns a digest for each proto file, covering its own descriptor, the settings it was generated
+// with (see cacheSettings), and the digests of everything it imports (because imported types end up in the generated
+// schemas too):
+func digestFileDescriptors(settings string, fileDescs []*descriptor.FileDescriptorProto) (map[string]string, error) {
+	digests := make(map[string]string)
+	marshalOptions := proto.MarshalOptions{Deterministic: true}
+
+	for _, fileDesc := range fileDescs {
+		fileDescBytes, err := marshalOptions.Marshal(fileDesc)
+		if err != nil {
+			return nil, err
+		}
+
+		hash := sha256.New()
+		hash.Write([]byte(settings))
+		hash.Write(fileDescBytes)
+		for _, dependency := range fileDesc.GetDependency() {
+			hash.Write([]byte(digests[dependency]))
+		}
+		digests[fileDesc.GetName()] = hex.EncodeToString(hash.Sum(nil))
+	}
+
+	return digests, nil
+}
@@ -0,0 +1,79 @@
+package converter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestGenerationCache(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "protoc-gen-jsonschema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+	cacheFileName := filepath.Join(directory, "cache.json")
+
+	// A simple proto file with one message:
+	fileDesc := testProtoFile("Cached.proto", "cached", testMessage("CachedMessage", testStringField("name", 1)))
+	convertWith := func(protoConverter *Converter, parameters string, fileDesc *descriptor.FileDescriptorProto) *plugin.CodeGeneratorResponse {
+		response, err := protoConverter.convert(testRequest("cache_file="+cacheFileName+parameters, proto.Clone(fileDesc).(*descriptor.FileDescriptorProto)))
+		assert.NoError(t, err)
+		assert.Len(t, response.File, 1)
+		return response
+	}
+	convertWithCache := func(fileDesc *descriptor.FileDescriptorProto) *plugin.CodeGeneratorResponse {
+		return convertWith(New(newTestLogger()), "", fileDesc)
+	}
+	doctorCache := func() {
+		cacheJSON, err := ioutil.ReadFile(cacheFileName)
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(cacheFileName, []byte(strings.Replace(string(cacheJSON), `\"changed\"`, `\"cached\"`, 1)), 0644))
+	}
+
+	// The first run generates a schema (and saves it in the cache):
+	response := convertWithCache(fileDesc)
+	assert.Contains(t, response.File[0].GetContent(), `"name"`)
+
+	// Doctor the cache, so that we can tell when it is being used:
+	cacheJSON, err := ioutil.ReadFile(cacheFileName)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(cacheFileName, []byte(strings.Replace(string(cacheJSON), `\"name\"`, `\"cached\"`, 1)), 0644))
+
+	// An unchanged file is served from the cache:
+	response = convertWithCache(fileDesc)
+	assert.Contains(t, response.File[0].GetContent(), `"cached"`)
+
+	// A changed file is regenerated:
+	fileDesc.MessageType[0].Field[0].Name = proto.String("changed")
+	response = convertWithCache(fileDesc)
+	assert.Contains(t, response.File[0].GetContent(), `"changed"`)
+
+	// So is one generated with a different config file, or different flags given to NewWithOptions:
+	configFileName := filepath.Join(directory, "config.json")
+	assert.NoError(t, ioutil.WriteFile(configFileName, []byte(`{}`), 0644))
+	convertWith(New(newTestLogger()), ",config_file="+configFileName, fileDesc)
+	doctorCache()
+	response = convertWith(New(newTestLogger()), ",config_file="+configFileName, fileDesc)
+	assert.Contains(t, response.File[0].GetContent(), `"cached"`)
+	assert.NoError(t, ioutil.WriteFile(configFileName, []byte(`{"profiles": [{"package": "cached", "flags": {"allow_null_values": true}}]}`), 0644))
+	response = convertWith(New(newTestLogger()), ",config_file="+configFileName, fileDesc)
+	assert.Contains(t, response.File[0].GetContent(), `"changed"`)
+	doctorCache()
+	response = convertWith(NewWithOptions(newTestLogger(), ConvertOptions{Flags: ConverterFlags{AllowNullValues: true}}), ",config_file="+configFileName, fileDesc)
+	assert.Contains(t, response.File[0].GetContent(), `"changed"`)
+
+	// Saving replaces the cache in one go (leaving no temporary files behind):
+	leftovers, err := filepath.Glob(filepath.Join(directory, "*.tmp"))
+	assert.NoError(t, err)
+	assert.Empty(t, leftovers)
+	cacheInfo, err := os.Stat(cacheFileName)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0644), cacheInfo.Mode().Perm())
+}
@@ -0,0 +1,105 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+)
+
+// typeKeywordOrder is the order in which jsonschema.Type marshals its own keywords (before any extras):
+var typeKeywordOrder = func() map[string]int {
+	keywordOrder := make(map[string]int)
+	schemaType := reflect.TypeOf(jsonschema.Type{})
+	for index := 0; index < schemaType.NumField(); index++ {
+		if name := strings.Split(schemaType.Field(index).Tag.Get("json"), ",")[0]; name != "" && name != "-" {
+			keywordOrder[name] = index
+		}
+	}
+	return keywordOrder
+}()
+
+// canonicalJSON re-encodes a JSON document so that it is byte-stable (for schemas which are checked in): object keys are sorted,
+// nothing is HTML-escaped, numbers are written exactly as they were, and the document ends with a newline:
+func canonicalJSON(jsonBytes []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	canonicalJSONBytes := &bytes.Buffer{}
+	encoder := json.NewEncoder(canonicalJSONBytes)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "    ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+	return canonicalJSONBytes.Bytes(), nil
+}
+
+// generatedJSON makes generated JSON canonical, unless we've been asked to leave it in the order it was generated in (as
+// older releases did):
+func (c *Converter) generatedJSON(jsonBytes []byte) ([]byte, error) {
+	if c.Flags.NonCanonicalJSON {
+		return generatedOrderJSON(jsonBytes)
+	}
+	return canonicalJSON(jsonBytes)
+}
+
+// generatedOrderJSON puts additionalProperties (which we keep among the extras) back where jsonschema.Type would have
+// marshaled it, leaving everything else in the order it was generated in:
+func generatedOrderJSON(jsonBytes []byte) ([]byte, error) {
+	if !bytes.Contains(jsonBytes, []byte(`"`+additionalPropertiesKeyword+`"`)) {
+		return jsonBytes, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	document, err := decodeOrderedJSON(decoder)
+	if err != nil {
+		return nil, err
+	}
+	moveAdditionalProperties(document, false)
+	return json.MarshalIndent(document, "", "    ")
+}
+
+// moveAdditionalProperties moves additionalProperties before the first keyword which jsonschema.Type marshals after it
+// (skipping the objects which map names to schemas, whose keys are names rather than keywords):
+func moveAdditionalProperties(value interface{}, namesSchemas bool) {
+	switch value := value.(type) {
+	case []interface{}:
+		for _, item := range value {
+			moveAdditionalProperties(item, false)
+		}
+	case *orderedmap.OrderedMap:
+		keys := append([]string(nil), value.Keys()...)
+		for _, key := range keys {
+			item, _ := value.Get(key)
+			moveAdditionalProperties(item, !namesSchemas && contains(subschemaMapKeywords, key))
+		}
+		if _, ok := value.Get(additionalPropertiesKeyword); namesSchemas || !ok {
+			return
+		}
+
+		others := make([]string, 0, len(keys)-1)
+		for _, key := range keys {
+			if key != additionalPropertiesKeyword {
+				others = append(others, key)
+			}
+		}
+		position := len(others)
+		for index, key := range others {
+			if order, ok := typeKeywordOrder[key]; !ok || order > typeKeywordOrder[additionalPropertiesKeyword] {
+				position = index
+				break
+			}
+		}
+		reordered := append(append(append([]string(nil), others[:position]...), additionalPropertiesKeyword), others[position:]...)
+		value.SortKeys(func(sortedKeys []string) { copy(sortedKeys, reordered) })
+	}
+}
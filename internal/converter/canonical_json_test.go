@@ -0,0 +1,24 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+
+	// Keys are sorted (at every level), nothing is HTML-escaped, and numbers are left as they were:
+	canonical, err := canonicalJSON([]byte(`{"type": "string", "pattern": "^<[a-z]+>&$", "nested": {"b": 1.50, "a": 1e+21}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "{\n    \"nested\": {\n        \"a\": 1e+21,\n        \"b\": 1.50\n    },\n    \"pattern\": \"^<[a-z]+>&$\",\n    \"type\": \"string\"\n}\n", string(canonical))
+
+	// Canonical JSON stays the same:
+	again, err := canonicalJSON(canonical)
+	require.NoError(t, err)
+	assert.Equal(t, string(canonical), string(again))
+
+	_, err = canonicalJSON([]byte(`{"type": `))
+	assert.Error(t, err)
+}
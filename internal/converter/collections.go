@@ -0,0 +1,436 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	collectionPostman         = "postman"
+	collectionInsomnia        = "insomnia"
+	postmanCollectionSuffix   = ".postman_collection"
+	postmanCollectionSchema   = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+	insomniaWorkspaceSuffix   = ".insomnia"
+	insomniaExportFormat      = 4
+	collectionBaseURLVariable = "baseUrl"
+	defaultCollectionBaseURL  = "http://localhost"
+)
+
+// collectionRequest is a request in a client collection (one for every HTTP binding of a unary method, or for the
+// method itself if it has none):
+type collectionRequest struct {
+	body        interface{}
+	description string
+	method      string
+	name        string
+	path        string
+	service     string
+	serviceDesc string
+}
+
+// convertCollection packages the services of a file into a client collection (a Postman collection or an Insomnia
+// workspace), with a request for every unary method and example bodies made from their request schemas:
+func (c *Converter) convertCollection(pkg *ProtoPackage, file *descriptor.FileDescriptorProto, fileExtension string) (*plugin.CodeGeneratorResponse_File, error) {
+	definitions := jsonschema.Definitions{}
+	var requests []collectionRequest
+	var bodyRefs []string
+	baseURL := defaultCollectionBaseURL
+	for _, service := range file.GetService() {
+		if annotations := parseServiceAnnotations(service.GetOptions()); annotations.defaultHost != "" && baseURL == defaultCollectionBaseURL {
+			baseURL = "https://" + annotations.defaultHost
+		}
+
+		_, serviceDescription := c.commentSummary(c.sourceInfo.GetService(service))
+		for _, method := range service.GetMethod() {
+			if method.GetClientStreaming() || method.GetServerStreaming() {
+				c.logger.WithField("service", service.GetName()).WithField("method", method.GetName()).Debug("Skipping streaming method")
+				continue
+			}
+			inputRef, inputDesc, err := c.addServiceMessage(pkg, method.GetInputType(), definitions)
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert the input of %s.%s: %v", service.GetName(), method.GetName(), err)
+			}
+
+			// Methods without HTTP bindings are called the way Connect and HTTP/JSON transcoding proxies call them:
+			bindings := parseHTTPRule(method.GetOptions())
+			if len(bindings) == 0 {
+				bindings = []httpBinding{{body: "*", method: "POST", path: connectPath(file.GetPackage(), service.GetName(), method.GetName())}}
+			}
+
+			_, description := c.commentSummary(c.sourceInfo.GetMethod(method))
+			for _, binding := range bindings {
+				requests = append(requests, collectionRequest{
+					description: description,
+					method:      binding.method,
+					name:        method.GetName(),
+					path:        binding.path,
+					service:     service.GetName(),
+					serviceDesc: serviceDescription,
+				})
+				bodyRef := ""
+				if binding.body != "" {
+					bodyRef = c.bodyRef(inputRef, inputDesc, binding.body)
+				}
+				bodyRefs = append(bodyRefs, bodyRef)
+			}
+		}
+	}
+	if len(requests) == 0 {
+		return nil, nil
+	}
+
+	// The example bodies come from the rendered schemas (so they match what we generate):
+	renderedDefinitions, err := c.renderCollectionDefinitions(definitions)
+	if err != nil {
+		return nil, err
+	}
+	for index, bodyRef := range bodyRefs {
+		if bodyRef != "" {
+			requests[index].body = exampleValue(renderedDefinitions, resolveSchemaRef(renderedDefinitions, bodyRef), map[string]bool{bodyRef: true})
+		}
+	}
+
+	var collection *orderedmap.OrderedMap
+	var collectionFileName string
+	switch c.collectionFormat {
+	case collectionInsomnia:
+		collection = insomniaWorkspace(file.GetPackage(), baseURL, requests)
+		collectionFileName = c.generateSchemaFilename(file, fileExtension, strings.TrimSuffix(path.Base(file.GetName()), ".proto")+insomniaWorkspaceSuffix)
+	default:
+		collection = postmanCollection(file.GetPackage(), baseURL, requests)
+		collectionFileName = c.generateSchemaFilename(file, fileExtension, strings.TrimSuffix(path.Base(file.GetName()), ".proto")+postmanCollectionSuffix)
+	}
+	c.logger.WithField("proto_filename", file.GetName()).WithField("requests", len(requests)).WithField("collection_filename", collectionFileName).Info("Generating client collection for SERVICES")
+
+	collectionJSON, err := json.MarshalIndent(collection, "", "    ")
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to encode client collection")
+		return nil, err
+	}
+	return c.responseFile(collectionFileName, collectionJSON)
+}
+
+// renderCollectionDefinitions renders the definitions of the request messages (as they would appear in a schema):
+func (c *Converter) renderCollectionDefinitions(definitions jsonschema.Definitions) (*orderedmap.OrderedMap, error) {
+	definitionsSchema := &jsonschema.Schema{Type: &jsonschema.Type{}, Definitions: definitions}
+	definitionsJSON, err := json.Marshal(definitionsSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(definitionsJSON))
+	decoder.UseNumber()
+	decoded, err := decodeOrderedJSON(decoder)
+	if err != nil {
+		return nil, err
+	}
+	root, _ := decoded.(*orderedmap.OrderedMap)
+	return root, nil
+}
+
+// resolveSchemaRef finds the schema a (local) $ref points to, eg "#/definitions/Order/properties/id":
+func resolveSchemaRef(root *orderedmap.OrderedMap, ref string) *orderedmap.OrderedMap {
+	schema := root
+	for _, segment := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		if schema == nil {
+			return nil
+		}
+		value, _ := schema.Get(segment)
+		schema, _ = value.(*orderedmap.OrderedMap)
+	}
+	return schema
+}
+
+// exampleValue makes an example value for a schema: its own examples (or default, const or first enum value) if it has
+// any, otherwise an empty value of its type (with examples of every property of objects, and one item of arrays). Refs
+// which are already being followed (recursive messages) are left out:
+func exampleValue(root, schema *orderedmap.OrderedMap, following map[string]bool) interface{} {
+	if schema == nil {
+		return nil
+	}
+	if examples, ok := getKeyword(schema, "examples"); ok && len(examples) > 0 {
+		return examples[0]
+	}
+	for _, keyword := range []string{"default", "const"} {
+		if value, ok := schema.Get(keyword); ok {
+			return value
+		}
+	}
+	if enum, ok := getKeyword(schema, "enum"); ok && len(enum) > 0 {
+		return enum[0]
+	}
+
+	if ref, ok := schema.Get("$ref"); ok {
+		ref, _ := ref.(string)
+		if following[ref] {
+			return nil
+		}
+		following[ref] = true
+		defer delete(following, ref)
+		return exampleValue(root, resolveSchemaRef(root, ref), following)
+	}
+
+	// Schemas split into parts (with allOf) are examples of every part:
+	if parts, ok := getKeyword(schema, "allOf"); ok {
+		example := orderedmap.New()
+		for _, part := range parts {
+			part, _ := part.(*orderedmap.OrderedMap)
+			if partExample, ok := exampleValue(root, part, following).(*orderedmap.OrderedMap); ok {
+				for _, key := range partExample.Keys() {
+					value, _ := partExample.Get(key)
+					example.Set(key, value)
+				}
+			}
+		}
+		return example
+	}
+
+	// Alternatives are represented by the first which isn't null:
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		alternatives, _ := getKeyword(schema, keyword)
+		for _, alternative := range alternatives {
+			if alternative, ok := alternative.(*orderedmap.OrderedMap); ok {
+				if value := exampleValue(root, alternative, following); value != nil {
+					return value
+				}
+			}
+		}
+	}
+
+	switch schemaType(schema) {
+	case "object":
+		example := orderedmap.New()
+		if properties, ok := schema.Get("properties"); ok {
+			if properties, ok := properties.(*orderedmap.OrderedMap); ok {
+				for _, propertyName := range properties.Keys() {
+					property, _ := properties.Get(propertyName)
+					propertySchema, _ := property.(*orderedmap.OrderedMap)
+					if value := exampleValue(root, propertySchema, following); value != nil {
+						example.Set(propertyName, value)
+					}
+				}
+			}
+		}
+		return example
+	case "array":
+		items, _ := schema.Get("items")
+		if items, ok := items.(*orderedmap.OrderedMap); ok {
+			if value := exampleValue(root, items, following); value != nil {
+				return []interface{}{value}
+			}
+		}
+		return []interface{}{}
+	case "string":
+		return ""
+	case "integer", "number":
+		return 0
+	case "boolean":
+		return false
+	}
+	return nil
+}
+
+// schemaType returns the (first non-null) type of a schema:
+func schemaType(schema *orderedmap.OrderedMap) string {
+	jsonType, _ := schema.Get("type")
+	switch jsonType := jsonType.(type) {
+	case string:
+		return jsonType
+	case []interface{}:
+		for _, alternative := range jsonType {
+			if alternative, ok := alternative.(string); ok && alternative != "null" {
+				return alternative
+			}
+		}
+	}
+	return ""
+}
+
+// collectionPath turns an HttpRule path into a collection URL path, with its variables (eg "{name=orders/*}") as
+// collection variables (eg "{{name}}" in Postman, or "{{ _.name }}" in Insomnia):
+func collectionPath(rulePath string, variable func(name string) string) (string, []string) {
+	var variables []string
+	collectionPath := pathVariablePattern.ReplaceAllStringFunc(rulePath, func(pathVariable string) string {
+		name := strings.ReplaceAll(pathVariablePattern.FindStringSubmatch(pathVariable)[1], ".", "_")
+		variables = append(variables, name)
+		return variable(name)
+	})
+	return collectionPath, variables
+}
+
+// exampleBodyJSON renders an example body as indented JSON:
+func exampleBodyJSON(body interface{}) string {
+	bodyJSON, err := json.MarshalIndent(body, "", "    ")
+	if err != nil {
+		return ""
+	}
+	return string(bodyJSON)
+}
+
+// postmanCollection describes requests as a Postman (v2.1) collection, with a folder for each service:
+func postmanCollection(name, baseURL string, requests []collectionRequest) *orderedmap.OrderedMap {
+	postmanVariable := func(name string) string { return "{{" + name + "}}" }
+
+	variables := []interface{}{postmanCollectionVariable(collectionBaseURLVariable, baseURL)}
+	declaredVariables := map[string]bool{collectionBaseURLVariable: true}
+	var folders []string
+	folderItems := make(map[string][]interface{})
+	folderDescriptions := make(map[string]string)
+	for _, request := range requests {
+		urlPath, pathVariables := collectionPath(request.path, postmanVariable)
+		for _, pathVariable := range pathVariables {
+			if !declaredVariables[pathVariable] {
+				declaredVariables[pathVariable] = true
+				variables = append(variables, postmanCollectionVariable(pathVariable, ""))
+			}
+		}
+
+		url := orderedmap.New()
+		url.Set("raw", postmanVariable(collectionBaseURLVariable)+urlPath)
+		url.Set("host", []string{postmanVariable(collectionBaseURLVariable)})
+		url.Set("path", strings.Split(strings.TrimPrefix(urlPath, "/"), "/"))
+
+		postmanRequest := orderedmap.New()
+		postmanRequest.Set("method", request.method)
+		if request.body != nil {
+			header := orderedmap.New()
+			header.Set("key", "Content-Type")
+			header.Set("value", "application/json")
+			postmanRequest.Set("header", []interface{}{header})
+			language := orderedmap.New()
+			language.Set("language", "json")
+			options := orderedmap.New()
+			options.Set("raw", language)
+			body := orderedmap.New()
+			body.Set("mode", "raw")
+			body.Set("raw", exampleBodyJSON(request.body))
+			body.Set("options", options)
+			postmanRequest.Set("body", body)
+		}
+		postmanRequest.Set("url", url)
+		if request.description != "" {
+			postmanRequest.Set("description", request.description)
+		}
+
+		item := orderedmap.New()
+		item.Set("name", request.name)
+		item.Set("request", postmanRequest)
+		if _, ok := folderItems[request.service]; !ok {
+			folders = append(folders, request.service)
+			folderDescriptions[request.service] = request.serviceDesc
+		}
+		folderItems[request.service] = append(folderItems[request.service], item)
+	}
+
+	var items []interface{}
+	for _, folderName := range folders {
+		folder := orderedmap.New()
+		folder.Set("name", folderName)
+		if folderDescriptions[folderName] != "" {
+			folder.Set("description", folderDescriptions[folderName])
+		}
+		folder.Set("item", folderItems[folderName])
+		items = append(items, folder)
+	}
+
+	info := orderedmap.New()
+	info.Set("name", name)
+	info.Set("schema", postmanCollectionSchema)
+	collection := orderedmap.New()
+	collection.Set("info", info)
+	collection.Set("item", items)
+	collection.Set("variable", variables)
+	return collection
+}
+
+// postmanCollectionVariable declares a Postman collection variable:
+func postmanCollectionVariable(key, value string) *orderedmap.OrderedMap {
+	variable := orderedmap.New()
+	variable.Set("key", key)
+	variable.Set("value", value)
+	return variable
+}
+
+// insomniaWorkspace describes requests as an Insomnia (v4) export of a workspace, with a request group for each service
+// (and the base URL and path variables in the base environment):
+func insomniaWorkspace(name, baseURL string, requests []collectionRequest) *orderedmap.OrderedMap {
+	insomniaVariable := func(name string) string { return "{{ _." + name + " }}" }
+	workspaceID := "wrk_" + name
+
+	environmentData := orderedmap.New()
+	environmentData.Set(collectionBaseURLVariable, baseURL)
+	var requestGroups, requestResources []interface{}
+	requestGroupIDs := make(map[string]string)
+	for index, request := range requests {
+		urlPath, pathVariables := collectionPath(request.path, insomniaVariable)
+		for _, pathVariable := range pathVariables {
+			if _, ok := environmentData.Get(pathVariable); !ok {
+				environmentData.Set(pathVariable, "")
+			}
+		}
+
+		requestGroupID, ok := requestGroupIDs[request.service]
+		if !ok {
+			requestGroupID = fmt.Sprintf("fld_%s.%s", name, request.service)
+			requestGroupIDs[request.service] = requestGroupID
+			requestGroup := orderedmap.New()
+			requestGroup.Set("_id", requestGroupID)
+			requestGroup.Set("_type", "request_group")
+			requestGroup.Set("parentId", workspaceID)
+			requestGroup.Set("name", request.service)
+			if request.serviceDesc != "" {
+				requestGroup.Set("description", request.serviceDesc)
+			}
+			requestGroups = append(requestGroups, requestGroup)
+		}
+
+		resource := orderedmap.New()
+		resource.Set("_id", fmt.Sprintf("req_%s.%s.%s_%d", name, request.service, request.name, index))
+		resource.Set("_type", "request")
+		resource.Set("parentId", requestGroupID)
+		resource.Set("name", request.name)
+		resource.Set("method", request.method)
+		resource.Set("url", insomniaVariable(collectionBaseURLVariable)+urlPath)
+		if request.body != nil {
+			header := orderedmap.New()
+			header.Set("name", "Content-Type")
+			header.Set("value", "application/json")
+			resource.Set("headers", []interface{}{header})
+			body := orderedmap.New()
+			body.Set("mimeType", "application/json")
+			body.Set("text", exampleBodyJSON(request.body))
+			resource.Set("body", body)
+		}
+		if request.description != "" {
+			resource.Set("description", request.description)
+		}
+		requestResources = append(requestResources, resource)
+	}
+
+	workspace := orderedmap.New()
+	workspace.Set("_id", workspaceID)
+	workspace.Set("_type", "workspace")
+	workspace.Set("name", name)
+	environment := orderedmap.New()
+	environment.Set("_id", "env_"+name)
+	environment.Set("_type", "environment")
+	environment.Set("parentId", workspaceID)
+	environment.Set("name", "Base Environment")
+	environment.Set("data", environmentData)
+
+	resources := append([]interface{}{workspace, environment}, requestGroups...)
+	export := orderedmap.New()
+	export.Set("_type", "export")
+	export.Set("__export_format", insomniaExportFormat)
+	export.Set("__export_source", "protoc-gen-jsonschema")
+	export.Set("resources", append(resources, requestResources...))
+	return export
+}
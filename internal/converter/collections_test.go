@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/iancoleman/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExampleValue(t *testing.T) {
+
+	// Examples come from the schemas themselves where they can, and recursive references are left out:
+	decoder := json.NewDecoder(strings.NewReader(`{
+		"definitions": {
+			"Node": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string", "examples": ["root"]},
+					"kind": {"enum": ["LEAF", "BRANCH"]},
+					"size": {"oneOf": [{"type": "null"}, {"type": "integer"}]},
+					"enabled": {"type": ["null", "boolean"]},
+					"parent": {"$ref": "#/definitions/Node"},
+					"extra": {"allOf": [{"properties": {"a": {"type": "number"}}, "type": "object"}, {"properties": {"b": {"default": "x"}}, "type": "object"}]}
+				}
+			}
+		}
+	}`))
+	decoded, err := decodeOrderedJSON(decoder)
+	require.NoError(t, err)
+	schema := decoded.(*orderedmap.OrderedMap)
+	assert.Nil(t, exampleValue(schema, nil, map[string]bool{}))
+
+	example, err := json.Marshal(exampleValue(schema, resolveSchemaRef(schema, "#/definitions/Node"), map[string]bool{"#/definitions/Node": true}))
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"root","kind":"LEAF","size":0,"enabled":false,"extra":{"a":0,"b":"x"}}`, string(example))
+}
@@ -0,0 +1,79 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// thingRequest describes an acme.Thing with a single (numbered) field, so that every request is different:
+func thingRequest(number int) []byte {
+	request, _ := proto.Marshal(testRequest("", testProtoFile("acme/thing.proto", "acme", testMessage("Thing", testStringField(fmt.Sprintf("field_%d", number), 1)))))
+	return request
+}
+
+func TestConcurrentConversions(t *testing.T) {
+
+	// Convert lots of (slightly different) descriptor sets at the same time (run with -race to check for shared state):
+	const conversions = 20
+	contents := make([]string, conversions)
+	var waitGroup sync.WaitGroup
+	for number := 0; number < conversions; number++ {
+		waitGroup.Add(1)
+		go func(number int) {
+			defer waitGroup.Done()
+			protoConverter := NewWithOptions(newTestLogger(), ConvertOptions{Parameters: "disallow_additional_properties"})
+			response, err := protoConverter.ConvertFrom(bytes.NewReader(thingRequest(number)))
+			if assert.NoError(t, err) && assert.Len(t, response.GetFile(), 1) {
+				contents[number] = response.GetFile()[0].GetContent()
+			}
+		}(number)
+	}
+	waitGroup.Wait()
+
+	// Every schema describes its own acme.Thing (and nobody else's):
+	for number, content := range contents {
+		assert.Contains(t, content, fmt.Sprintf(`"field_%d"`, number))
+		assert.Contains(t, content, `"additionalProperties": false`)
+		for otherNumber := range contents {
+			if otherNumber != number {
+				assert.NotContains(t, content, fmt.Sprintf(`"field_%d"`, otherNumber))
+			}
+		}
+	}
+}
+
+func TestConverterReuse(t *testing.T) {
+
+	// Types registered by one request aren't visible to the next:
+	protoConverter := NewWithOptions(newTestLogger(), ConvertOptions{Flags: ConverterFlags{AllowNullValues: true}})
+	_, err := protoConverter.ConvertFrom(bytes.NewReader(thingRequest(1)))
+	assert.NoError(t, err)
+	_, err = protoConverter.Convert(testRequest("", testProtoFile("acme/other.proto", "acme")))
+	assert.NoError(t, err)
+	_, _, ok := protoConverter.lookupType(protoConverter.rootPkg, ".acme.Thing")
+	assert.False(t, ok)
+
+	// The options still apply:
+	assert.True(t, protoConverter.Flags.AllowNullValues)
+
+	// Flags changed between conversions apply to the next one, while those set by parameters only last for their own:
+	protoConverter = New(newTestLogger())
+	response, err := protoConverter.Convert(testRequest("allow_null_values", testProtoFile("acme/thing.proto", "acme", testMessage("Thing", testStringField("name", 1)))))
+	require.NoError(t, err)
+	require.Len(t, response.GetFile(), 1)
+	assert.NotContains(t, response.GetFile()[0].GetContent(), `"additionalProperties": false`)
+	assert.False(t, protoConverter.Flags.AllowNullValues)
+
+	protoConverter.Flags.DisallowAdditionalProperties = true
+	response, err = protoConverter.ConvertFrom(bytes.NewReader(thingRequest(1)))
+	require.NoError(t, err)
+	require.Len(t, response.GetFile(), 1)
+	assert.Contains(t, response.GetFile()[0].GetContent(), `"additionalProperties": false`)
+	assert.NotContains(t, response.GetFile()[0].GetContent(), `"null"`)
+}
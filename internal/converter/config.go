@@ -0,0 +1,81 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// converterConfig is the structure of the (JSON) config file:
+type converterConfig struct {
+	Profiles []packageProfile  `json:"profiles"`
+	Renames  map[string]string `json:"renames"`
+}
+
+// packageProfile overrides flags for every package matching a pattern (eg "acme.legacy.*"):
+type packageProfile struct {
+	Package string          `json:"package"`
+	Flags   map[string]bool `json:"flags"`
+}
+
+// loadConverterConfig reads and checks a config file:
+func loadConverterConfig(fileName string) (*converterConfig, error) {
+	configJSON, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &converterConfig{}
+	if err := json.Unmarshal(configJSON, config); err != nil {
+		return nil, err
+	}
+
+	// Make sure every profile makes sense:
+	for _, profile := range config.Profiles {
+		if profile.Package == "" {
+			return nil, fmt.Errorf("profiles must specify a package")
+		}
+		for flag := range profile.Flags {
+			if !new(ConverterFlags).set(flag, true) {
+				return nil, fmt.Errorf("unknown flag in profile for %s: %s", profile.Package, flag)
+			}
+		}
+	}
+
+	// Make sure renames don't leave us with empty property names:
+	for name, renamed := range config.Renames {
+		if renamed == "" {
+			return nil, fmt.Errorf("empty rename for %s", name)
+		}
+	}
+
+	return config, nil
+}
+
+// applyPackageFlags applies the flags from any matching output route, then from any matching package profiles (in order),
+// returning where each flag came from. Config takes precedence over generator parameters (and proto options take precedence over both):
+func (c *Converter) applyPackageFlags(pkgName string) map[string]string {
+	flagSources := make(map[string]string)
+
+	if route, ok := c.lookupOutputRoute(pkgName); ok {
+		for _, flag := range route.flags {
+			c.Flags.set(flag, true)
+			flagSources[flag] = fmt.Sprintf("route %s", route.packagePattern)
+		}
+	}
+
+	if c.config != nil {
+		for _, profile := range c.config.Profiles {
+			if !packageMatches(profile.Package, pkgName) {
+				continue
+			}
+			c.logger.WithField("package_name", pkgName).WithField("profile", profile.Package).Debug("Applying package profile")
+			for flag, value := range profile.Flags {
+				c.Flags.set(flag, value)
+				flagSources[flag] = fmt.Sprintf("config profile %s", profile.Package)
+			}
+		}
+	}
+
+	return flagSources
+}
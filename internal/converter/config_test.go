@@ -0,0 +1,56 @@
+package converter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const testPackageProfilesConfig = `{
+    "profiles": [
+        {"package": "acme.*", "flags": {"disallow_additional_properties": true}},
+        {"package": "acme.legacy.*", "flags": {"disallow_bigints_as_strings": true, "disallow_additional_properties": false}}
+    ]
+}`
+
+func TestPackageProfiles(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "protoc-gen-jsonschema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+	configFileName := filepath.Join(directory, "config.json")
+	assert.NoError(t, ioutil.WriteFile(configFileName, []byte(testPackageProfilesConfig), 0644))
+
+	// Proto files (in different packages) with an int64 field:
+	newFileDesc := func(fileName, pkgName, messageName string) *descriptor.FileDescriptorProto {
+		return testProtoFile(fileName, pkgName, testMessage(messageName, testField("count", 1, descriptor.FieldDescriptorProto_TYPE_INT64, "")))
+	}
+
+	response, err := convertTestRequest(testRequest(
+		"config_file="+configFileName,
+		newFileDesc("Legacy.proto", "acme.legacy", "LegacyMessage"),
+		newFileDesc("Modern.proto", "acme.modern", "ModernMessage"),
+		newFileDesc("Other.proto", "other", "OtherMessage"),
+	))
+	assert.NoError(t, err)
+	assert.Len(t, response.File, 3)
+
+	// Later profiles override earlier ones:
+	assert.Contains(t, response.File[0].GetContent(), `"type": "integer"`)
+	assert.Contains(t, response.File[0].GetContent(), `"additionalProperties": true`)
+
+	// Packages only get the flags from their own profiles:
+	assert.Contains(t, response.File[1].GetContent(), `"type": "string"`)
+	assert.Contains(t, response.File[1].GetContent(), `"additionalProperties": false`)
+	assert.Contains(t, response.File[2].GetContent(), `"type": "string"`)
+	assert.Contains(t, response.File[2].GetContent(), `"additionalProperties": true`)
+
+	// Unknown flags are rejected:
+	assert.NoError(t, ioutil.WriteFile(configFileName, []byte(`{"profiles": [{"package": "acme.*", "flags": {"no_such_flag": true}}]}`), 0644))
+	_, err = loadConverterConfig(configFileName)
+	assert.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/alecthomas/jsonschema"
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// applyContainsConstraint requires a repeated field to contain an element (or at least min_contains of them) matching
+// the JSON-Schema snippet of its "contains" option:
+func (c *Converter) applyContainsConstraint(fieldDesc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type) error {
+	fieldOptions, ok := proto.GetExtension(fieldDesc.GetOptions(), protoc_gen_jsonschema.E_FieldOptions).(*protoc_gen_jsonschema.FieldOptions)
+	if !ok || (fieldOptions.GetContains() == "" && fieldOptions.GetMinContains() == 0) {
+		return nil
+	}
+	if fieldOptions.GetContains() == "" {
+		return fmt.Errorf("min_contains for field %s needs a contains schema to count matches of", fieldDesc.GetName())
+	}
+	if fieldDesc.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED || jsonSchemaType.Items == nil {
+		return fmt.Errorf("contains only applies to repeated fields (and %s is not one)", fieldDesc.GetName())
+	}
+
+	c.schemaVersion = versionDraft06 // Contains requires draft-06
+	contains, err := c.parseSchemaSnippet("contains", fieldDesc, fieldOptions.GetContains())
+	if err != nil {
+		return err
+	}
+	setExtras(jsonSchemaType, map[string]interface{}{"contains": contains})
+
+	// Counting matches came along in 2019-09 (earlier drafts ignore minContains, so they only insist on one):
+	if minContains := fieldOptions.GetMinContains(); minContains > 0 {
+		if minContains > 1 && c.targetSchemaVersion != "2019-09" && c.targetSchemaVersion != "2020-12" {
+			c.logger.WithField("field_name", fieldDesc.GetName()).WithField("min_contains", minContains).Warn("minContains is only enforced from JSON-Schema 2019-09 onwards (see the schema_version parameter)")
+		}
+		setExtras(jsonSchemaType, map[string]interface{}{"minContains": minContains})
+	}
+	return nil
+}
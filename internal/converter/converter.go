@@ -1,13 +1,18 @@
 package converter
 
 import (
+	"crypto"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 	"path"
+	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/alecthomas/jsonschema"
 	"github.com/iancoleman/strcase"
@@ -15,6 +20,7 @@ import (
 	"github.com/xeipuuv/gojsonschema"
 	gengo "google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
 	plugin "google.golang.org/protobuf/types/pluginpb"
 
@@ -25,7 +31,7 @@ const (
 	defaultCommentDelimiter    = "  "
 	defaultExcludeCommentToken = "@exclude"
 	defaultFileExtension       = "json"
-	defaultPackageName         = "package"
+	defaultIndexFileName       = "index.json"
 	defaultRefPrefix           = "#/definitions/"
 	messageDelimiter           = "+"
 	versionDraft04             = "http://json-schema.org/draft-04/schema#"
@@ -34,42 +40,187 @@ const (
 
 // Converter is everything you need to convert protos to JSONSchemas:
 type Converter struct {
-	Flags               ConverterFlags
-	commentDelimiter    string
-	excludeCommentToken string
-	logger              *logrus.Logger
-	refPrefix           string
-	schemaFileExtension string
-	schemaVersion       string
-	sourceInfo          *sourceCodeInfo
-	messageTargets      []string
+	Flags                   ConverterFlags
+	bundle                  string
+	cacheFileName           string
+	collectionFormat        string
+	commentDelimiter        string
+	config                  *converterConfig
+	configFileName          string
+	definitionCache         map[definitionCacheKey]*cachedDefinition
+	deepestMessageDepth     int
+	enumDefinitions         jsonschema.Definitions
+	defaultParameters       string
+	docsPrecedence          string
+	envelopeMessage         string
+	envelopePayloadField    string
+	explainedMessages       map[string]bool
+	idTemplate              string
+	excludeCommentToken     string
+	excludedFieldNumbers    []fieldNumberRange
+	listStyle               string
+	locale                  string
+	logFile                 *os.File
+	logOutput               io.Writer
+	extensionTypes          *protoregistry.Types
+	logger                  *logrus.Logger
+	maxDepth                int
+	messageDepth            int
+	namingRules             []*namingRule
+	outputDirectory         string
+	postProcessCommand      string
+	proto2Messages          map[*descriptor.DescriptorProto]bool
+	publishBackoff          time.Duration
+	publishBatchSize        int
+	publishContentAddressed bool
+	publishRate             float64
+	publishRetries          int
+	publishStateFileName    string
+	publishURL              string
+	publishVersion          string
+	refPrefix               string
+	report                  *generationReport
+	reportedIdentifiers     map[string]bool
+	rootMessage             string
+	resolvedTypes           *lookupCache
+	rootPkg                 *ProtoPackage
+	rulesFileName           string
+	schemaFileExtension     string
+	schemaIDBase            string
+	schemaIndex             map[string]string
+	schemaVersion           string
+	sharedMessages          []string
+	sharedSchemaFileName    string
+	signingKey              crypto.Signer
+	signingKeyFileName      string
+	sourceInfo              *sourceCodeInfo
+	sourceRevision          string
+	splitThreshold          int
+	templateVariables       map[string]string
+	typeCacheSize           int
+	titleTemplate           string
+	unknownFieldsPolicy     string
+	targetSchemaVersion     string
+	visibilityLabels        []string
+	messageTargets          []string
+	namingStrategy          NamingStrategy
+	outputRoutes            []outputRoute
+	packageBundles          []*schemaBundle
 }
 
-// ConverterFlags control the behaviour of the converter:
+// ConverterFlags control the behaviour of the converter (those which can be set by name are tagged with their parameter
+// names, and usage for the option catalogue):
 type ConverterFlags struct {
-	AllFieldsRequired            bool
-	AllowNullValues              bool
-	DisallowAdditionalProperties bool
-	DisallowBigIntsAsStrings     bool
-	EnforceOneOf                 bool
+	AIPConventions               bool `parameter:"aip_conventions" usage:"Annotate standard AIP fields (page_size, page_token, next_page_token and field masks)"`
+	AllFieldsRequired            bool `parameter:"all_fields_required" usage:"Require all fields in schema"`
+	AllowNullOptionals           bool `parameter:"allow_null_optionals" usage:"Allow null values for explicitly optional fields"`
+	AllowNullValues              bool `parameter:"allow_null_values" usage:"Allow null values in schema"`
+	BigIntsAsPlainStrings        bool `parameter:"bigints_as_plain_strings" usage:"Represent 64-bit integers as plain strings (not integers or strings of digits), as older releases did"`
+	BinaryEncoding               bool `parameter:"binary_encoding" usage:"Describe bytes with the binaryEncoding keyword (not contentEncoding and a base64 pattern), as older releases did"`
+	CheckIdentifiers             bool `parameter:"check_identifiers" usage:"Report names which would be awkward for downstream schema consumers"`
+	CommentsAsExtension          bool `parameter:"comments_as_extension" usage:"Put proto comments under x-proto-comment instead of description"`
+	DefinitionSlugs              bool `parameter:"definition_slugs" usage:"Escape definition names so they are always safe to address by fragment (in URIs, JSON pointers and $anchors)"`
+	DisallowAdditionalProperties bool `parameter:"disallow_additional_properties" usage:"Disallow additional properties in schema"`
+	DisallowBigIntsAsStrings     bool `parameter:"disallow_bigints_as_strings" usage:"Only accept 64-bit integers as numbers (not strings of digits)"`
+	EnforceOneOf                 bool `parameter:"enforce_oneof" usage:"Interpret Proto \"oneOf\" clauses"`
+	EnumsAllowLowercase          bool `parameter:"enums_allow_lowercase" usage:"Also accept lowercase variants of enum value names"`
 	EnumsAsConstants             bool
-	EnumsAsStringsOnly           bool
-	EnumsTrimPrefix              bool
+	EnumsAsDefinitions           bool `parameter:"enums_as_definitions" usage:"Define each enum once and $ref it from every field which uses it"`
+	EnumsAsIntegersOnly          bool `parameter:"enums_as_integers_only" usage:"Only include numbers in the allowed values for enums"`
+	EnumsAsStringsOnly           bool `parameter:"enums_as_strings_only" usage:"Only include strings in the allowed values for enums"`
+	EnumsExcludeUnspecified      bool `parameter:"enums_exclude_unspecified" usage:"Leave the zero UNSPECIFIED value out of enums"`
+	EnumsTrimPrefix              bool `parameter:"enums_trim_prefix" usage:"Remove the enum name prefix from enum values"`
+	ExcludeAlpha                 bool `parameter:"exclude_alpha" usage:"Leave fields and messages with alpha stability out entirely"`
+	ExplainConfig                bool `parameter:"explain_config" usage:"Log the effective flags for every file, along with where each one came from"`
+	FaithfulProtoJSON            bool `parameter:"faithful_protojson" usage:"Accept every encoding proto3's JSON mapping does (eg quoted numbers, \"NaN\" and either base64 alphabet), and only those"`
+	GenerateChecksums            bool `parameter:"generate_checksums" usage:"Also generate a SHA256SUMS file of every generated file (see signing_key)"`
+	GenerateIndex                bool `parameter:"generate_index" usage:"Also generate an index.json mapping proto names to schema filenames"`
+	GenerateListSchemas          bool `parameter:"generate_list_schemas" usage:"Also generate a <Message>List schema for every message"`
+	GenerateReport               bool `parameter:"generate_report" usage:"Also generate a report.json recording how long generation took"`
+	GenerateUploadOrder          bool `parameter:"generate_upload_order" usage:"Also generate an upload_order.json listing schemas in dependency order"`
+	HyperSchema                  bool `parameter:"hyper_schema" usage:"Also generate a hyper-schema for each file with HTTP-bound services"`
+	InferFormats                 bool `parameter:"infer_formats" usage:"Infer string formats from conventional field names (*_email, *_uuid, *_url and *_ip)"`
 	KeepNewLinesInDescription    bool
-	PrefixSchemaFilesWithPackage bool
-	UseJSONFieldnamesOnly        bool
-	UseProtoAndJSONFieldNames    bool
+	LegacyWellKnownTypes         bool `parameter:"legacy_well_known_types" usage:"Only follow the JSON mapping for the well-known types older releases did (converting the likes of Any, FieldMask and ListValue as ordinary messages)"`
+	LenientLookup                bool `parameter:"lenient_lookup" usage:"Allow anything in place of fields whose types are missing from the request"`
+	Lint                         bool `parameter:"lint" usage:"Log warnings about common quality problems in generated schemas"`
+	LintStrict                   bool `parameter:"lint_strict" usage:"As lint, but fail generation if there are any warnings"`
+	NonCanonicalJSON             bool `parameter:"non_canonical_json" usage:"Write JSON in the order it was generated (HTML-escaped, without a trailing newline) instead of canonical JSON, as older releases did"`
+	NonNullableValues            bool `parameter:"non_nullable_values" usage:"Don't accept null for google.protobuf.Value and NullValue, as older releases didn't"`
+	NonNullableWrappers          bool `parameter:"non_nullable_wrappers" usage:"Only allow null for wrapper types (eg google.protobuf.StringValue) along with allow_null_values, as older releases did"`
+	OmitEmpty                    bool `parameter:"omit_empty" usage:"Leave out keywords with empty objects or arrays as their values"`
+	PackageVersions              bool `parameter:"package_versions" usage:"Embed versioned package segments (eg v2beta1) into titles, keywords and paths"`
+	PreserveUnknownOptions       bool `parameter:"preserve_unknown_options" usage:"Include unrecognised custom options as x-proto-option-<number> keywords"`
+	PrefixSchemaFilesWithPackage bool `parameter:"prefix_schema_files_with_package" usage:"Prefix the output filename with package"`
+	RequiredByFieldNumber        bool `parameter:"required_by_field_number" usage:"Order required arrays by field number"`
+	SkipDeprecated               bool `parameter:"skip_deprecated" usage:"Leave deprecated files, messages and enums out"`
+	TypeArrays                   bool `parameter:"type_arrays" usage:"Declare alternative types as type arrays (eg [\"integer\", \"string\"]) instead of oneOf, for validators which coerce types"`
+	TypeURLNames                 bool `parameter:"type_url_names" usage:"Name message schemas (and their IDs) after their type URLs (eg type.googleapis.com/acme.v1.Order)"`
+	UnconstrainedMapKeys         bool `parameter:"unconstrained_map_keys" usage:"Describe every map's values with additionalProperties (not patternProperties for integer and bool keys), as older releases did"`
+	UntypedEnumItems             bool `parameter:"untyped_enum_items" usage:"Only give the items of repeated enums their values (not the types of those values), as older releases did"`
+	UseJSONFieldnamesOnly        bool `parameter:"json_fieldnames" usage:"Use JSON field names only"`
+	UseProtoAndJSONFieldNames    bool `parameter:"proto_and_json_fieldnames" usage:"Use proto and JSON field names"`
+	WellKnownTypesAsMessages     bool `parameter:"well_known_types_as_messages" usage:"Convert well-known types as ordinary messages instead of following the JSON mapping"`
+}
+
+// ConvertOptions configure a Converter made by NewWithOptions:
+type ConvertOptions struct {
+	Flags      ConverterFlags
+	Naming     NamingStrategy // Names schemas, definitions, properties and IDs (instead of the naming flags)
+	Parameters string         // Generator parameters (eg "allow_null_values,schema_version=draft-07"), applied before those of each request (and to each descriptor converted)
 }
 
 // New returns a configured *Converter (defaulting to draft-04 version):
 func New(logger *logrus.Logger) *Converter {
-	return &Converter{
+	converter := &Converter{logger: logger}
+	converter.reset()
+	return converter
+}
+
+// NewWithOptions returns a *Converter configured with the given options.
+// Converters share no state, so (as long as each goroutine has its own) many can convert at the same time.
+// Note that the "debug", "log_file" and "log_level" parameters reconfigure the logger, so converters running alongside each other should have their own:
+func NewWithOptions(logger *logrus.Logger, options ConvertOptions) *Converter {
+	converter := New(logger)
+	converter.Flags = options.Flags
+	converter.namingStrategy = options.Naming
+	converter.defaultParameters = options.Parameters
+	return converter
+}
+
+// reset puts everything which a conversion works out for itself back to its defaults, keeping only the configuration we
+// were given (the flags, naming strategy, parameters and logger):
+func (c *Converter) reset() {
+	*c = Converter{
+		Flags:             c.Flags,
+		defaultParameters: c.defaultParameters,
+		logger:            c.logger,
+		namingStrategy:    c.namingStrategy,
+
 		commentDelimiter:    defaultCommentDelimiter,
 		excludeCommentToken: defaultExcludeCommentToken,
-		logger:              logger,
+		maxDepth:            defaultMaxDepth,
+		publishBackoff:      defaultPublishBackoff,
+		publishBatchSize:    1,
+		publishRetries:      defaultPublishRetries,
 		refPrefix:           defaultRefPrefix,
+		rootPkg:             newProtoPackage(nil, ""),
 		schemaFileExtension: defaultFileExtension,
 		schemaVersion:       versionDraft04,
+		typeCacheSize:       defaultTypeCacheSize,
+	}
+}
+
+// startConversion starts each conversion afresh (so that nothing from a previous one lingers) and parses the given
+// parameters. They only apply to this conversion, so the function it returns puts our flags back the way they were
+// configured once it has finished (whatever the caller has changed them to since the last one):
+func (c *Converter) startConversion(parameters string) (finish func()) {
+	flags := c.Flags
+	c.reset()
+	c.parseGeneratorParameters(parameters)
+	return func() {
+		c.closeLogFile()
+		c.Flags = flags
 	}
 }
 
@@ -93,31 +244,47 @@ func (c *Converter) ConvertFrom(rd io.Reader) (*plugin.CodeGeneratorResponse, er
 	return c.convert(req)
 }
 
+// Convert converts a code generator request which has already been decoded (eg when embedding the converter in another tool):
+func (c *Converter) Convert(request *plugin.CodeGeneratorRequest) (*plugin.CodeGeneratorResponse, error) {
+	return c.convert(request)
+}
+
 func (c *Converter) parseGeneratorParameters(parameters string) {
-	for _, parameter := range strings.Split(parameters, ",") {
+	splitParameters, err := splitGeneratorParameters(parameters)
+	splitParameters = c.expandPresets(splitParameters)
+
+	// Configure logging first, so that it covers everything (eg "log_file=protoc-gen-jsonschema.log" or "log_level=info"):
+	for _, parameter := range splitParameters {
+		if value, ok := parameterValue(parameter, "log_file"); ok {
+			if err := c.openLogFile(value); err != nil {
+				c.logger.WithError(err).WithField("log_file", value).Warn("Unable to open log file - logging to STDERR instead")
+			}
+		}
+		if parameter == "debug" {
+			c.logger.SetLevel(logrus.DebugLevel)
+		}
+		if value, ok := parameterValue(parameter, "log_level"); ok {
+			level, err := logrus.ParseLevel(value)
+			if err != nil {
+				c.logger.WithField("log_level", value).Warn("Ignoring invalid log level")
+				continue
+			}
+			c.logger.SetLevel(level)
+		}
+	}
+
+	if err != nil {
+		c.logger.WithError(err).Warn("Generator parameters may have been mangled")
+	}
+
+	for _, parameter := range splitParameters {
 		switch parameter {
-		case "all_fields_required":
-			c.Flags.AllFieldsRequired = true
-		case "allow_null_values":
-			c.Flags.AllowNullValues = true
 		case "debug":
-			c.logger.SetLevel(logrus.DebugLevel)
-		case "disallow_additional_properties":
-			c.Flags.DisallowAdditionalProperties = true
-		case "disallow_bigints_as_strings":
-			c.Flags.DisallowBigIntsAsStrings = true
-		case "enforce_oneof":
-			c.Flags.EnforceOneOf = true
-		case "enums_as_strings_only":
-			c.Flags.EnumsAsStringsOnly = true
-		case "enums_trim_prefix":
-			c.Flags.EnumsTrimPrefix = true
-		case "json_fieldnames":
-			c.Flags.UseJSONFieldnamesOnly = true
-		case "prefix_schema_files_with_package":
-			c.Flags.PrefixSchemaFilesWithPackage = true
-		case "proto_and_json_fieldnames":
-			c.Flags.UseProtoAndJSONFieldNames = true
+			// Already configured (along with the rest of the logging)
+		case "publish_content_addressed":
+			c.publishContentAddressed = true
+		default:
+			c.Flags.set(parameter, true)
 		}
 
 		// look for specific message targets
@@ -134,9 +301,296 @@ func (c *Converter) parseGeneratorParameters(parameters string) {
 		if parameterParts := strings.Split(parameter, "file_extension="); len(parameterParts) == 2 {
 			c.schemaFileExtension = parameterParts[1]
 		}
+
+		// Configure a config file:
+		if value, ok := parameterValue(parameter, "config_file"); ok {
+			c.configFileName = value
+		}
+
+		// Configure a rules file (schema fragments for fields which follow naming conventions):
+		if value, ok := parameterValue(parameter, "rules_file"); ok {
+			c.rulesFileName = value
+		}
+
+		// Configure an envelope message to wrap every schema in (eg "envelope=acme.Envelope,envelope_payload_field=data"):
+		if value, ok := parameterValue(parameter, "envelope"); ok {
+			c.envelopeMessage = value
+		}
+		if value, ok := parameterValue(parameter, "envelope_payload_field"); ok {
+			c.envelopePayloadField = value
+		}
+
+		// Configure the style of list schemas ("array" or "paginated"):
+		if value, ok := parameterValue(parameter, "list_style"); ok {
+			c.listStyle = value
+		}
+
+		// Configure a locale, to pick one language from comments which have several (eg "locale=de" for "@de: ..."):
+		if value, ok := parameterValue(parameter, "locale"); ok {
+			c.locale = value
+		}
+
+		// Configure bundling, to generate one schema per proto file or package (eg "bundle=file" or "bundle=package"):
+		if value, ok := parameterValue(parameter, "bundle"); ok {
+			if value != bundleFile && value != bundlePackage {
+				c.logger.WithField("bundle", value).Warn("Ignoring invalid bundle mode")
+				continue
+			}
+			c.bundle = value
+		}
+
+		// Configure a client collection to package services into ("collection=postman" or "collection=insomnia"):
+		if value, ok := parameterValue(parameter, "collection"); ok {
+			if value != collectionPostman && value != collectionInsomnia {
+				c.logger.WithField("collection", value).Warn("Ignoring invalid collection format")
+				continue
+			}
+			c.collectionFormat = value
+		}
+
+		// Configure which title and description wins when a field has them in both comments and options ("docs_precedence=options", "docs_precedence=comments" or "docs_precedence=concatenate"):
+		if value, ok := parameterValue(parameter, "docs_precedence"); ok {
+			if value != docsPrecedenceOptions && value != docsPrecedenceComments && value != docsPrecedenceConcatenate {
+				c.logger.WithField("docs_precedence", value).Warn("Ignoring invalid docs precedence")
+				continue
+			}
+			c.docsPrecedence = value
+		}
+
+		// Configure how the protojson consumers of schemas treat unknown fields ("unknown_fields=discard" or "unknown_fields=reject"):
+		if value, ok := parameterValue(parameter, "unknown_fields"); ok {
+			if value != unknownFieldsDiscard && value != unknownFieldsReject {
+				c.logger.WithField("unknown_fields", value).Warn("Ignoring invalid unknown fields policy")
+				continue
+			}
+			c.unknownFieldsPolicy = value
+		}
+
+		// Configure the JSON-Schema draft (or OpenAPI flavour) to target (eg "schema_version=2020-12"):
+		if value, ok := parameterValue(parameter, "schema_version"); ok {
+			if _, ok := schemaVersions[value]; !ok {
+				c.logger.WithField("schema_version", value).Warn("Ignoring unsupported schema version")
+				continue
+			}
+			c.targetSchemaVersion = value
+		}
+
+		// Configure a root message, to be the only schema generated from files containing it (eg "root=Order"):
+		if value, ok := parameterValue(parameter, "root"); ok {
+			c.rootMessage = value
+		}
+
+		// Configure which (google.api.*_visibility) restricted elements to include (eg "visibility_labels=INTERNAL+PREVIEW"):
+		if value, ok := parameterValue(parameter, "visibility_labels"); ok {
+			c.visibilityLabels = append(c.visibilityLabels, strings.Split(value, messageDelimiter)...)
+		}
+
+		// Configure ranges of field numbers to leave out of schemas (eg "exclude_field_numbers=9000-9999+42"):
+		if value, ok := parameterValue(parameter, "exclude_field_numbers"); ok {
+			for _, rangeValue := range strings.Split(value, messageDelimiter) {
+				excludedRange, err := parseFieldNumberRange(rangeValue)
+				if err != nil {
+					c.logger.WithError(err).WithField("exclude_field_numbers", value).Warn("Ignoring invalid field number range")
+					continue
+				}
+				c.excludedFieldNumbers = append(c.excludedFieldNumbers, excludedRange)
+			}
+		}
+
+		// Configure the number of properties above which messages get split into composed subschemas (eg "split_threshold=50"):
+		if value, ok := parameterValue(parameter, "split_threshold"); ok {
+			splitThreshold, err := strconv.Atoi(value)
+			if err != nil || splitThreshold < 1 {
+				c.logger.WithField("split_threshold", value).Warn("Ignoring invalid split threshold")
+				continue
+			}
+			c.splitThreshold = splitThreshold
+		}
+
+		// Configure how many resolved type names to remember (eg "type_cache_size=1000", or "type_cache_size=0" to not cache them):
+		if value, ok := parameterValue(parameter, "type_cache_size"); ok {
+			typeCacheSize, err := strconv.Atoi(value)
+			if err != nil || typeCacheSize < 0 {
+				c.logger.WithField("type_cache_size", value).Warn("Ignoring invalid type cache size")
+				continue
+			}
+			c.typeCacheSize = typeCacheSize
+		}
+
+		// Configure how deeply messages may be nested before they get truncated (eg "max_depth=20", or "max_depth=0" for no limit):
+		if value, ok := parameterValue(parameter, "max_depth"); ok {
+			maxDepth, err := strconv.Atoi(value)
+			if err != nil || maxDepth < 0 {
+				c.logger.WithField("max_depth", value).Warn("Ignoring invalid maximum depth")
+				continue
+			}
+			c.maxDepth = maxDepth
+		}
+
+		// Configure messages to hoist into a shared schema (eg "shared_messages=acme.RequestHeader+acme.EventMetadata,shared_schema_file=common.json"):
+		if value, ok := parameterValue(parameter, "shared_messages"); ok {
+			c.sharedMessages = append(c.sharedMessages, strings.Split(value, messageDelimiter)...)
+		}
+		if value, ok := parameterValue(parameter, "shared_schema_file"); ok {
+			c.sharedSchemaFileName = value
+		}
+
+		// Configure a base URL for the IDs of versioned schemas (eg "schema_id_base=https://schemas.acme.com"):
+		if value, ok := parameterValue(parameter, "schema_id_base"); ok {
+			c.schemaIDBase = value
+		}
+
+		// Configure templates for the IDs and titles of schemas (eg "id_template=https://schemas.acme.com/{env}/{package}/{message}.json,template_var=env=prod"):
+		if value, ok := parameterValue(parameter, "id_template"); ok {
+			c.idTemplate = value
+		}
+		if value, ok := parameterValue(parameter, "title_template"); ok {
+			c.titleTemplate = value
+		}
+		if value, ok := parameterValue(parameter, "template_var"); ok {
+			name, variableValue, err := parseTemplateVariable(value)
+			if err != nil {
+				c.logger.WithError(err).Warn("Ignoring invalid template variable")
+				continue
+			}
+			if c.templateVariables == nil {
+				c.templateVariables = make(map[string]string)
+			}
+			c.templateVariables[name] = variableValue
+		}
+
+		// Configure the source revision to stamp schemas with (eg "source_revision=$(git describe --always --dirty)"):
+		if value, ok := parameterValue(parameter, "source_revision"); ok {
+			c.sourceRevision = value
+		}
+
+		// Configure a key to sign checksums with:
+		if value, ok := parameterValue(parameter, "signing_key"); ok {
+			c.signingKeyFileName = value
+		}
+
+		// Configure a cache file for incremental generation:
+		if value, ok := parameterValue(parameter, "cache_file"); ok {
+			c.cacheFileName = value
+		}
+
+		// Configure a directory to write generated schemas straight into (instead of handing them back to protoc):
+		if value, ok := parameterValue(parameter, "output_dir"); ok {
+			c.outputDirectory = value
+		}
+
+		// Configure an external command to post-process generated schemas:
+		if value, ok := parameterValue(parameter, "post_process_cmd"); ok {
+			c.postProcessCommand = value
+		}
+
+		// Configure publishing of generated schemas:
+		if value, ok := parameterValue(parameter, "publish_url"); ok {
+			c.publishURL = value
+		}
+		if value, ok := parameterValue(parameter, "publish_version"); ok {
+			c.publishVersion = value
+		}
+		if value, ok := parameterValue(parameter, "publish_state_file"); ok {
+			c.publishStateFileName = value
+		}
+
+		// Configure how publishing is paced (eg "publish_batch_size=20,publish_rate=50,publish_retries=6"):
+		if value, ok := parameterValue(parameter, "publish_batch_size"); ok {
+			publishBatchSize, err := strconv.Atoi(value)
+			if err != nil || publishBatchSize < 1 {
+				c.logger.WithField("publish_batch_size", value).Warn("Ignoring invalid publish batch size")
+				continue
+			}
+			c.publishBatchSize = publishBatchSize
+		}
+		if value, ok := parameterValue(parameter, "publish_rate"); ok {
+			publishRate, err := strconv.ParseFloat(value, 64)
+			if err != nil || publishRate <= 0 {
+				c.logger.WithField("publish_rate", value).Warn("Ignoring invalid publish rate")
+				continue
+			}
+			c.publishRate = publishRate
+		}
+		if value, ok := parameterValue(parameter, "publish_retries"); ok {
+			publishRetries, err := strconv.Atoi(value)
+			if err != nil || publishRetries < 0 {
+				c.logger.WithField("publish_retries", value).Warn("Ignoring invalid number of publish retries")
+				continue
+			}
+			c.publishRetries = publishRetries
+		}
+
+		// Configure output routes (eg "route=acme.api.*=public-schemas/;disallow_additional_properties"):
+		if value, ok := parameterValue(parameter, "route"); ok {
+			route, err := parseOutputRoute(value)
+			if err != nil {
+				c.logger.WithError(err).WithField("route", value).Warn("Ignoring invalid output route")
+				continue
+			}
+			c.outputRoutes = append(c.outputRoutes, route)
+		}
 	}
 }
 
+// splitGeneratorParameters splits up the generator parameters (which protoc joins together with commas from repeated
+// --jsonschema_opt flags). Values can be double-quoted (eg `post_process_cmd="jq -c ."`) to contain commas and equals signs,
+// with backslashes escaping quotes (or backslashes) inside them:
+func splitGeneratorParameters(parameters string) ([]string, error) {
+	var splitParameters []string
+	var parameter strings.Builder
+	var escaped, quoted bool
+
+	for _, r := range parameters {
+		switch {
+		case escaped:
+			parameter.WriteRune(r)
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case quoted && r == '"':
+			quoted = false
+		case quoted:
+			parameter.WriteRune(r)
+		case r == ',':
+			if parameter.Len() > 0 {
+				splitParameters = append(splitParameters, parameter.String())
+			}
+			parameter.Reset()
+		case r == '"' && (parameter.Len() == 0 || strings.HasSuffix(parameter.String(), "=")):
+			quoted = true // Only values which start with a quote are quoted
+		default:
+			parameter.WriteRune(r)
+		}
+	}
+	if parameter.Len() > 0 {
+		splitParameters = append(splitParameters, parameter.String())
+	}
+
+	if quoted {
+		return splitParameters, fmt.Errorf("unterminated quote in parameters: %s", parameters)
+	}
+	return splitParameters, nil
+}
+
+// parameterValue returns the value of a "name=value" generator parameter:
+func parameterValue(parameter, name string) (string, bool) {
+	if !strings.HasPrefix(parameter, name+"=") {
+		return "", false
+	}
+	return strings.TrimPrefix(parameter, name+"="), true
+}
+
+// set turns a flag on or off by its generator parameter name (returning false if there is no such flag):
+func (f *ConverterFlags) set(name string, value bool) bool {
+	field, ok := flagFields[name]
+	if !ok {
+		return false
+	}
+	reflect.ValueOf(f).Elem().Field(field).SetBool(value)
+	return true
+}
+
 // Converts a proto "ENUM" into a JSON-Schema:
 func (c *Converter) convertEnumType(enum *descriptor.EnumDescriptorProto, converterFlags ConverterFlags) (jsonschema.Type, error) {
 
@@ -144,7 +598,11 @@ func (c *Converter) convertEnumType(enum *descriptor.EnumDescriptorProto, conver
 	jsonSchemaType := jsonschema.Type{}
 
 	// Inherit the CLI converterFlags:
+	converterFlags.EnumsAsIntegersOnly = c.Flags.EnumsAsIntegersOnly
 	converterFlags.EnumsAsStringsOnly = c.Flags.EnumsAsStringsOnly
+	converterFlags.EnumsAllowLowercase = c.Flags.EnumsAllowLowercase
+	converterFlags.EnumsExcludeUnspecified = c.Flags.EnumsExcludeUnspecified
+	converterFlags.EnumsTrimPrefix = converterFlags.EnumsTrimPrefix || c.Flags.EnumsTrimPrefix // Fields can ask for this too
 
 	// Set some per-enum flags from config and options:
 	if opts := enum.GetOptions(); opts != nil && proto.HasExtension(opts, protoc_gen_jsonschema.E_EnumOptions) {
@@ -156,6 +614,11 @@ func (c *Converter) convertEnumType(enum *descriptor.EnumDescriptorProto, conver
 					converterFlags.EnumsAsConstants = true
 				}
 
+				// ENUM values as integers only:
+				if enumOptions.GetEnumsAsIntegersOnly() {
+					converterFlags.EnumsAsIntegersOnly = true
+				}
+
 				// ENUM values as strings only:
 				if enumOptions.GetEnumsAsStringsOnly() {
 					converterFlags.EnumsAsStringsOnly = true
@@ -166,6 +629,16 @@ func (c *Converter) convertEnumType(enum *descriptor.EnumDescriptorProto, conver
 					converterFlags.EnumsTrimPrefix = true
 				}
 
+				// ENUM values also accept lowercase names:
+				if enumOptions.GetEnumsAllowLowercase() {
+					converterFlags.EnumsAllowLowercase = true
+				}
+
+				// ENUM values leave out UNSPECIFIED:
+				if enumOptions.GetEnumsExcludeUnspecified() {
+					converterFlags.EnumsExcludeUnspecified = true
+				}
+
 				// If this particular ENUM is marked with the "ignore" option then return a skipped error:
 				if enumOptions.GetIgnore() {
 					c.logger.WithField("msg_name", enum.GetName()).Debug("Skipping ignored enum")
@@ -177,11 +650,13 @@ func (c *Converter) convertEnumType(enum *descriptor.EnumDescriptorProto, conver
 
 	// Generate a description from src comments (if available):
 	if src := c.sourceInfo.GetEnum(enum); src != nil {
-		jsonSchemaType.Title, jsonSchemaType.Description = c.formatTitleAndDescription(strPtr(enum.GetName()), src)
+		var description string
+		jsonSchemaType.Title, description = c.formatTitleAndDescription(strPtr(enum.GetName()), src)
+		c.setDescription(&jsonSchemaType, description)
 	}
 
 	// Use basic types if we're not opting to use constants for ENUMs:
-	if !converterFlags.EnumsAsConstants {
+	if !converterFlags.EnumsAsConstants && !converterFlags.EnumsAsIntegersOnly {
 		jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: gojsonschema.TYPE_STRING})
 		if !converterFlags.EnumsAsStringsOnly {
 			jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: gojsonschema.TYPE_INTEGER})
@@ -194,7 +669,7 @@ func (c *Converter) convertEnumType(enum *descriptor.EnumDescriptorProto, conver
 	}
 
 	// If we end up with just one option in OneOf, unwrap it
-	if len(jsonSchemaType.OneOf) == 1 {
+	if len(jsonSchemaType.OneOf) == 1 && !converterFlags.EnumsAsIntegersOnly {
 		jsonSchemaType.Type = jsonSchemaType.OneOf[0].Type
 		jsonSchemaType.OneOf = nil
 	}
@@ -213,22 +688,51 @@ func (c *Converter) convertEnumType(enum *descriptor.EnumDescriptorProto, conver
 
 		valueName := value.GetName()
 
+		// The zero "UNSPECIFIED" value can be left out (so that it gets rejected):
+		if converterFlags.EnumsExcludeUnspecified && value.GetNumber() == 0 && (valueName == "UNSPECIFIED" || strings.HasSuffix(valueName, "_UNSPECIFIED")) {
+			continue
+		}
+
 		// If enum name prefix should be removed from enum value name:
 		if converterFlags.EnumsTrimPrefix {
 			valueName = strings.TrimPrefix(valueName, enumNamePrefix)
 		}
 
+		// Lenient ENUMs also accept a lowercase variant of the value name (for sources which lowercase their ENUM strings):
+		var lowercaseValueName string
+		if converterFlags.EnumsAllowLowercase && strings.ToLower(valueName) != valueName {
+			lowercaseValueName = strings.ToLower(valueName)
+		}
+
+		// Integer-only ENUMs are a list of numbers, each described by its value name (and comments):
+		if converterFlags.EnumsAsIntegersOnly {
+			if valueDescription != "" {
+				valueDescription = fmt.Sprintf("%s: %s", valueName, valueDescription)
+			} else {
+				valueDescription = valueName
+			}
+			c.schemaVersion = versionDraft06 // Const requires draft-06
+			jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, c.enumConstant(value.GetNumber(), valueDescription))
+			continue
+		}
+
 		// If we're using constants for ENUMs then add these here, along with their title:
 		if converterFlags.EnumsAsConstants {
 			c.schemaVersion = versionDraft06 // Const requires draft-06
-			jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Extras: map[string]interface{}{"const": valueName}, Description: valueDescription})
+			jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, c.enumConstant(valueName, valueDescription))
+			if lowercaseValueName != "" {
+				jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, c.enumConstant(lowercaseValueName, valueDescription))
+			}
 			if !converterFlags.EnumsAsStringsOnly {
-				jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Extras: map[string]interface{}{"const": value.GetNumber()}, Description: valueDescription})
+				jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, c.enumConstant(value.GetNumber(), valueDescription))
 			}
 		}
 
 		// Add the values to the ENUM:
 		jsonSchemaType.Enum = append(jsonSchemaType.Enum, valueName)
+		if lowercaseValueName != "" {
+			jsonSchemaType.Enum = append(jsonSchemaType.Enum, lowercaseValueName)
+		}
 		if !converterFlags.EnumsAsStringsOnly {
 			jsonSchemaType.Enum = append(jsonSchemaType.Enum, value.Number)
 		}
@@ -237,6 +741,13 @@ func (c *Converter) convertEnumType(enum *descriptor.EnumDescriptorProto, conver
 	return jsonSchemaType, nil
 }
 
+// enumConstant makes a "const" schema for an ENUM value (described by its comments):
+func (c *Converter) enumConstant(value interface{}, description string) *jsonschema.Type {
+	jsonSchemaType := &jsonschema.Type{Extras: map[string]interface{}{"const": value}}
+	c.setDescription(jsonSchemaType, description)
+	return jsonSchemaType
+}
+
 // Converts a proto file into a JSON-Schema:
 func (c *Converter) convertFile(file *descriptor.FileDescriptorProto, fileExtension string) ([]*plugin.CodeGeneratorResponse_File, error) {
 
@@ -246,11 +757,24 @@ func (c *Converter) convertFile(file *descriptor.FileDescriptorProto, fileExtens
 	// Prepare a list of responses:
 	var response []*plugin.CodeGeneratorResponse_File
 
+	// Deprecated files can be left out altogether:
+	if c.skippedAsDeprecated(file.GetOptions().GetDeprecated()) {
+		c.logger.WithField("proto_filename", protoFileName).Debug("Skipping deprecated file")
+		return nil, nil
+	}
+
 	// user wants specific messages
 	genSpecificMessages := len(c.messageTargets) > 0
 
+	// Some files only have one message which represents the payload:
+	rootMessage, err := c.fileRootMessage(file)
+	if err != nil {
+		c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to find root message")
+		return nil, err
+	}
+
 	// Warn about multiple messages / enums in files:
-	if !genSpecificMessages && len(file.GetMessageType()) > 1 {
+	if !genSpecificMessages && rootMessage == "" && c.bundle == "" && len(file.GetMessageType()) > 1 {
 		c.logger.WithField("schemas", len(file.GetMessageType())).WithField("proto_filename", protoFileName).Debug("protoc-gen-jsonschema will create multiple MESSAGE schemas from one proto file")
 	}
 
@@ -261,11 +785,19 @@ func (c *Converter) convertFile(file *descriptor.FileDescriptorProto, fileExtens
 	// Generate standalone ENUMs:
 	if len(file.GetMessageType()) == 0 {
 		for _, enum := range file.GetEnumType() {
+			if c.skippedAsDeprecated(enum.GetOptions().GetDeprecated()) {
+				c.logger.WithField("enum_name", enum.GetName()).Debug("Skipping deprecated enum")
+				continue
+			}
+
 			jsonSchemaFileName := c.generateSchemaFilename(file, fileExtension, enum.GetName())
 			c.logger.WithField("proto_filename", protoFileName).WithField("enum_name", enum.GetName()).WithField("jsonschema_filename", jsonSchemaFileName).Info("Generating JSON-schema for stand-alone ENUM")
 
 			// Convert the ENUM:
+			c.reportMessage(qualifiedName(file.GetPackage(), enum.GetName()))
+			started := time.Now()
 			enumJSONSchema, err := c.convertEnumType(enum, ConverterFlags{})
+			c.reportTiming(phaseRecursion, started)
 			if err != nil {
 				switch err {
 				case errIgnored:
@@ -276,31 +808,39 @@ func (c *Converter) convertFile(file *descriptor.FileDescriptorProto, fileExtens
 				}
 			}
 			enumJSONSchema.Version = c.schemaVersion
+			c.stampDeprecated(file, enum.GetOptions().GetDeprecated(), &enumJSONSchema)
+			c.embedPackageVersion(file.GetPackage(), jsonSchemaFileName, &enumJSONSchema, nil)
+			c.applySchemaTemplates(file.GetPackage(), enum.GetName(), jsonSchemaFileName, &enumJSONSchema, nil)
 
-			// Marshal the JSON-Schema into JSON:
-			jsonSchemaJSON, err := json.MarshalIndent(enumJSONSchema, "", "    ")
+			// Add a response:
+			resFile, err := c.generateResponseFile(jsonSchemaFileName, &enumJSONSchema)
 			if err != nil {
-				c.logger.WithError(err).Error("Failed to encode jsonSchema")
 				return nil, err
 			}
-
-			// Add a response:
-			resFile := &plugin.CodeGeneratorResponse_File{
-				Name:    proto.String(jsonSchemaFileName),
-				Content: proto.String(string(jsonSchemaJSON)),
-			}
 			response = append(response, resFile)
+			c.schemaIndex[qualifiedName(file.GetPackage(), enum.GetName())] = jsonSchemaFileName
 		}
 	} else {
 		// Otherwise process MESSAGES (packages):
-		pkg, ok := c.relativelyLookupPackage(globalPkg, file.GetPackage())
+		pkg, ok := c.relativelyLookupPackage(c.rootPkg, file.GetPackage())
 		if !ok {
 			return nil, fmt.Errorf("no such package found: %s", file.GetPackage())
 		}
 
+		// Bundles carry all of the messages in one schema:
+		if c.bundle != "" {
+			return c.convertBundle(pkg, file, fileExtension, rootMessage)
+		}
+
 		// Go through all of the messages in this file:
 		for _, msgDesc := range file.GetMessageType() {
 
+			// Schemas can be named after the type URLs of their messages (eg "type.googleapis.com/acme.v1.Order.json"):
+			schemaName := msgDesc.GetName()
+			if c.Flags.TypeURLNames {
+				schemaName = typeURL(file.GetPackage(), msgDesc.GetName())
+			}
+
 			// Check for our custom message options:
 			if opts := msgDesc.GetOptions(); opts != nil && proto.HasExtension(opts, protoc_gen_jsonschema.E_MessageOptions) {
 				if opt := proto.GetExtension(opts, protoc_gen_jsonschema.E_MessageOptions); opt != nil {
@@ -311,51 +851,276 @@ func (c *Converter) convertFile(file *descriptor.FileDescriptorProto, fileExtens
 							c.logger.WithField("msg_name", msgDesc.GetName()).Debug("Skipping ignored message")
 							continue
 						}
+
+						// Messages can choose the filename of their schema:
+						if messageOptions.GetSchemaFilename() != "" {
+							schemaName = messageOptions.GetSchemaFilename()
+						}
 					}
 				}
 			}
 
+			// Alpha (or restricted) messages can be left out of (public) schemas:
+			if c.excludedMessage(msgDesc) {
+				c.logger.WithField("msg_name", msgDesc.GetName()).Debug("Skipping excluded message")
+				continue
+			}
+
 			// skip if we are only generating schema for specific messages
 			if genSpecificMessages && !contains(c.messageTargets, msgDesc.GetName()) {
 				continue
 			}
 
+			// Skip everything but the root message (if this file has one):
+			if rootMessage != "" && msgDesc.GetName() != rootMessage {
+				continue
+			}
+
 			// Convert the message:
+			c.reportMessage(qualifiedName(file.GetPackage(), msgDesc.GetName()))
 			messageJSONSchema, err := c.convertMessageType(pkg, msgDesc)
 			if err != nil {
 				c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to convert")
 				return nil, err
 			}
 
+			// The root message carries the rest of the file's messages as definitions:
+			if rootMessage != "" {
+				if err := c.addFileDefinitions(pkg, file, msgDesc, messageJSONSchema); err != nil {
+					c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to convert")
+					return nil, err
+				}
+			}
+
+			// Optionally prepare a "<Message>List" schema too (before any envelope gets involved):
+			var listJSONSchema *jsonschema.Schema
+			if c.Flags.GenerateListSchemas {
+				listJSONSchema = c.listSchema(msgDesc, messageJSONSchema)
+			}
+
+			// Optionally wrap the message in an envelope:
+			if c.envelopeMessage != "" {
+				if messageJSONSchema, err = c.wrapInEnvelope(msgDesc, messageJSONSchema); err != nil {
+					c.logger.WithError(err).WithField("proto_filename", protoFileName).WithField("envelope", c.envelopeMessage).Error("Failed to wrap in envelope")
+					return nil, err
+				}
+			}
+
 			// Generate a schema filename:
-			jsonSchemaFileName := c.generateSchemaFilename(file, fileExtension, msgDesc.GetName())
+			jsonSchemaFileName := c.generateSchemaFilename(file, fileExtension, schemaName)
 			c.logger.WithField("proto_filename", protoFileName).WithField("msg_name", msgDesc.GetName()).WithField("jsonschema_filename", jsonSchemaFileName).Info("Generating JSON-schema for MESSAGE")
 
-			// Marshal the JSON-Schema into JSON:
-			jsonSchemaJSON, err := json.MarshalIndent(messageJSONSchema, "", "    ")
+			// Add a response:
+			c.stampDeprecated(file, msgDesc.GetOptions().GetDeprecated(), messageJSONSchema.Type)
+			c.stampSchemaID(file.GetPackage(), msgDesc.GetName(), jsonSchemaFileName, messageJSONSchema.Type)
+			c.stampTypeURL(file.GetPackage(), msgDesc.GetName(), messageJSONSchema.Type)
+			c.embedPackageVersion(file.GetPackage(), jsonSchemaFileName, messageJSONSchema.Type, messageJSONSchema.Definitions)
+			c.applySchemaTemplates(file.GetPackage(), msgDesc.GetName(), jsonSchemaFileName, messageJSONSchema.Type, messageJSONSchema.Definitions)
+			resFile, err := c.generateResponseFile(jsonSchemaFileName, messageJSONSchema)
 			if err != nil {
-				c.logger.WithError(err).Error("Failed to encode jsonSchema")
 				return nil, err
 			}
 
-			// Add a response:
-			resFile := &plugin.CodeGeneratorResponse_File{
-				Name:    proto.String(jsonSchemaFileName),
-				Content: proto.String(string(jsonSchemaJSON)),
+			// Messages can make assertions about their schemas, which fail generation if they don't hold:
+			if err := c.assertSchema(msgDesc, []byte(resFile.GetContent())); err != nil {
+				c.logger.WithError(err).WithField("jsonschema_filename", jsonSchemaFileName).Error("JSON-Schema failed its assertions")
+				return nil, err
 			}
 			response = append(response, resFile)
+			c.schemaIndex[qualifiedName(file.GetPackage(), msgDesc.GetName())] = jsonSchemaFileName
+
+			// Add a response for the list schema:
+			if listJSONSchema != nil {
+				listJSONSchemaFileName := c.generateSchemaFilename(file, fileExtension, schemaName+listSchemaSuffix)
+				c.logger.WithField("proto_filename", protoFileName).WithField("msg_name", msgDesc.GetName()).WithField("jsonschema_filename", listJSONSchemaFileName).Info("Generating JSON-schema for MESSAGE list")
+				c.stampDeprecated(file, msgDesc.GetOptions().GetDeprecated(), listJSONSchema.Type)
+				c.embedPackageVersion(file.GetPackage(), listJSONSchemaFileName, listJSONSchema.Type, listJSONSchema.Definitions)
+				c.applySchemaTemplates(file.GetPackage(), msgDesc.GetName()+listSchemaSuffix, listJSONSchemaFileName, listJSONSchema.Type, listJSONSchema.Definitions)
+				resFile, err := c.generateResponseFile(listJSONSchemaFileName, listJSONSchema)
+				if err != nil {
+					return nil, err
+				}
+				response = append(response, resFile)
+			}
+		}
+	}
+
+	// Services can be described too (in OpenAPI output, as hyper-schema links, or as client collections):
+	if len(file.GetService()) > 0 && (c.targetSchemaVersion == schemaVersionOpenAPI3 || c.Flags.HyperSchema || c.collectionFormat != "") {
+		pkg, ok := c.relativelyLookupPackage(c.rootPkg, file.GetPackage())
+		if !ok {
+			return nil, fmt.Errorf("no such package found: %s", file.GetPackage())
+		}
+		if c.targetSchemaVersion == schemaVersionOpenAPI3 {
+			resFile, err := c.convertServices(pkg, file, fileExtension)
+			if err != nil {
+				c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to convert services")
+				return nil, err
+			}
+			response = append(response, resFile)
+		}
+		if c.Flags.HyperSchema {
+			resFile, err := c.convertHyperSchema(pkg, file, fileExtension)
+			if err != nil {
+				c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to convert services")
+				return nil, err
+			}
+			if resFile != nil {
+				response = append(response, resFile)
+			}
+		}
+		if c.collectionFormat != "" {
+			resFile, err := c.convertCollection(pkg, file, fileExtension)
+			if err != nil {
+				c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to convert services")
+				return nil, err
+			}
+			if resFile != nil {
+				response = append(response, resFile)
+			}
 		}
 	}
 
 	return response, nil
 }
 
+// generateResponseFile marshals a JSON-Schema (linting and post-processing it along the way) into a response file:
+func (c *Converter) generateResponseFile(jsonSchemaFileName string, jsonSchema interface{}) (*plugin.CodeGeneratorResponse_File, error) {
+
+	// Tie the schema to the revision it was generated from:
+	c.stampSourceRevision(jsonSchema)
+
+	// Marshal the JSON-Schema into JSON:
+	started := time.Now()
+	jsonSchemaJSON, err := json.MarshalIndent(jsonSchema, "", "    ")
+	c.reportTiming(phaseMarshaling, started)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to encode jsonSchema")
+		return nil, err
+	}
+
+	// Refer to the shared schema from wherever this one is generated:
+	if jsonSchemaJSON, err = c.relativeSharedRefs(jsonSchemaFileName, jsonSchemaJSON); err != nil {
+		c.logger.WithError(err).Error("Failed to make shared schema references relative")
+		return nil, err
+	}
+
+	// Optionally leave out empty objects and arrays:
+	if c.Flags.OmitEmpty {
+		if jsonSchemaJSON, err = omitEmpty(jsonSchemaJSON); err != nil {
+			c.logger.WithError(err).Error("Failed to omit empty values from jsonSchema")
+			return nil, err
+		}
+	}
+
+	// Optionally declare alternative types with type arrays:
+	if c.Flags.TypeArrays {
+		if jsonSchemaJSON, err = typeArrays(jsonSchemaJSON); err != nil {
+			c.logger.WithError(err).Error("Failed to declare type arrays in jsonSchema")
+			return nil, err
+		}
+	}
+
+	// Optionally lint the JSON-Schema:
+	if c.Flags.Lint || c.Flags.LintStrict {
+		if err := c.lintSchema(jsonSchemaFileName, jsonSchemaJSON); err != nil {
+			c.logger.WithError(err).WithField("jsonschema_filename", jsonSchemaFileName).Error("JSON-Schema failed linting")
+			return nil, err
+		}
+	}
+
+	// Optionally convert the JSON-Schema for a specific draft (or OpenAPI):
+	if c.targetSchemaVersion != "" {
+		if jsonSchemaJSON, err = c.convertSchemaVersion(jsonSchemaFileName, jsonSchemaJSON); err != nil {
+			c.logger.WithError(err).WithField("schema_version", c.targetSchemaVersion).Error("Failed to convert jsonSchema")
+			return nil, err
+		}
+	}
+
+	return c.responseFile(jsonSchemaFileName, jsonSchemaJSON)
+}
+
+// responseFile makes a response file from generated JSON (making it canonical and post-processing it along the way):
+func (c *Converter) responseFile(jsonSchemaFileName string, jsonSchemaJSON []byte) (*plugin.CodeGeneratorResponse_File, error) {
+	var err error
+
+	// Make the JSON canonical (so that it is byte-stable wherever it is generated):
+	if jsonSchemaJSON, err = c.generatedJSON(jsonSchemaJSON); err != nil {
+		c.logger.WithError(err).Error("Failed to canonicalise jsonSchema")
+		return nil, err
+	}
+
+	// Pass the JSON-Schema through any post-processing command:
+	jsonSchemaJSON, err = c.postProcessSchema(jsonSchemaFileName, jsonSchemaJSON)
+	if err != nil {
+		c.logger.WithError(err).WithField("jsonschema_filename", jsonSchemaFileName).Error("Failed to post-process jsonSchema")
+		return nil, err
+	}
+
+	return &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(jsonSchemaFileName),
+		Content: proto.String(string(jsonSchemaJSON)),
+	}, nil
+}
+
 // convert processes a protoc CodeGeneratorRequest:
 func (c *Converter) convert(request *plugin.CodeGeneratorRequest) (*plugin.CodeGeneratorResponse, error) {
 	response := &plugin.CodeGeneratorResponse{}
 
-	// Parse the various generator parameter flags:
-	c.parseGeneratorParameters(request.GetParameter())
+	// Parse the various generator parameter flags (any we were given as options first):
+	defer c.startConversion(c.defaultParameters + "," + request.GetParameter())()
+
+	// The source revision can also come from the environment:
+	if c.sourceRevision == "" {
+		c.sourceRevision = os.Getenv(envSourceRevision)
+	}
+
+	// Load the config file (if we have one):
+	if c.configFileName != "" {
+		config, err := loadConverterConfig(c.configFileName)
+		if err != nil {
+			c.logger.WithError(err).WithField("config_file", c.configFileName).Error("Failed to load config file")
+			response.Error = proto.String(fmt.Sprintf("Failed to load config file %s: %v", c.configFileName, err))
+			return response, err
+		}
+		c.config = config
+	}
+
+	// Load the signing key (if we have one), before there's anything to sign:
+	if c.signingKeyFileName != "" {
+		signingKey, err := loadSigningKey(c.signingKeyFileName)
+		if err != nil {
+			c.logger.WithError(err).WithField("signing_key", c.signingKeyFileName).Error("Failed to load signing key")
+			response.Error = proto.String(fmt.Sprintf("Failed to load signing key %s: %v", c.signingKeyFileName, err))
+			return response, err
+		}
+		c.signingKey = signingKey
+	}
+
+	// Load the rules file (if we have one):
+	if c.rulesFileName != "" {
+		namingRules, err := loadNamingRules(c.rulesFileName)
+		if err != nil {
+			c.logger.WithError(err).WithField("rules_file", c.rulesFileName).Error("Failed to load rules file")
+			response.Error = proto.String(fmt.Sprintf("Failed to load rules file %s: %v", c.rulesFileName, err))
+			return response, err
+		}
+		c.namingRules = namingRules
+	}
+
+	// Start a fresh index of generated schemas (and a fresh tree of packages, so nothing leaks in from a previous request):
+	c.schemaIndex = make(map[string]string)
+	c.rootPkg = newProtoPackage(nil, "")
+	c.resolvedTypes = newLookupCache(c.typeCacheSize)
+	c.definitionCache = nil
+	c.packageBundles = nil
+	c.proto2Messages = make(map[*descriptor.DescriptorProto]bool)
+
+	// Optionally report on generation (and how long it takes):
+	c.report = nil
+	if c.Flags.GenerateReport {
+		c.report = &generationReport{}
+	}
 
 	// Prepare a list of target files:
 	generateTargets := make(map[string]bool)
@@ -366,8 +1131,43 @@ func (c *Converter) convert(request *plugin.CodeGeneratorRequest) (*plugin.CodeG
 	// Get the source-code info (we use this to map any code comments to JSONSchema descriptions):
 	c.sourceInfo = newSourceCodeInfo(request.GetProtoFile())
 
-	// Go through the list of proto files provided by protoc:
+	// Resolve any custom options defined in the request (so that unknown options, and protovalidate's, can be decoded):
+	c.extensionTypes = nil
+	if c.Flags.PreserveUnknownOptions || usesProtovalidate(request.GetProtoFile()) {
+		extensionTypes, err := newExtensionResolver(request.GetProtoFile())
+		if err != nil {
+			c.logger.WithError(err).Warn("Unable to resolve custom options - unknown options will be preserved as raw values")
+		}
+		c.extensionTypes = extensionTypes
+	}
+
+	// Optionally load a cache of previous outputs (so that unchanged files can be skipped):
+	var cache *generationCache
+	var fileDigests map[string]string
+	if c.cacheFileName != "" {
+		var err error
+		if cache, err = loadGenerationCache(c.cacheFileName); err != nil {
+			c.logger.WithError(err).WithField("cache_file", c.cacheFileName).Warn("Unable to load generation cache - ignoring it")
+			cache = &generationCache{Files: make(map[string]*generationCacheEntry)}
+		}
+
+		settings, err := c.cacheSettings(request.GetParameter())
+		if err == nil {
+			fileDigests, err = digestFileDescriptors(settings, request.GetProtoFile())
+		}
+		if err != nil {
+			response.Error = proto.String(fmt.Sprintf("Failed to digest proto files: %v", err))
+			return response, err
+		}
+	}
+
+	// Go through the list of proto files provided by protoc (skipping any which the files we're generating don't import):
+	fileReachable := reachableFiles(request)
 	for _, fileDesc := range request.GetProtoFile() {
+		if !fileReachable[fileDesc.GetName()] {
+			c.logger.WithField("file_name", fileDesc.GetName()).Debug("Skipping unreachable file")
+			continue
+		}
 
 		// Start with the default / global file extension:
 		fileExtension := c.schemaFileExtension
@@ -392,17 +1192,12 @@ func (c *Converter) convert(request *plugin.CodeGeneratorRequest) (*plugin.CodeG
 			}
 		}
 
-		// Check that this file has a proto package, and give it one if not:
-		if fileDesc.GetPackage() == "" {
-			c.logger.WithField("filename", fileDesc.GetName()).WithField("default_package_name", defaultPackageName).Debug("Proto file doesn't specify a package - assuming the default")
-			fileDesc.Package = strPtr(defaultPackageName)
-		}
-
 		// Build a list of any messages specified by this file:
 		for _, msgDesc := range fileDesc.GetMessageType() {
 			c.logger.WithField("msg_name", msgDesc.GetName()).WithField("package_name", fileDesc.GetPackage()).Debug("Loading a message")
 			c.registerType(fileDesc.GetPackage(), msgDesc)
 		}
+		c.markProto2Messages(fileDesc)
 
 		// Build a list of any enums specified by this file:
 		for _, en := range fileDesc.GetEnumType() {
@@ -412,14 +1207,153 @@ func (c *Converter) convert(request *plugin.CodeGeneratorRequest) (*plugin.CodeG
 
 		// Generate schemas for this file:
 		if _, ok := generateTargets[fileDesc.GetName()]; ok {
+
+			// Re-use the previous outputs if this file hasn't changed (package bundles need every file converting though):
+			if cache != nil && c.bundle != bundlePackage {
+				if entry, ok := cache.lookup(fileDesc.GetName(), fileDigests[fileDesc.GetName()]); ok {
+					c.logger.WithField("filename", fileDesc.GetName()).Debug("File is unchanged - using cached schemas")
+					c.reportFile(fileDesc.GetName(), true)()
+					for fullName, schemaFileName := range entry.Index {
+						c.schemaIndex[fullName] = schemaFileName
+					}
+					response.File = append(response.File, entry.responseFiles()...)
+					continue
+				}
+			}
+
+			// Package profiles and output routes can bring their own flags:
+			defaultFlags := c.Flags
+			flagSources := c.applyPackageFlags(fileDesc.GetPackage())
+			if c.Flags.ExplainConfig {
+				c.explainFileConfig(fileDesc.GetName(), flagSources)
+			}
+
 			c.logger.WithField("filename", fileDesc.GetName()).Debug("Converting file")
+			finishFileReport := c.reportFile(fileDesc.GetName(), false)
 			converted, err := c.convertFile(fileDesc, fileExtension)
+			finishFileReport()
+			c.Flags = defaultFlags
 			if err != nil {
 				response.Error = proto.String(fmt.Sprintf("Failed to convert %s: %v", fileDesc.GetName(), err))
 				return response, err
 			}
 			response.File = append(response.File, converted...)
+
+			if cache != nil {
+				cache.store(fileDesc.GetName(), fileDigests[fileDesc.GetName()], c.schemaIndex, converted)
+			}
+		}
+	}
+
+	// Generate the bundles of packages (now that they have all of their files' messages):
+	for _, bundle := range c.packageBundles {
+		resFile, err := c.generateBundle(bundle)
+		if err != nil {
+			response.Error = proto.String(fmt.Sprintf("Failed to generate bundle for %s: %v", bundle.name, err))
+			return response, err
+		}
+		response.File = append(response.File, resFile)
+	}
+
+	// Save the cache for next time:
+	if cache != nil {
+		if err := cache.save(c.cacheFileName); err != nil {
+			c.logger.WithError(err).WithField("cache_file", c.cacheFileName).Warn("Unable to save generation cache")
+		}
+	}
+
+	// Generate the shared schema (which other schemas reference shared messages in):
+	if len(c.sharedMessages) > 0 {
+		sharedJSONSchema, err := c.sharedSchema()
+		if err != nil {
+			c.logger.WithError(err).Error("Failed to convert shared messages")
+			response.Error = proto.String(fmt.Sprintf("Failed to convert shared messages: %v", err))
+			return response, err
+		}
+		resFile, err := c.generateResponseFile(c.sharedSchemaFile(), sharedJSONSchema)
+		if err != nil {
+			response.Error = proto.String(fmt.Sprintf("Failed to generate %s: %v", c.sharedSchemaFile(), err))
+			return response, err
+		}
+		response.File = append(response.File, resFile)
+	}
+
+	// Optionally list the schemas in dependency order (for uploading to registries which validate references):
+	if c.Flags.GenerateUploadOrder && len(response.File) > 0 {
+		resFile, err := c.uploadOrderFile(response.File)
+		if err != nil {
+			c.logger.WithError(err).Error("Failed to encode upload order")
+			response.Error = proto.String(fmt.Sprintf("Failed to encode upload order: %v", err))
+			return response, err
+		}
+		response.File = append(response.File, resFile)
+	}
+
+	// Optionally add an index (mapping fully-qualified proto names to schema filenames):
+	if c.Flags.GenerateIndex && len(c.schemaIndex) > 0 {
+		indexJSON, err := json.MarshalIndent(c.schemaIndex, "", "    ")
+		if err == nil {
+			indexJSON, err = c.generatedJSON(indexJSON)
+		}
+		if err != nil {
+			c.logger.WithError(err).Error("Failed to encode schema index")
+			response.Error = proto.String(fmt.Sprintf("Failed to encode schema index: %v", err))
+			return response, err
 		}
+		response.File = append(response.File, &plugin.CodeGeneratorResponse_File{
+			Name:    proto.String(defaultIndexFileName),
+			Content: proto.String(string(indexJSON)),
+		})
+	}
+
+	// Optionally add a report:
+	if c.report != nil {
+		reportJSON, err := c.marshalReport()
+		if err != nil {
+			c.logger.WithError(err).Error("Failed to encode report")
+			response.Error = proto.String(fmt.Sprintf("Failed to encode report: %v", err))
+			return response, err
+		}
+		response.File = append(response.File, &plugin.CodeGeneratorResponse_File{
+			Name:    proto.String(defaultReportFileName),
+			Content: proto.String(string(reportJSON)),
+		})
+	}
+
+	// Optionally add checksums of everything we've generated (signed if we have a key), so that consumers can verify it:
+	if (c.Flags.GenerateChecksums || c.signingKey != nil) && len(response.File) > 0 {
+		checksums := checksumsFile(response.File)
+		response.File = append(response.File, checksums)
+		if c.signingKey != nil {
+			resFile, err := signatureFile(checksums, c.signingKey)
+			if err != nil {
+				c.logger.WithError(err).Error("Failed to sign checksums")
+				response.Error = proto.String(fmt.Sprintf("Failed to sign checksums: %v", err))
+				return response, err
+			}
+			response.File = append(response.File, resFile)
+		}
+	}
+
+	// Optionally write the generated files ourselves (so protoc is left with nothing to write):
+	if c.outputDirectory != "" {
+		if err := c.writeOutputFiles(response.File); err != nil {
+			c.logger.WithError(err).Error("Failed to write schemas")
+			response.Error = proto.String(fmt.Sprintf("Failed to write schemas: %v", err))
+			return response, err
+		}
+	}
+
+	// Optionally publish the generated schemas:
+	if c.publishURL != "" {
+		if err := c.publishSchemas(c.dependencyOrder(response.File)); err != nil {
+			c.logger.WithError(err).Error("Failed to publish schemas")
+			response.Error = proto.String(fmt.Sprintf("Failed to publish schemas: %v", err))
+			return response, err
+		}
+	}
+	if c.outputDirectory != "" {
+		response.File = nil
 	}
 
 	// This is required in order to "support" optional proto3 fields:
@@ -430,10 +1364,17 @@ func (c *Converter) convert(request *plugin.CodeGeneratorRequest) (*plugin.CodeG
 }
 
 func (c *Converter) generateSchemaFilename(file *descriptor.FileDescriptorProto, fileExtension, protoName string) string {
-	if c.Flags.PrefixSchemaFilesWithPackage {
-		return fmt.Sprintf("%s/%s.%s", file.GetPackage(), protoName, fileExtension)
+	schemaFilename := c.naming().SchemaFileName(file.GetPackage(), protoName, fileExtension)
+
+	// Versioned packages get their own directories:
+	schemaFilename = c.versionedSchemaFilename(file.GetPackage(), schemaFilename)
+
+	// Route the file into a different output root if its package matches:
+	if route, ok := c.lookupOutputRoute(file.GetPackage()); ok {
+		schemaFilename = path.Join(route.directory, schemaFilename)
 	}
-	return fmt.Sprintf("%s.%s", protoName, fileExtension)
+
+	return sanitizeFilePath(schemaFilename)
 }
 
 func contains(haystack []string, needle string) bool {
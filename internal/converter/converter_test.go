@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/iancoleman/strcase"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/xeipuuv/gojsonschema"
@@ -27,8 +28,11 @@ type sampleProto struct {
 	ExpectedFileNames     []string
 	ExpectedJSONSchema    []string
 	FilesToGenerate       []string
+	MissingImports        []string
+	Naming                NamingStrategy
 	ObjectsToValidateFail []string
 	ObjectsToValidatePass []string
+	Parameters            string
 	ProtoFileName         string
 	TargetedMessages      []string
 }
@@ -46,32 +50,53 @@ func TestGenerateJsonSchema(t *testing.T) {
 	}
 }
 
+func TestSplitGeneratorParameters(t *testing.T) {
+
+	// Repeated --jsonschema_opt flags get joined with commas (and quoted values keep theirs):
+	parameters, err := splitGeneratorParameters(`debug,post_process_cmd="jq -c '.a, .b'",route=acme.*="schemas/a,b",,title="say \"hi\""`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"debug", "post_process_cmd=jq -c '.a, .b'", "route=acme.*=schemas/a,b", `title=say "hi"`}, parameters)
+
+	// Quotes in the middle of values are left alone:
+	parameters, err = splitGeneratorParameters(`post_process_cmd=sed s/"a"/b/`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`post_process_cmd=sed s/"a"/b/`}, parameters)
+
+	// Unterminated quotes are reported:
+	_, err = splitGeneratorParameters(`post_process_cmd="jq -c .,debug`)
+	assert.Error(t, err)
+}
+
 func testConvertSampleProto(t *testing.T, sampleProto sampleProto) {
 	t.Helper()
 
-	// Make a Logrus logger:
-	logger := logrus.New()
-	logger.SetLevel(logrus.ErrorLevel)
-	logger.SetOutput(os.Stderr)
-
-	// Use the logger to make a Converter:
-	protoConverter := New(logger)
-	protoConverter.Flags = sampleProto.Flags
+	// Make a Converter:
+	protoConverter := NewWithOptions(newTestLogger(), ConvertOptions{Flags: sampleProto.Flags, Naming: sampleProto.Naming})
 
 	// Open the sample proto file:
 	sampleProtoFileName := fmt.Sprintf("%v/%v", sampleProtoDirectory, sampleProto.ProtoFileName)
 	fileDescriptorSet := mustReadProtoFiles(t, sampleProtoDirectory, sampleProto.ProtoFileName)
 
-	// Prepare a request:
+	// Prepare a request (leaving out any imports which protoc shouldn't have been given):
 	codeGeneratorRequest := plugin.CodeGeneratorRequest{
 		FileToGenerate: sampleProto.FilesToGenerate,
-		ProtoFile:      fileDescriptorSet.GetFile(),
+	}
+	for _, protoFile := range fileDescriptorSet.GetFile() {
+		if !contains(sampleProto.MissingImports, protoFile.GetName()) {
+			codeGeneratorRequest.ProtoFile = append(codeGeneratorRequest.ProtoFile, protoFile)
+		}
 	}
 
-	// Test the TargetedMessages feature:
+	// Pass on any generator parameters (and test the TargetedMessages feature):
+	var parameters []string
+	if sampleProto.Parameters != "" {
+		parameters = append(parameters, sampleProto.Parameters)
+	}
 	if len(sampleProto.TargetedMessages) > 0 {
-		arg := fmt.Sprintf("messages=[%s]", strings.Join(sampleProto.TargetedMessages, messageDelimiter))
-		codeGeneratorRequest.Parameter = &arg
+		parameters = append(parameters, fmt.Sprintf("messages=[%s]", strings.Join(sampleProto.TargetedMessages, messageDelimiter)))
+	}
+	if len(parameters) > 0 {
+		codeGeneratorRequest.Parameter = proto.String(strings.Join(parameters, ","))
 	}
 
 	// Perform the conversion:
@@ -83,8 +108,8 @@ func testConvertSampleProto(t *testing.T, sampleProto sampleProto) {
 	} else {
 		for responseFileIndex, responseFile := range response.File {
 
-			// Ensure that the generated schema matches the expected (canned) one:
-			assert.Equal(t, strings.TrimSpace(sampleProto.ExpectedJSONSchema[responseFileIndex]), responseFile.GetContent(), "Incorrect JSON-Schema returned for sample proto file (%v)", sampleProtoFileName)
+			// Ensure that the generated schema matches the expected (canned) one, byte for byte:
+			assert.Equal(t, sampleProto.ExpectedJSONSchema[responseFileIndex], responseFile.GetContent(), "Incorrect JSON-Schema returned for sample proto file (%v)", sampleProtoFileName)
 
 			// Validate the generated filenames:
 			if len(sampleProto.ExpectedFileNames) > 0 {
@@ -112,8 +137,11 @@ func testConvertSampleProto(t *testing.T, sampleProto sampleProto) {
 		return
 	}
 
-	// Check for the correct prefix:
-	if protoConverter.Flags.PrefixSchemaFilesWithPackage {
+	// Check for the correct prefix (unless the sample names its files some other way):
+	if sampleProto.Naming != nil {
+		return
+	}
+	if sampleProto.Flags.PrefixSchemaFilesWithPackage {
 		assert.Contains(t, response.File[0].GetName(), "samples")
 	} else {
 		assert.NotContains(t, response.File[0].GetName(), "samples")
@@ -122,6 +150,19 @@ func testConvertSampleProto(t *testing.T, sampleProto sampleProto) {
 
 func configureSampleProtos() map[string]sampleProto {
 	return map[string]sampleProto{
+		"AIPConventions": {
+			Flags:                 ConverterFlags{AIPConventions: true},
+			ExpectedJSONSchema:    []string{testdata.ListBooksRequest, testdata.ListBooksResponse},
+			FilesToGenerate:       []string{"AIPConventions.proto"},
+			ProtoFileName:         "AIPConventions.proto",
+			ObjectsToValidateFail: []string{testdata.ListBooksRequestFail},
+			ObjectsToValidatePass: []string{testdata.ListBooksRequestPass},
+		},
+		"AIPConventionsOff": {
+			ExpectedJSONSchema: []string{testdata.ListBooksRequestWithoutConventions, testdata.ListBooksResponseWithoutConventions},
+			FilesToGenerate:    []string{"AIPConventions.proto"},
+			ProtoFileName:      "AIPConventions.proto",
+		},
 		"AllRequired": {
 			Flags:                 ConverterFlags{AllFieldsRequired: true},
 			ExpectedJSONSchema:    []string{testdata.PayloadMessage2},
@@ -182,11 +223,66 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.BigIntAsStringFail},
 			ObjectsToValidatePass: []string{testdata.BigIntAsStringPass},
 		},
+		"BigIntAsStringFastify": {
+			Parameters:         "preset=fastify",
+			ExpectedJSONSchema: []string{testdata.BigIntAsStringFastify},
+			FilesToGenerate:    []string{"BigIntAsString.proto"},
+			ProtoFileName:      "BigIntAsString.proto",
+		},
+		"BigIntAsStringTypeArrays": {
+			Parameters:         "type_arrays",
+			ExpectedJSONSchema: []string{testdata.BigIntAsStringTypeArrays},
+			FilesToGenerate:    []string{"BigIntAsString.proto"},
+			ProtoFileName:      "BigIntAsString.proto",
+		},
+		"BundleFile": {
+			Parameters:         "bundle=file,root=Order",
+			ExpectedFileNames:  []string{"BundleInvoice.json", "Bundle.json"},
+			ExpectedJSONSchema: []string{testdata.BundleFileInvoice, testdata.BundleFileOrder},
+			FilesToGenerate:    []string{"Bundle.proto", "BundleInvoice.proto"},
+			ProtoFileName:      "Bundle.proto",
+		},
+		"BundlePackage": {
+			Parameters:         "bundle=package,root=Order,generate_index",
+			ExpectedFileNames:  []string{"acme.json", "index.json"},
+			ExpectedJSONSchema: []string{testdata.BundlePackage, testdata.BundlePackageIndex},
+			FilesToGenerate:    []string{"Bundle.proto", "BundleInvoice.proto"},
+			ProtoFileName:      "Bundle.proto",
+		},
 		"BytesPayload": {
 			ExpectedJSONSchema:    []string{testdata.BytesPayload},
 			FilesToGenerate:       []string{"BytesPayload.proto"},
 			ProtoFileName:         "BytesPayload.proto",
 			ObjectsToValidateFail: []string{testdata.BytesPayloadFail},
+			ObjectsToValidatePass: []string{testdata.BytesPayloadPass},
+		},
+		"Collections": {
+			Parameters:         "collection=postman",
+			ExpectedFileNames:  []string{"PlaceOrderRequest.json", "Order.json", "Services.postman_collection.json"},
+			ExpectedJSONSchema: []string{testdata.CollectionsPlaceOrderRequest, testdata.CollectionsOrder, testdata.Collections},
+			FilesToGenerate:    []string{"Services.proto"},
+			ProtoFileName:      "Services.proto",
+		},
+		"CollectionsHTTP": {
+			Parameters:         "collection=insomnia",
+			ExpectedFileNames:  []string{"PlaceOrderRequest.json", "Order.json", "CollectionsHTTP.insomnia.json"},
+			ExpectedJSONSchema: []string{testdata.CollectionsPlaceOrderRequest, testdata.CollectionsOrder, testdata.CollectionsHTTP},
+			FilesToGenerate:    []string{"CollectionsHTTP.proto"},
+			ProtoFileName:      "CollectionsHTTP.proto",
+		},
+		"CollectionsInvalidFormat": {
+			Parameters:         "collection=swagger",
+			ExpectedFileNames:  []string{"PlaceOrderRequest.json", "Order.json"},
+			ExpectedJSONSchema: []string{testdata.CollectionsPlaceOrderRequest, testdata.CollectionsOrder},
+			FilesToGenerate:    []string{"Services.proto"},
+			ProtoFileName:      "Services.proto",
+		},
+		"CollectionsNoPackage": {
+			Parameters:         "collection=postman",
+			ExpectedFileNames:  []string{"Order.json", "CollectionsNoPackage.postman_collection.json"},
+			ExpectedJSONSchema: []string{testdata.CollectionsNoPackageOrder, testdata.CollectionsNoPackage},
+			FilesToGenerate:    []string{"CollectionsNoPackage.proto"},
+			ProtoFileName:      "CollectionsNoPackage.proto",
 		},
 		"Comments": {
 			ExpectedJSONSchema:    []string{testdata.MessageWithComments},
@@ -194,11 +290,41 @@ func configureSampleProtos() map[string]sampleProto {
 			ProtoFileName:         "MessageWithComments.proto",
 			ObjectsToValidateFail: []string{testdata.MessageWithCommentsFail},
 		},
+		"CommentsAsExtension": {
+			Flags:              ConverterFlags{CommentsAsExtension: true},
+			ExpectedJSONSchema: []string{testdata.MessageWithCommentsAsExtension},
+			FilesToGenerate:    []string{"MessageWithComments.proto"},
+			ProtoFileName:      "MessageWithComments.proto",
+		},
 		"CyclicalReference": {
 			ExpectedJSONSchema: []string{testdata.CyclicalReferenceMessageM, testdata.CyclicalReferenceMessageFoo, testdata.CyclicalReferenceMessageBar, testdata.CyclicalReferenceMessageBaz},
 			FilesToGenerate:    []string{"CyclicalReference.proto"},
 			ProtoFileName:      "CyclicalReference.proto",
 		},
+		"Deprecated": {
+			ExpectedFileNames:  []string{"CurrentOrder.json", "LegacyOrder.json"},
+			ExpectedJSONSchema: []string{testdata.DeprecatedCurrentOrder, testdata.DeprecatedLegacyOrder},
+			FilesToGenerate:    []string{"Deprecated.proto"},
+			ProtoFileName:      "Deprecated.proto",
+		},
+		"DeprecatedFile": {
+			ExpectedFileNames:  []string{"CurrentOrder.json"},
+			ExpectedJSONSchema: []string{testdata.DeprecatedFile},
+			FilesToGenerate:    []string{"DeprecatedFile.proto"},
+			ProtoFileName:      "DeprecatedFile.proto",
+		},
+		"DeprecatedFileSkipped": {
+			Parameters:      "skip_deprecated",
+			FilesToGenerate: []string{"DeprecatedFile.proto"},
+			ProtoFileName:   "DeprecatedFile.proto",
+		},
+		"DeprecatedSkipped": {
+			Parameters:         "skip_deprecated",
+			ExpectedFileNames:  []string{"CurrentOrder.json"},
+			ExpectedJSONSchema: []string{testdata.DeprecatedSkipped},
+			FilesToGenerate:    []string{"Deprecated.proto"},
+			ProtoFileName:      "Deprecated.proto",
+		},
 		"EnumNestedReference": {
 			ExpectedJSONSchema:    []string{testdata.EnumNestedReference},
 			FilesToGenerate:       []string{"EnumNestedReference.proto"},
@@ -206,6 +332,14 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.EnumNestedReferenceFail},
 			ObjectsToValidatePass: []string{testdata.EnumNestedReferencePass},
 		},
+		"EnumsAsDefinitions": {
+			Flags:                 ConverterFlags{EnumsAsDefinitions: true},
+			ExpectedJSONSchema:    []string{testdata.EnumsAsDefinitions},
+			FilesToGenerate:       []string{"EnumsAsDefinitions.proto"},
+			ProtoFileName:         "EnumsAsDefinitions.proto",
+			ObjectsToValidateFail: []string{testdata.EnumsAsDefinitionsFail},
+			ObjectsToValidatePass: []string{testdata.EnumsAsDefinitionsPass},
+		},
 		"EnumWithMessage": {
 			ExpectedJSONSchema:    []string{testdata.EnumWithMessage},
 			FilesToGenerate:       []string{"EnumWithMessage.proto"},
@@ -220,6 +354,42 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.PayloadMessageFail, testdata.ImportedEnumFail, testdata.EnumCeptionFail},
 			ObjectsToValidatePass: []string{testdata.PayloadMessagePass, testdata.ImportedEnumPass, testdata.EnumCeptionPass},
 		},
+		"Envelope": {
+			Parameters:            "envelope=acme.Envelope",
+			ExpectedJSONSchema:    []string{testdata.Envelope},
+			FilesToGenerate:       []string{"Envelope.proto"},
+			ProtoFileName:         "Envelope.proto",
+			ObjectsToValidateFail: []string{testdata.EnvelopeFail},
+			ObjectsToValidatePass: []string{testdata.EnvelopePass},
+		},
+		"ExternalEnums": {
+			ExpectedJSONSchema:    []string{testdata.ExternalEnums},
+			FilesToGenerate:       []string{"ExternalEnums.proto"},
+			ProtoFileName:         "ExternalEnums.proto",
+			ObjectsToValidateFail: []string{testdata.ExternalEnumsFail},
+			ObjectsToValidatePass: []string{testdata.ExternalEnumsPass},
+		},
+		"FieldNumbersCombined": {
+			Parameters:         "exclude_field_numbers=1-2+9001",
+			ExpectedFileNames:  []string{"Account.json", "ExperimentData.json"},
+			ExpectedJSONSchema: []string{testdata.FieldNumbersCombined, testdata.FieldNumbersCombinedExperimentData},
+			FilesToGenerate:    []string{"FieldNumbers.proto"},
+			ProtoFileName:      "FieldNumbers.proto",
+		},
+		"FieldNumbersExcluded": {
+			Parameters:         "exclude_field_numbers=9000-9999",
+			ExpectedFileNames:  []string{"Account.json", "ExperimentData.json"},
+			ExpectedJSONSchema: []string{testdata.FieldNumbersExcluded, testdata.FieldNumbersExcludedExperimentData},
+			FilesToGenerate:    []string{"FieldNumbers.proto"},
+			ProtoFileName:      "FieldNumbers.proto",
+		},
+		"GenerateIndex": {
+			Flags:              ConverterFlags{GenerateIndex: true},
+			ExpectedJSONSchema: []string{testdata.PayloadMessage, testdata.GenerateIndex},
+			ExpectedFileNames:  []string{"PayloadMessage.json", "index.json"},
+			FilesToGenerate:    []string{"PayloadMessage.proto"},
+			ProtoFileName:      "PayloadMessage.proto",
+		},
 		"GoogleValue": {
 			ExpectedJSONSchema:    []string{testdata.GoogleValue},
 			FilesToGenerate:       []string{"GoogleValue.proto"},
@@ -261,6 +431,13 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.GoogleInt64ValueDisallowStringAllowNullFail},
 			ObjectsToValidatePass: []string{testdata.GoogleInt64ValueDisallowStringAllowNullPass},
 		},
+		"HyperSchema": {
+			Parameters:         "hyper_schema",
+			ExpectedFileNames:  []string{"PlaceOrderRequest.json", "Order.json", "HyperSchema.hyper.json"},
+			ExpectedJSONSchema: []string{testdata.HyperSchemaPlaceOrderRequest, testdata.HyperSchemaOrder, testdata.HyperSchema},
+			FilesToGenerate:    []string{"HyperSchema.proto"},
+			ProtoFileName:      "HyperSchema.proto",
+		},
 		"ImportedEnum": {
 			ExpectedJSONSchema:    []string{testdata.ImportedEnum},
 			FilesToGenerate:       []string{"ImportedEnum.proto"},
@@ -268,6 +445,14 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.ImportedEnumFail},
 			ObjectsToValidatePass: []string{testdata.ImportedEnumPass},
 		},
+		"InferredFormats": {
+			Flags:                 ConverterFlags{InferFormats: true},
+			ExpectedJSONSchema:    []string{testdata.InferredFormats},
+			FilesToGenerate:       []string{"InferredFormats.proto"},
+			ProtoFileName:         "InferredFormats.proto",
+			ObjectsToValidateFail: []string{testdata.InferredFormatsFail},
+			ObjectsToValidatePass: []string{testdata.InferredFormatsPass},
+		},
 		"JSONFields": {
 			Flags:                 ConverterFlags{UseJSONFieldnamesOnly: true},
 			ExpectedJSONSchema:    []string{testdata.JSONFields},
@@ -276,6 +461,45 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.JSONFieldsFail},
 			ObjectsToValidatePass: []string{testdata.JSONFieldsPass},
 		},
+		"ListSchemas": {
+			Flags:              ConverterFlags{GenerateListSchemas: true},
+			ExpectedFileNames:  []string{"Book.json", "BookList.json"},
+			ExpectedJSONSchema: []string{testdata.ListSchemasBook, testdata.ListSchemasBookList},
+			FilesToGenerate:    []string{"ListSchemas.proto"},
+			ProtoFileName:      "ListSchemas.proto",
+		},
+		"ListSchemasPaginated": {
+			Parameters:         "generate_list_schemas,list_style=paginated,json_fieldnames",
+			ExpectedFileNames:  []string{"Book.json", "BookList.json"},
+			ExpectedJSONSchema: []string{testdata.ListSchemasPaginatedBook, testdata.ListSchemasPaginatedBookList},
+			FilesToGenerate:    []string{"ListSchemas.proto"},
+			ProtoFileName:      "ListSchemas.proto",
+		},
+		"ListSchemasVersioned": {
+			Parameters:         "generate_list_schemas,package_versions",
+			ExpectedFileNames:  []string{"v1/Book.json", "v1/BookList.json"},
+			ExpectedJSONSchema: []string{testdata.ListSchemasVersionedBook, testdata.ListSchemasVersionedBookList},
+			FilesToGenerate:    []string{"ListSchemasVersioned.proto"},
+			ProtoFileName:      "ListSchemasVersioned.proto",
+		},
+		"LocalizedDescriptions": {
+			ExpectedJSONSchema: []string{testdata.LocalizedDescriptions},
+			FilesToGenerate:    []string{"LocalizedDescriptions.proto"},
+			ProtoFileName:      "LocalizedDescriptions.proto",
+		},
+		"LocalizedDescriptionsGerman": {
+			Parameters:         "locale=de,enums_as_integers_only",
+			ExpectedJSONSchema: []string{testdata.LocalizedDescriptionsGerman},
+			FilesToGenerate:    []string{"LocalizedDescriptions.proto"},
+			ProtoFileName:      "LocalizedDescriptions.proto",
+		},
+		"MapKeys": {
+			ExpectedJSONSchema:    []string{testdata.MapKeys},
+			FilesToGenerate:       []string{"MapKeys.proto"},
+			ProtoFileName:         "MapKeys.proto",
+			ObjectsToValidateFail: []string{testdata.MapKeysFail},
+			ObjectsToValidatePass: []string{testdata.MapKeysPass},
+		},
 		"Maps": {
 			ExpectedJSONSchema:    []string{testdata.Maps},
 			FilesToGenerate:       []string{"Maps.proto"},
@@ -283,6 +507,58 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.MapsFail},
 			ObjectsToValidatePass: []string{testdata.MapsPass},
 		},
+		"MapsAndWrappers": {
+			Flags:                 ConverterFlags{EnforceOneOf: true},
+			ExpectedJSONSchema:    []string{testdata.MapsAndWrappers},
+			FilesToGenerate:       []string{"MapsAndWrappers.proto"},
+			ProtoFileName:         "MapsAndWrappers.proto",
+			ObjectsToValidateFail: []string{testdata.MapsAndWrappersFail, testdata.MapsAndWrappersMissingOneofFail, testdata.MapsAndWrappersBadMapValueFail},
+			ObjectsToValidatePass: []string{testdata.MapsAndWrappersPass},
+		},
+		"MapsAndWrappersAllowNull": {
+			Flags:                 ConverterFlags{AllowNullValues: true, EnforceOneOf: true},
+			ExpectedJSONSchema:    []string{testdata.MapsAndWrappersAllowNull},
+			FilesToGenerate:       []string{"MapsAndWrappers.proto"},
+			ProtoFileName:         "MapsAndWrappers.proto",
+			ObjectsToValidateFail: []string{testdata.MapsAndWrappersFail, testdata.MapsAndWrappersMissingOneofFail, testdata.MapsAndWrappersBadMapValueFail},
+			ObjectsToValidatePass: []string{testdata.MapsAndWrappersPass, "null"},
+		},
+		"NamingRules": {
+			Parameters:            "disallow_bigints_as_strings,rules_file=testdata/naming_rules.json",
+			ExpectedJSONSchema:    []string{testdata.NamingRules},
+			FilesToGenerate:       []string{"NamingRules.proto"},
+			ProtoFileName:         "NamingRules.proto",
+			ObjectsToValidateFail: []string{testdata.NamingRulesFail},
+			ObjectsToValidatePass: []string{testdata.NamingRulesPass},
+		},
+		"NestingDepth": {
+			Parameters:         "max_depth=1",
+			ExpectedJSONSchema: []string{testdata.NestingDepthL1, testdata.NestingDepthL2, testdata.NestingDepthL3, testdata.NestingDepthL4},
+			FilesToGenerate:    []string{"NestingDepth.proto"},
+			ProtoFileName:      "NestingDepth.proto",
+		},
+		"NestingDepthUnlimited": {
+			Parameters:         "max_depth=0",
+			ExpectedJSONSchema: []string{testdata.NestingDepthUnlimited},
+			FilesToGenerate:    []string{"NestingDepth.proto"},
+			ProtoFileName:      "NestingDepth.proto",
+			TargetedMessages:   []string{"L1"},
+		},
+		"NestedAnyTypes": {
+			ExpectedJSONSchema:    []string{testdata.NestedAnyTypes},
+			FilesToGenerate:       []string{"NestedAnyTypes.proto"},
+			ProtoFileName:         "NestedAnyTypes.proto",
+			ObjectsToValidateFail: []string{testdata.NestedAnyTypesFail},
+			ObjectsToValidatePass: []string{testdata.NestedAnyTypesPass},
+		},
+		"NestedAnyTypesStrict": {
+			Flags:                 ConverterFlags{DisallowAdditionalProperties: true},
+			ExpectedJSONSchema:    []string{testdata.NestedAnyTypesStrict},
+			FilesToGenerate:       []string{"NestedAnyTypes.proto"},
+			ProtoFileName:         "NestedAnyTypes.proto",
+			ObjectsToValidateFail: []string{testdata.NestedAnyTypesFail, testdata.NestedAnyTypesStrictFail},
+			ObjectsToValidatePass: []string{testdata.NestedAnyTypesPass},
+		},
 		"NestedMessage": {
 			ExpectedJSONSchema:    []string{testdata.PayloadMessage, testdata.NestedMessage},
 			FilesToGenerate:       []string{"NestedMessage.proto", "PayloadMessage.proto"},
@@ -290,6 +566,47 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.PayloadMessageFail, testdata.NestedMessageFail},
 			ObjectsToValidatePass: []string{testdata.PayloadMessagePass, testdata.NestedMessagePass},
 		},
+		"NestedMessageKebabNaming": {
+			Flags:                 ConverterFlags{PrefixSchemaFilesWithPackage: true},
+			Naming:                kebabNaming{NamingStrategy: JSONNaming},
+			ExpectedFileNames:     []string{"nestedmessage.schema.json"},
+			ExpectedJSONSchema:    []string{testdata.NestedMessageKebabNaming},
+			FilesToGenerate:       []string{"NestedMessage.proto"},
+			ProtoFileName:         "NestedMessage.proto",
+			ObjectsToValidateFail: []string{testdata.NestedMessageFail},
+			ObjectsToValidatePass: []string{testdata.NestedMessagePass},
+		},
+		"NestedMessageSlugs": {
+			Naming:                SlugDefinitions(registryNaming{NamingStrategy: ProtoNaming}),
+			ExpectedJSONSchema:    []string{testdata.NestedMessageSlugs},
+			FilesToGenerate:       []string{"NestedMessage.proto"},
+			ProtoFileName:         "NestedMessage.proto",
+			ObjectsToValidateFail: []string{testdata.NestedMessageFail},
+			ObjectsToValidatePass: []string{testdata.NestedMessagePass},
+		},
+		"NestedMessageSlugsAnchors": {
+			Flags:              ConverterFlags{DefinitionSlugs: true},
+			Naming:             registryNaming{NamingStrategy: ProtoNaming},
+			Parameters:         "schema_version=2020-12",
+			ExpectedJSONSchema: []string{testdata.NestedMessageSlugsAnchors},
+			FilesToGenerate:    []string{"NestedMessage.proto"},
+			ProtoFileName:      "NestedMessage.proto",
+		},
+		"NestedMessageSlugsFlag": {
+			Flags:                 ConverterFlags{DefinitionSlugs: true},
+			Naming:                registryNaming{NamingStrategy: ProtoNaming},
+			ExpectedJSONSchema:    []string{testdata.NestedMessageSlugs},
+			FilesToGenerate:       []string{"NestedMessage.proto"},
+			ProtoFileName:         "NestedMessage.proto",
+			ObjectsToValidateFail: []string{testdata.NestedMessageFail},
+			ObjectsToValidatePass: []string{testdata.NestedMessagePass},
+		},
+		"NestedMessageSlugsProtoNames": {
+			Flags:              ConverterFlags{DefinitionSlugs: true},
+			ExpectedJSONSchema: []string{testdata.NestedMessage},
+			FilesToGenerate:    []string{"NestedMessage.proto"},
+			ProtoFileName:      "NestedMessage.proto",
+		},
 		"NestedObject": {
 			ExpectedJSONSchema:    []string{testdata.NestedObject},
 			FilesToGenerate:       []string{"NestedObject.proto"},
@@ -298,10 +615,34 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidatePass: []string{testdata.NestedObjectPass},
 		},
 		"NoPackage": {
-			ExpectedJSONSchema: []string{},
-			FilesToGenerate:    []string{},
+			ExpectedJSONSchema:    []string{testdata.NoPackage, testdata.NoPackageOwner},
+			FilesToGenerate:       []string{"NoPackage.proto"},
+			ProtoFileName:         "NoPackage.proto",
+			ObjectsToValidateFail: []string{testdata.NoPackageFail},
+			ObjectsToValidatePass: []string{testdata.NoPackagePass},
+		},
+		"NoPackageBundled": {
+			Parameters:         "bundle=package",
+			ExpectedFileNames:  []string{"NoPackage.json"},
+			ExpectedJSONSchema: []string{testdata.NoPackageBundled},
+			FilesToGenerate:    []string{"NoPackage.proto"},
+			ProtoFileName:      "NoPackage.proto",
+		},
+		"NoPackageTypeURLs": {
+			Parameters:         "prefix_schema_files_with_package,generate_index,type_url_names",
+			ExpectedFileNames:  []string{"type.googleapis.com/NoPackage.json", "type.googleapis.com/Owner.json", "index.json"},
+			ExpectedJSONSchema: []string{testdata.NoPackageTypeURLs, testdata.NoPackageTypeURLsOwner, testdata.NoPackageTypeURLsIndex},
+			FilesToGenerate:    []string{"NoPackage.proto"},
 			ProtoFileName:      "NoPackage.proto",
 		},
+		"NonCanonicalJSON": {
+			Flags:                 ConverterFlags{NonCanonicalJSON: true},
+			ExpectedJSONSchema:    []string{testdata.NonCanonicalJSON},
+			FilesToGenerate:       []string{"PayloadMessage.proto"},
+			ProtoFileName:         "PayloadMessage.proto",
+			ObjectsToValidateFail: []string{testdata.PayloadMessageFail},
+			ObjectsToValidatePass: []string{testdata.PayloadMessagePass},
+		},
 		"OneOf": {
 			Flags:                 ConverterFlags{AllFieldsRequired: true, EnforceOneOf: true},
 			ExpectedJSONSchema:    []string{testdata.OneOf},
@@ -310,6 +651,21 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.OneOfFail},
 			ObjectsToValidatePass: []string{testdata.OneOfPass},
 		},
+		"NullableOptionals": {
+			Flags:                 ConverterFlags{AllowNullOptionals: true},
+			ExpectedJSONSchema:    []string{testdata.NullableOptionals},
+			FilesToGenerate:       []string{"NullableOptionals.proto"},
+			ProtoFileName:         "NullableOptionals.proto",
+			ObjectsToValidateFail: []string{testdata.NullableOptionalsFail},
+			ObjectsToValidatePass: []string{testdata.NullableOptionalsPass},
+		},
+		"OpenAPIServices": {
+			Parameters:         "schema_version=openapi3",
+			ExpectedFileNames:  []string{"PlaceOrderRequest.json", "Order.json", "OpenAPIServices.openapi.json"},
+			ExpectedJSONSchema: []string{testdata.OpenAPIServicesPlaceOrderRequest, testdata.OpenAPIServicesOrder, testdata.OpenAPIServices},
+			FilesToGenerate:    []string{"OpenAPIServices.proto"},
+			ProtoFileName:      "OpenAPIServices.proto",
+		},
 		"OptionAllowNullValues": {
 			ExpectedJSONSchema:    []string{testdata.OptionAllowNullValues},
 			FilesToGenerate:       []string{"OptionAllowNullValues.proto"},
@@ -317,6 +673,42 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.OptionAllowNullValuesFail},
 			ObjectsToValidatePass: []string{testdata.OptionAllowNullValuesPass},
 		},
+		"OptionAnyTypes": {
+			ExpectedJSONSchema:    []string{testdata.OptionAnyTypes},
+			FilesToGenerate:       []string{"OptionAnyTypes.proto"},
+			ProtoFileName:         "OptionAnyTypes.proto",
+			ObjectsToValidateFail: []string{testdata.OptionAnyTypesFail},
+			ObjectsToValidatePass: []string{testdata.OptionAnyTypesPass},
+		},
+		"OptionAnyTypesStrict": {
+			Flags:                 ConverterFlags{DisallowAdditionalProperties: true},
+			ExpectedJSONSchema:    []string{testdata.OptionAnyTypesStrict},
+			FilesToGenerate:       []string{"OptionAnyTypes.proto"},
+			ProtoFileName:         "OptionAnyTypes.proto",
+			ObjectsToValidateFail: []string{testdata.OptionAnyTypesFail, testdata.OptionAnyTypesStrictFail},
+			ObjectsToValidatePass: []string{testdata.OptionAnyTypesPass},
+		},
+		"OptionBannedField": {
+			ExpectedJSONSchema:    []string{testdata.OptionBannedField},
+			FilesToGenerate:       []string{"OptionBannedField.proto"},
+			ProtoFileName:         "OptionBannedField.proto",
+			ObjectsToValidateFail: []string{testdata.OptionBannedFieldFail},
+			ObjectsToValidatePass: []string{testdata.OptionBannedFieldPass},
+		},
+		"OptionContains": {
+			ExpectedJSONSchema:    []string{testdata.OptionContains},
+			FilesToGenerate:       []string{"OptionContains.proto"},
+			ProtoFileName:         "OptionContains.proto",
+			ObjectsToValidateFail: []string{testdata.OptionContainsFail},
+			ObjectsToValidatePass: []string{testdata.OptionContainsPass},
+		},
+		"OptionDecimal": {
+			ExpectedJSONSchema:    []string{testdata.OptionDecimal},
+			FilesToGenerate:       []string{"OptionDecimal.proto"},
+			ProtoFileName:         "OptionDecimal.proto",
+			ObjectsToValidateFail: []string{testdata.OptionDecimalFail},
+			ObjectsToValidatePass: []string{testdata.OptionDecimalPass},
+		},
 		"OptionDisallowAdditionalProperties": {
 			ExpectedJSONSchema:    []string{testdata.OptionDisallowAdditionalProperties},
 			FilesToGenerate:       []string{"OptionDisallowAdditionalProperties.proto"},
@@ -324,6 +716,20 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.OptionDisallowAdditionalPropertiesFail},
 			ObjectsToValidatePass: []string{testdata.OptionDisallowAdditionalPropertiesPass},
 		},
+		"OptionEnumsAllowLowercase": {
+			ExpectedJSONSchema:    []string{testdata.OptionEnumsAllowLowercase},
+			FilesToGenerate:       []string{"OptionEnumsAllowLowercase.proto"},
+			ProtoFileName:         "OptionEnumsAllowLowercase.proto",
+			ObjectsToValidateFail: []string{testdata.OptionEnumsAllowLowercaseFail},
+			ObjectsToValidatePass: []string{testdata.OptionEnumsAllowLowercasePass},
+		},
+		"OptionEnumsExcludeUnspecified": {
+			ExpectedJSONSchema:    []string{testdata.OptionEnumsExcludeUnspecified},
+			FilesToGenerate:       []string{"OptionEnumsExcludeUnspecified.proto"},
+			ProtoFileName:         "OptionEnumsExcludeUnspecified.proto",
+			ObjectsToValidateFail: []string{testdata.OptionEnumsExcludeUnspecifiedFail},
+			ObjectsToValidatePass: []string{testdata.OptionEnumsExcludeUnspecifiedPass},
+		},
 		"OptionEnumsAsConstants": {
 			ExpectedJSONSchema:    []string{testdata.OptionEnumsAsConstants},
 			FilesToGenerate:       []string{"OptionEnumsAsConstants.proto"},
@@ -331,6 +737,13 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.OptionEnumsAsConstantsFail},
 			ObjectsToValidatePass: []string{testdata.OptionEnumsAsConstantsPass},
 		},
+		"OptionEnumsAsIntegersOnly": {
+			ExpectedJSONSchema:    []string{testdata.OptionEnumsAsIntegersOnly},
+			FilesToGenerate:       []string{"OptionEnumsAsIntegersOnly.proto"},
+			ProtoFileName:         "OptionEnumsAsIntegersOnly.proto",
+			ObjectsToValidateFail: []string{testdata.OptionEnumsAsIntegersOnlyFail},
+			ObjectsToValidatePass: []string{testdata.OptionEnumsAsIntegersOnlyPass},
+		},
 		"OptionEnumsAsStringsOnly": {
 			Flags: ConverterFlags{
 				EnumsAsStringsOnly: true,
@@ -357,6 +770,14 @@ func configureSampleProtos() map[string]sampleProto {
 			FilesToGenerate:    []string{"OptionFileExtension.proto"},
 			ProtoFileName:      "OptionFileExtension.proto",
 		},
+		"OptionFileRoot": {
+			ExpectedJSONSchema:    []string{testdata.OptionFileRoot},
+			ExpectedFileNames:     []string{"Order.json"},
+			FilesToGenerate:       []string{"OptionFileRoot.proto"},
+			ProtoFileName:         "OptionFileRoot.proto",
+			ObjectsToValidateFail: []string{testdata.OptionFileRootFail},
+			ObjectsToValidatePass: []string{testdata.OptionFileRootPass},
+		},
 		"OptionIgnoredEnum": {
 			ExpectedJSONSchema: []string{testdata.UnignoredEnum},
 			FilesToGenerate:    []string{"OptionIgnoredEnum.proto"},
@@ -379,6 +800,52 @@ func configureSampleProtos() map[string]sampleProto {
 			FilesToGenerate:    []string{"OptionIgnoredMessage.proto"},
 			ProtoFileName:      "OptionIgnoredMessage.proto",
 		},
+		"OptionFieldDocs": {
+			ExpectedJSONSchema:    []string{testdata.OptionFieldDocs},
+			FilesToGenerate:       []string{"OptionFieldDocs.proto"},
+			ProtoFileName:         "OptionFieldDocs.proto",
+			ObjectsToValidateFail: []string{testdata.OptionFieldDocsFail},
+			ObjectsToValidatePass: []string{testdata.OptionFieldDocsPass},
+		},
+		"OptionFieldDocsComments": {
+			Parameters:         "docs_precedence=comments",
+			ExpectedJSONSchema: []string{testdata.OptionFieldDocsComments},
+			FilesToGenerate:    []string{"OptionFieldDocs.proto"},
+			ProtoFileName:      "OptionFieldDocs.proto",
+		},
+		"OptionFieldDocsConcatenated": {
+			Parameters:         "docs_precedence=concatenate",
+			ExpectedJSONSchema: []string{testdata.OptionFieldDocsConcatenated},
+			FilesToGenerate:    []string{"OptionFieldDocs.proto"},
+			ProtoFileName:      "OptionFieldDocs.proto",
+		},
+		"OptionFieldDocsInvalidPrecedence": {
+			Parameters:         "docs_precedence=loudest",
+			ExpectedJSONSchema: []string{testdata.OptionFieldDocs},
+			FilesToGenerate:    []string{"OptionFieldDocs.proto"},
+			ProtoFileName:      "OptionFieldDocs.proto",
+		},
+		"OptionKnownKeys": {
+			ExpectedJSONSchema:    []string{testdata.OptionKnownKeys},
+			FilesToGenerate:       []string{"OptionKnownKeys.proto"},
+			ProtoFileName:         "OptionKnownKeys.proto",
+			ObjectsToValidateFail: []string{testdata.OptionKnownKeysFail},
+			ObjectsToValidatePass: []string{testdata.OptionKnownKeysPass},
+		},
+		"OptionPropertyName": {
+			ExpectedJSONSchema:    []string{testdata.OptionPropertyName},
+			FilesToGenerate:       []string{"OptionPropertyName.proto"},
+			ProtoFileName:         "OptionPropertyName.proto",
+			ObjectsToValidateFail: []string{testdata.OptionPropertyNameFail},
+			ObjectsToValidatePass: []string{testdata.OptionPropertyNamePass},
+		},
+		"OptionRawSchema": {
+			ExpectedJSONSchema:    []string{testdata.OptionRawSchema},
+			FilesToGenerate:       []string{"OptionRawSchema.proto"},
+			ProtoFileName:         "OptionRawSchema.proto",
+			ObjectsToValidateFail: []string{testdata.OptionRawSchemaFail},
+			ObjectsToValidatePass: []string{testdata.OptionRawSchemaPass},
+		},
 		"OptionRequiredField": {
 			ExpectedJSONSchema:    []string{testdata.OptionRequiredField},
 			FilesToGenerate:       []string{"OptionRequiredField.proto"},
@@ -400,6 +867,13 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.OptionMaxLengthFail},
 			ObjectsToValidatePass: []string{testdata.OptionMaxLengthPass},
 		},
+		"OptionMultipleOf": {
+			ExpectedJSONSchema:    []string{testdata.OptionMultipleOf},
+			FilesToGenerate:       []string{"OptionMultipleOf.proto"},
+			ProtoFileName:         "OptionMultipleOf.proto",
+			ObjectsToValidateFail: []string{testdata.OptionMultipleOfFail},
+			ObjectsToValidatePass: []string{testdata.OptionMultipleOfPass},
+		},
 		"OptionPattern": {
 			ExpectedJSONSchema:    []string{testdata.OptionPattern},
 			FilesToGenerate:       []string{"OptionPattern.proto"},
@@ -414,6 +888,51 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.OptionRequiredMessageFail},
 			ObjectsToValidatePass: []string{testdata.OptionRequiredMessagePass},
 		},
+		"OptionStability": {
+			ExpectedJSONSchema: []string{testdata.OptionStability, testdata.OptionStabilityPreview},
+			FilesToGenerate:    []string{"OptionStability.proto"},
+			ProtoFileName:      "OptionStability.proto",
+		},
+		"OptionStabilityExcludeAlpha": {
+			Flags:              ConverterFlags{ExcludeAlpha: true},
+			ExpectedJSONSchema: []string{testdata.OptionStabilityExcludeAlpha},
+			FilesToGenerate:    []string{"OptionStability.proto"},
+			ProtoFileName:      "OptionStability.proto",
+		},
+		"OptionSchemaAssertions": {
+			ExpectedJSONSchema:    []string{testdata.OptionSchemaAssertions},
+			FilesToGenerate:       []string{"OptionSchemaAssertions.proto"},
+			ProtoFileName:         "OptionSchemaAssertions.proto",
+			ObjectsToValidateFail: []string{testdata.OptionSchemaAssertionsFail},
+			ObjectsToValidatePass: []string{testdata.OptionSchemaAssertionsPass},
+		},
+		"OptionSchemaFilename": {
+			ExpectedJSONSchema: []string{testdata.OptionSchemaFilename},
+			ExpectedFileNames:  []string{"order-v1.json"},
+			FilesToGenerate:    []string{"OptionSchemaFilename.proto"},
+			ProtoFileName:      "OptionSchemaFilename.proto",
+		},
+		"OptionTimestampFormat": {
+			ExpectedJSONSchema:    []string{testdata.OptionTimestampFormat},
+			FilesToGenerate:       []string{"OptionTimestampFormat.proto"},
+			ProtoFileName:         "OptionTimestampFormat.proto",
+			ObjectsToValidateFail: []string{testdata.OptionTimestampFormatFail},
+			ObjectsToValidatePass: []string{testdata.OptionTimestampFormatPass},
+		},
+		"OutputRoutes": {
+			Parameters:         "route=acme.internal.*=internal-schemas,route=acme.api.*=public-schemas;disallow_additional_properties",
+			ExpectedFileNames:  []string{"internal-schemas/InternalMessage.json", "OtherMessage.json", "public-schemas/PublicMessage.json"},
+			ExpectedJSONSchema: []string{testdata.InternalMessage, testdata.OtherMessage, testdata.PublicMessage},
+			FilesToGenerate:    []string{"OutputRoutes.proto", "OutputRoutesInternal.proto", "OutputRoutesOther.proto"},
+			ProtoFileName:      "OutputRoutes.proto",
+		},
+		"OutputRoutesWindowsPaths": {
+			Parameters:         `route=acme.internal.*=schemas\internal,prefix_schema_files_with_package`,
+			ExpectedFileNames:  []string{"schemas/internal/acme.internal.things/InternalMessage.json"},
+			ExpectedJSONSchema: []string{testdata.InternalMessage},
+			FilesToGenerate:    []string{"OutputRoutesInternal.proto"},
+			ProtoFileName:      "OutputRoutesInternal.proto",
+		},
 		"PackagePrefix": {
 			Flags:                 ConverterFlags{PrefixSchemaFilesWithPackage: true},
 			ExpectedJSONSchema:    []string{testdata.Timestamp},
@@ -422,6 +941,26 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.TimestampFail},
 			ObjectsToValidatePass: []string{testdata.TimestampPass},
 		},
+		"PackageVersions": {
+			Parameters:         "package_versions,schema_id_base=https://schemas.acme.com/",
+			ExpectedFileNames:  []string{"v2beta1/Order.json"},
+			ExpectedJSONSchema: []string{testdata.PackageVersions},
+			FilesToGenerate:    []string{"PackageVersions.proto"},
+			ProtoFileName:      "PackageVersions.proto",
+		},
+		"PackageVersionsOff": {
+			ExpectedFileNames:  []string{"Order.json"},
+			ExpectedJSONSchema: []string{testdata.PackageVersionsOff},
+			FilesToGenerate:    []string{"PackageVersions.proto"},
+			ProtoFileName:      "PackageVersions.proto",
+		},
+		"PackageVersionsPrefixed": {
+			Parameters:         "package_versions,prefix_schema_files_with_package",
+			ExpectedFileNames:  []string{"acme.orders.v2beta1/Order.json"},
+			ExpectedJSONSchema: []string{testdata.PackageVersionsPrefixed},
+			FilesToGenerate:    []string{"PackageVersions.proto"},
+			ProtoFileName:      "PackageVersions.proto",
+		},
 		"PayloadMessage": {
 			ExpectedJSONSchema:    []string{testdata.PayloadMessage},
 			FilesToGenerate:       []string{"PayloadMessage.proto"},
@@ -451,6 +990,66 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.Proto2RequiredFail},
 			ObjectsToValidatePass: []string{testdata.Proto2RequiredPass},
 		},
+		"ProtoJSONScalars": {
+			Flags:                 ConverterFlags{FaithfulProtoJSON: true},
+			ExpectedJSONSchema:    []string{testdata.ProtoJSONScalars},
+			FilesToGenerate:       []string{"ProtoJSONScalars.proto"},
+			ProtoFileName:         "ProtoJSONScalars.proto",
+			ObjectsToValidateFail: []string{testdata.ProtoJSONScalarsFail},
+			ObjectsToValidatePass: []string{testdata.ProtoJSONScalarsPass},
+		},
+		"ProtoJSONScalarsNullable": {
+			Flags:              ConverterFlags{AllowNullValues: true, FaithfulProtoJSON: true},
+			ExpectedJSONSchema: []string{testdata.ProtoJSONScalarsNullable},
+			FilesToGenerate:    []string{"ProtoJSONScalars.proto"},
+			ProtoFileName:      "ProtoJSONScalars.proto",
+		},
+		"ProtoJSONScalarsUnfaithful": {
+			ExpectedJSONSchema: []string{testdata.ProtoJSONScalarsUnfaithful},
+			FilesToGenerate:    []string{"ProtoJSONScalars.proto"},
+			ProtoFileName:      "ProtoJSONScalars.proto",
+		},
+		"ProtovalidateExamples": {
+			ExpectedJSONSchema: []string{testdata.ProtovalidateExamples},
+			FilesToGenerate:    []string{"ProtovalidateExamples.proto"},
+			ProtoFileName:      "ProtovalidateExamples.proto",
+		},
+		"RecursiveMaps": {
+			ExpectedJSONSchema:    []string{testdata.RecursiveMapsNode, testdata.RecursiveMapsEdge},
+			FilesToGenerate:       []string{"RecursiveMaps.proto"},
+			ProtoFileName:         "RecursiveMaps.proto",
+			ObjectsToValidateFail: []string{testdata.RecursiveMapsNodeFail, testdata.RecursiveMapsEdgeFail},
+			ObjectsToValidatePass: []string{testdata.RecursiveMapsNodePass, testdata.RecursiveMapsEdgePass},
+		},
+		"RepeatedBytes": {
+			ExpectedJSONSchema:    []string{testdata.RepeatedBytes},
+			FilesToGenerate:       []string{"RepeatedBytes.proto"},
+			ProtoFileName:         "RepeatedBytes.proto",
+			ObjectsToValidateFail: []string{testdata.RepeatedBytesFail},
+			ObjectsToValidatePass: []string{testdata.RepeatedBytesPass},
+		},
+		"RequiredByFieldNumber": {
+			Flags:                 ConverterFlags{RequiredByFieldNumber: true},
+			ExpectedJSONSchema:    []string{testdata.RequiredByFieldNumber},
+			FilesToGenerate:       []string{"RequiredByFieldNumber.proto"},
+			ProtoFileName:         "RequiredByFieldNumber.proto",
+			ObjectsToValidateFail: []string{testdata.RequiredByFieldNumberFail},
+			ObjectsToValidatePass: []string{testdata.RequiredByFieldNumberPass},
+		},
+		"RootMessage": {
+			Parameters:         "root=Order",
+			ExpectedFileNames:  []string{"Invoice.json", "Payment.json", "Order.json"},
+			ExpectedJSONSchema: []string{testdata.RootMessageInvoice, testdata.RootMessagePayment, testdata.RootMessageOrder},
+			FilesToGenerate:    []string{"RootMessage.proto", "RootMessageOther.proto"},
+			ProtoFileName:      "RootMessage.proto",
+		},
+		"SchemaTemplates": {
+			Parameters:         `id_template=https://schemas.acme.com/{env}/{package}/{version}/{file},title_template="{title}, from {package} ({unknown})",template_var=env=prod,template_var=invalid`,
+			ExpectedFileNames:  []string{"Order.json"},
+			ExpectedJSONSchema: []string{testdata.SchemaTemplates},
+			FilesToGenerate:    []string{"SchemaTemplates.proto"},
+			ProtoFileName:      "SchemaTemplates.proto",
+		},
 		"SelfReference": {
 			ExpectedJSONSchema:    []string{testdata.SelfReference},
 			FilesToGenerate:       []string{"SelfReference.proto"},
@@ -472,6 +1071,52 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.FirstMessageFail, testdata.SecondMessageFail},
 			ObjectsToValidatePass: []string{testdata.FirstMessagePass, testdata.SecondMessagePass},
 		},
+		"SharedMessages": {
+			Parameters:         "shared_messages=acme.RequestHeader",
+			ExpectedFileNames:  []string{"CreateOrder.json", "CancelOrder.json", "common.json"},
+			ExpectedJSONSchema: []string{testdata.SharedMessagesCreateOrder, testdata.SharedMessagesCancelOrder, testdata.SharedMessagesCommon},
+			FilesToGenerate:    []string{"SharedMessages.proto"},
+			ProtoFileName:      "SharedMessages.proto",
+		},
+		"SharedMessagesPrefixed": {
+			Parameters:         "shared_messages=acme.RequestHeader,prefix_schema_files_with_package",
+			ExpectedFileNames:  []string{"acme/CreateOrder.json", "acme/CancelOrder.json", "common.json"},
+			ExpectedJSONSchema: []string{testdata.SharedMessagesPrefixedCreateOrder, testdata.SharedMessagesPrefixedCancelOrder, testdata.SharedMessagesCommon},
+			FilesToGenerate:    []string{"SharedMessages.proto"},
+			ProtoFileName:      "SharedMessages.proto",
+		},
+		"SourceRevision": {
+			Parameters:         "source_revision=v1.4.0-3-gabc1234",
+			ExpectedJSONSchema: []string{testdata.SourceRevisionStatus, testdata.SourceRevision},
+			FilesToGenerate:    []string{"SourceRevision.proto", "SourceRevisionStatus.proto"},
+			ProtoFileName:      "SourceRevision.proto",
+		},
+		"SourceRevisionDraft07": {
+			Parameters:         "source_revision=v1.4.0-3-gabc1234,schema_version=draft-07",
+			ExpectedJSONSchema: []string{testdata.SourceRevisionStatusDraft07, testdata.SourceRevisionDraft07},
+			FilesToGenerate:    []string{"SourceRevision.proto", "SourceRevisionStatus.proto"},
+			ProtoFileName:      "SourceRevision.proto",
+		},
+		"SplitSchemas": {
+			Parameters:            "split_threshold=3",
+			ExpectedJSONSchema:    []string{testdata.SplitSchemas},
+			FilesToGenerate:       []string{"SplitSchemas.proto"},
+			ProtoFileName:         "SplitSchemas.proto",
+			ObjectsToValidateFail: []string{testdata.SplitSchemasFail},
+			ObjectsToValidatePass: []string{testdata.SplitSchemasPass},
+		},
+		"SplitSchemasClosed": {
+			Parameters:         "split_threshold=3,disallow_additional_properties",
+			ExpectedJSONSchema: []string{testdata.SplitSchemasClosed},
+			FilesToGenerate:    []string{"SplitSchemas.proto"},
+			ProtoFileName:      "SplitSchemas.proto",
+		},
+		"SplitSchemasUnderThreshold": {
+			Parameters:         "split_threshold=6",
+			ExpectedJSONSchema: []string{testdata.SplitSchemasUnderThreshold},
+			FilesToGenerate:    []string{"SplitSchemas.proto"},
+			ProtoFileName:      "SplitSchemas.proto",
+		},
 		"TargetedMessages": {
 			TargetedMessages:   []string{"MessageKind10", "MessageKind11", "MessageKind12"},
 			ExpectedJSONSchema: []string{testdata.MessageKind10, testdata.MessageKind11, testdata.MessageKind12},
@@ -485,6 +1130,68 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.TimestampFail},
 			ObjectsToValidatePass: []string{testdata.TimestampPass},
 		},
+		"TypeURLNames": {
+			Parameters:         "type_url_names",
+			ExpectedFileNames:  []string{"type.googleapis.com/acme.v1.Order.json"},
+			ExpectedJSONSchema: []string{testdata.TypeURLNames},
+			FilesToGenerate:    []string{"TypeURLNames.proto"},
+			ProtoFileName:      "TypeURLNames.proto",
+		},
+		"TypeURLNamesDraft07": {
+			Parameters:         "type_url_names,schema_version=draft-07",
+			ExpectedFileNames:  []string{"type.googleapis.com/acme.v1.Order.json"},
+			ExpectedJSONSchema: []string{testdata.TypeURLNamesDraft07},
+			FilesToGenerate:    []string{"TypeURLNames.proto"},
+			ProtoFileName:      "TypeURLNames.proto",
+		},
+		"TypeURLNamesTemplated": {
+			Parameters:         "type_url_names,id_template=https://schemas.acme.com/{message}.json",
+			ExpectedFileNames:  []string{"type.googleapis.com/acme.v1.Order.json"},
+			ExpectedJSONSchema: []string{testdata.TypeURLNamesTemplated},
+			FilesToGenerate:    []string{"TypeURLNames.proto"},
+			ProtoFileName:      "TypeURLNames.proto",
+		},
+		"UnknownFields": {
+			ExpectedFileNames:  []string{"StrictOrder.json", "Labels.json"},
+			ExpectedJSONSchema: []string{testdata.UnknownFieldsStrictOrder, testdata.UnknownFieldsLabels},
+			FilesToGenerate:    []string{"UnknownFields.proto"},
+			ProtoFileName:      "UnknownFields.proto",
+		},
+		"UnknownFieldsDiscard": {
+			Parameters:         "disallow_additional_properties,unknown_fields=discard",
+			ExpectedFileNames:  []string{"StrictOrder.json", "Labels.json"},
+			ExpectedJSONSchema: []string{testdata.UnknownFieldsDiscardStrictOrder, testdata.UnknownFieldsDiscardLabels},
+			FilesToGenerate:    []string{"UnknownFields.proto"},
+			ProtoFileName:      "UnknownFields.proto",
+		},
+		"UnknownFieldsIgnored": {
+			Parameters:         "unknown_fields=ignore",
+			ExpectedFileNames:  []string{"StrictOrder.json", "Labels.json"},
+			ExpectedJSONSchema: []string{testdata.UnknownFieldsStrictOrder, testdata.UnknownFieldsLabels},
+			FilesToGenerate:    []string{"UnknownFields.proto"},
+			ProtoFileName:      "UnknownFields.proto",
+		},
+		"UnknownFieldsReject": {
+			Parameters:         "unknown_fields=reject",
+			ExpectedFileNames:  []string{"StrictOrder.json", "Labels.json"},
+			ExpectedJSONSchema: []string{testdata.UnknownFieldsRejectStrictOrder, testdata.UnknownFieldsRejectLabels},
+			FilesToGenerate:    []string{"UnknownFields.proto"},
+			ProtoFileName:      "UnknownFields.proto",
+		},
+		"UnknownOptions": {
+			Flags:              ConverterFlags{PreserveUnknownOptions: true},
+			ExpectedJSONSchema: []string{testdata.UnknownOptions},
+			FilesToGenerate:    []string{"UnknownOptions.proto"},
+			ProtoFileName:      "UnknownOptions.proto",
+		},
+		"UnresolvedTypes": {
+			Parameters:         "lenient_lookup",
+			ExpectedFileNames:  []string{"Order.json"},
+			ExpectedJSONSchema: []string{testdata.UnresolvedTypes},
+			FilesToGenerate:    []string{"UnresolvedTypes.proto"},
+			MissingImports:     []string{"UnresolvedTypesCommon.proto"},
+			ProtoFileName:      "UnresolvedTypes.proto",
+		},
 		"ValidationOptions": {
 			ExpectedJSONSchema:    []string{testdata.ValidationOptions},
 			FilesToGenerate:       []string{"ValidationOptions.proto"},
@@ -492,6 +1199,29 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.ValidationOptionsFail},
 			ObjectsToValidatePass: []string{testdata.ValidationOptionsPass},
 		},
+		"Visibility": {
+			ExpectedJSONSchema: []string{testdata.Account},
+			FilesToGenerate:    []string{"Visibility.proto"},
+			ProtoFileName:      "Visibility.proto",
+		},
+		"VisibilityPreview": {
+			Parameters:         "visibility_labels=PREVIEW",
+			ExpectedJSONSchema: []string{testdata.AccountPreview, testdata.BetaFeatures},
+			FilesToGenerate:    []string{"Visibility.proto"},
+			ProtoFileName:      "Visibility.proto",
+		},
+		"VisibilityInternalPreview": {
+			Parameters:         "visibility_labels=INTERNAL+PREVIEW",
+			ExpectedJSONSchema: []string{testdata.AccountInternalPreview, testdata.BetaFeatures},
+			FilesToGenerate:    []string{"Visibility.proto"},
+			ProtoFileName:      "Visibility.proto",
+		},
+		"VisibilityRepeatedLabels": {
+			Parameters:         "visibility_labels=INTERNAL,visibility_labels=PREVIEW",
+			ExpectedJSONSchema: []string{testdata.AccountInternalPreview, testdata.BetaFeatures},
+			FilesToGenerate:    []string{"Visibility.proto"},
+			ProtoFileName:      "Visibility.proto",
+		},
 		"WellKnown": {
 			ExpectedJSONSchema:    []string{testdata.WellKnown},
 			FilesToGenerate:       []string{"WellKnown.proto"},
@@ -499,6 +1229,28 @@ func configureSampleProtos() map[string]sampleProto {
 			ObjectsToValidateFail: []string{testdata.WellKnownFail},
 			ObjectsToValidatePass: []string{testdata.WellKnownPass},
 		},
+		"WellKnownAsMessages": {
+			Flags:                 ConverterFlags{WellKnownTypesAsMessages: true},
+			ExpectedJSONSchema:    []string{testdata.WellKnownAsMessages},
+			FilesToGenerate:       []string{"WellKnown.proto"},
+			ProtoFileName:         "WellKnown.proto",
+			ObjectsToValidateFail: []string{testdata.WellKnownAsMessagesFail},
+			ObjectsToValidatePass: []string{testdata.WellKnownAsMessagesPass},
+		},
+		"WellKnownTypes": {
+			ExpectedJSONSchema:    []string{testdata.WellKnownTypes},
+			FilesToGenerate:       []string{"WellKnownTypes.proto"},
+			ProtoFileName:         "WellKnownTypes.proto",
+			ObjectsToValidateFail: []string{testdata.WellKnownTypesFail},
+			ObjectsToValidatePass: []string{testdata.WellKnownTypesPass},
+		},
+		"WrapperCollections": {
+			ExpectedJSONSchema:    []string{testdata.WrapperCollections},
+			FilesToGenerate:       []string{"WrapperCollections.proto"},
+			ProtoFileName:         "WrapperCollections.proto",
+			ObjectsToValidateFail: []string{testdata.WrapperCollectionsFail},
+			ObjectsToValidatePass: []string{testdata.WrapperCollectionsPass},
+		},
 	}
 }
 
@@ -533,6 +1285,14 @@ func mustReadProtoFiles(t *testing.T, includePath string, filenames ...string) *
 	if err != nil {
 		t.Fatalf("failed to parse protoc output as FileDescriptorSet: %s", err.Error())
 	}
+
+	// The comments of the well-known types change from one release of protoc (and its includes) to the next, so they're
+	// left out to keep the expected schemas the same wherever the tests run:
+	for _, fileDesc := range fds.GetFile() {
+		if strings.HasPrefix(fileDesc.GetName(), "google/protobuf/") {
+			fileDesc.SourceCodeInfo = nil
+		}
+	}
 	return fds
 }
 
@@ -553,3 +1313,78 @@ func validateSchema(jsonSchema, jsonData string) (bool, error) {
 
 	return result.Valid(), nil
 }
+
+// newTestLogger makes a Logrus logger for tests (which only reports errors):
+func newTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	logger.SetOutput(os.Stderr)
+	return logger
+}
+
+// convertTestRequest converts a request with a new Converter:
+func convertTestRequest(request *plugin.CodeGeneratorRequest) (*plugin.CodeGeneratorResponse, error) {
+	return New(newTestLogger()).convert(request)
+}
+
+// testRequest makes a request to generate every one of some (hand-built) proto files, with generator parameters:
+func testRequest(parameters string, protoFiles ...*descriptor.FileDescriptorProto) *plugin.CodeGeneratorRequest {
+	request := &plugin.CodeGeneratorRequest{
+		Parameter: proto.String(parameters),
+		ProtoFile: protoFiles,
+	}
+	for _, protoFile := range protoFiles {
+		request.FileToGenerate = append(request.FileToGenerate, protoFile.GetName())
+	}
+	return request
+}
+
+// fileRequest makes a request to generate one of the given proto files, with generator parameters:
+func fileRequest(fileToGenerate, parameters string, protoFiles ...*descriptor.FileDescriptorProto) *plugin.CodeGeneratorRequest {
+	return &plugin.CodeGeneratorRequest{
+		FileToGenerate: []string{fileToGenerate},
+		Parameter:      proto.String(parameters),
+		ProtoFile:      protoFiles,
+	}
+}
+
+// sampleRequest makes a request to generate one of the sample protos (along with whatever it imports), with generator
+// parameters:
+func sampleRequest(t *testing.T, parameters, protoFileName string) *plugin.CodeGeneratorRequest {
+	return fileRequest(protoFileName, parameters, mustReadProtoFiles(t, sampleProtoDirectory, protoFileName).GetFile()...)
+}
+
+// testProtoFile makes a (proto3) file of messages:
+func testProtoFile(name, pkg string, messages ...*descriptor.DescriptorProto) *descriptor.FileDescriptorProto {
+	return &descriptor.FileDescriptorProto{
+		Name:        proto.String(name),
+		Package:     proto.String(pkg),
+		MessageType: messages,
+		Syntax:      proto.String("proto3"),
+	}
+}
+
+// testMessage makes a message of fields:
+func testMessage(name string, fields ...*descriptor.FieldDescriptorProto) *descriptor.DescriptorProto {
+	return &descriptor.DescriptorProto{Name: proto.String(name), Field: fields}
+}
+
+// testStringField makes an optional string field:
+func testStringField(name string, number int32) *descriptor.FieldDescriptorProto {
+	return testField(name, number, descriptor.FieldDescriptorProto_TYPE_STRING, "")
+}
+
+// testField makes an optional field of a type (and type name, for messages and enums), with the JSON name protoc gives it:
+func testField(name string, number int32, fieldType descriptor.FieldDescriptorProto_Type, typeName string) *descriptor.FieldDescriptorProto {
+	field := &descriptor.FieldDescriptorProto{
+		Name:     proto.String(name),
+		Number:   proto.Int32(number),
+		Type:     fieldType.Enum(),
+		Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		JsonName: proto.String(strcase.ToLowerCamel(name)),
+	}
+	if typeName != "" {
+		field.TypeName = proto.String(typeName)
+	}
+	return field
+}
@@ -0,0 +1,164 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// definitionCacheKey identifies a converted message definition. Besides the flags it was converted with, a definition
+// depends on the names it refers to other messages by (which differ with the schema it is part of):
+type definitionCacheKey struct {
+	flags      ConverterFlags
+	msgDesc    *descriptor.DescriptorProto
+	references string
+	sharing    bool
+}
+
+// cachedDefinition is a converted message definition, along with any ENUM definitions it added along the way (and the
+// depth it was converted at, along with how deeply it inlined other messages, which decide whether it can be reused):
+type cachedDefinition struct {
+	definition      *jsonschema.Type
+	enumDefinitions jsonschema.Definitions
+	depth           int
+	nesting         int
+}
+
+// convertMessageDefinition converts a message into a definition (for the schema being generated), reusing the definition
+// from any schema which has already converted it. Definitions get modified once they're part of a schema, so the cache
+// only ever hands out copies:
+func (c *Converter) convertMessageDefinition(curPkg *ProtoPackage, msgDesc *descriptor.DescriptorProto, duplicatedMessages map[*descriptor.DescriptorProto]string) (*jsonschema.Type, error) {
+	key := definitionCacheKey{
+		flags:      c.Flags,
+		msgDesc:    msgDesc,
+		references: c.definitionReferences(curPkg, msgDesc, duplicatedMessages),
+		sharing:    len(c.sharedMessages) > 0,
+	}
+
+	cached, ok := c.definitionCache[key]
+	if !ok || !c.reusableDefinition(cached) {
+		enumDefinitions, deepestMessageDepth := c.enumDefinitions, c.deepestMessageDepth
+		c.enumDefinitions, c.deepestMessageDepth = jsonschema.Definitions{}, c.messageDepth
+		definition, err := c.recursiveConvertMessageType(curPkg, msgDesc, "", duplicatedMessages, true)
+		enumDefinitions, c.enumDefinitions = c.enumDefinitions, enumDefinitions
+		nesting := c.deepestMessageDepth - c.messageDepth
+		if deepestMessageDepth > c.deepestMessageDepth {
+			c.deepestMessageDepth = deepestMessageDepth
+		}
+		if err != nil {
+			return nil, err
+		}
+		cached = &cachedDefinition{definition: definition, enumDefinitions: enumDefinitions, depth: c.messageDepth, nesting: nesting}
+
+		if c.definitionCache == nil {
+			c.definitionCache = make(map[definitionCacheKey]*cachedDefinition)
+		}
+		c.definitionCache[key] = cached
+	}
+
+	for name, enumDefinition := range cached.enumDefinitions {
+		if _, ok := c.enumDefinitions[name]; !ok {
+			c.enumDefinitions[name] = copyType(enumDefinition)
+		}
+	}
+	return copyType(cached.definition), nil
+}
+
+// definitionReferences lists the names a message refers to other messages by (including those of its map values and
+// Any payloads, which are converted along with it):
+func (c *Converter) definitionReferences(curPkg *ProtoPackage, msgDesc *descriptor.DescriptorProto, duplicatedMessages map[*descriptor.DescriptorProto]string) string {
+	var references []string
+	var addReferences func(msgDesc *descriptor.DescriptorProto)
+	addReferences = func(msgDesc *descriptor.DescriptorProto) {
+		for _, fieldDesc := range msgDesc.GetField() {
+			for _, typeName := range append([]string{fieldDesc.GetTypeName()}, anyFieldTypes(fieldDesc)...) {
+				if typeName == "" {
+					continue
+				}
+				recordType, _, ok := c.lookupType(curPkg, typeName)
+				if !ok {
+					continue
+				}
+				if name, ok := duplicatedMessages[recordType]; ok {
+					references = append(references, name)
+				} else if recordType.GetOptions().GetMapEntry() {
+					addReferences(recordType)
+				}
+			}
+		}
+	}
+	addReferences(msgDesc)
+	return strings.Join(references, ",")
+}
+
+// copyType makes a deep copy of a schema (values which we never modify in place, such as enums and examples, are shared):
+func copyType(jsonSchemaType *jsonschema.Type) *jsonschema.Type {
+	if jsonSchemaType == nil {
+		return nil
+	}
+
+	copied := *jsonSchemaType
+	copied.AdditionalItems = copyType(jsonSchemaType.AdditionalItems)
+	copied.Items = copyType(jsonSchemaType.Items)
+	copied.Not = copyType(jsonSchemaType.Not)
+	copied.Media = copyType(jsonSchemaType.Media)
+	copied.AllOf = copyTypes(jsonSchemaType.AllOf)
+	copied.AnyOf = copyTypes(jsonSchemaType.AnyOf)
+	copied.OneOf = copyTypes(jsonSchemaType.OneOf)
+	copied.PatternProperties = copyTypeMap(jsonSchemaType.PatternProperties)
+	copied.Dependencies = copyTypeMap(jsonSchemaType.Dependencies)
+	copied.Definitions = copyTypeMap(jsonSchemaType.Definitions)
+	if jsonSchemaType.Required != nil {
+		copied.Required = append([]string{}, jsonSchemaType.Required...)
+	}
+
+	if jsonSchemaType.Properties != nil {
+		copied.Properties = orderedmap.New()
+		for _, propertyName := range jsonSchemaType.Properties.Keys() {
+			property, _ := jsonSchemaType.Properties.Get(propertyName)
+			if propertyJSONSchemaType, ok := property.(*jsonschema.Type); ok {
+				property = copyType(propertyJSONSchemaType)
+			}
+			copied.Properties.Set(propertyName, property)
+		}
+	}
+
+	if jsonSchemaType.Extras != nil {
+		copied.Extras = make(map[string]interface{}, len(jsonSchemaType.Extras))
+		for key, value := range jsonSchemaType.Extras {
+			switch extra := value.(type) {
+			case *jsonschema.Type:
+				value = copyType(extra)
+			case additionalProperties:
+				value = additionalProperties{allowed: extra.allowed, schema: copyType(extra.schema)}
+			}
+			copied.Extras[key] = value
+		}
+	}
+
+	return &copied
+}
+
+func copyTypes(jsonSchemaTypes []*jsonschema.Type) []*jsonschema.Type {
+	if jsonSchemaTypes == nil {
+		return nil
+	}
+	copied := make([]*jsonschema.Type, len(jsonSchemaTypes))
+	for i, jsonSchemaType := range jsonSchemaTypes {
+		copied[i] = copyType(jsonSchemaType)
+	}
+	return copied
+}
+
+func copyTypeMap(jsonSchemaTypes map[string]*jsonschema.Type) map[string]*jsonschema.Type {
+	if jsonSchemaTypes == nil {
+		return nil
+	}
+	copied := make(map[string]*jsonschema.Type, len(jsonSchemaTypes))
+	for name, jsonSchemaType := range jsonSchemaTypes {
+		copied[name] = copyType(jsonSchemaType)
+	}
+	return copied
+}
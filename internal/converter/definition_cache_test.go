@@ -0,0 +1,60 @@
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestDefinitionCache(t *testing.T) {
+
+	// Two files which both need PayloadMessage (along with one which neither of them import):
+	fileDescriptorSet := mustReadProtoFiles(t, sampleProtoDirectory, "ArrayOfMessages.proto", "SeveralMessages.proto")
+	converter := New(newTestLogger())
+	response, err := converter.convert(&plugin.CodeGeneratorRequest{
+		FileToGenerate: []string{"PayloadMessage.proto", "ArrayOfMessages.proto"},
+		ProtoFile:      fileDescriptorSet.GetFile(),
+	})
+	require.NoError(t, err)
+	schemas := make(map[string]map[string]interface{})
+	for _, file := range response.GetFile() {
+		var schema map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(file.GetContent()), &schema))
+		schemas[file.GetName()] = schema
+	}
+	require.Len(t, schemas, 2)
+
+	// PayloadMessage was only converted once, but is defined the same way in both schemas:
+	assert.Len(t, converter.definitionCache, 2)
+	payloadDefinitions := schemas["PayloadMessage.json"]["definitions"].(map[string]interface{})
+	arrayDefinitions := schemas["ArrayOfMessages.json"]["definitions"].(map[string]interface{})
+	assert.Equal(t, payloadDefinitions["PayloadMessage"], arrayDefinitions["samples.PayloadMessage"])
+
+	// Files which aren't imported by anything we're generating don't get registered:
+	_, _, ok := converter.lookupType(converter.rootPkg, ".samples.PayloadMessage")
+	assert.True(t, ok)
+	_, _, ok = converter.lookupType(converter.rootPkg, ".samples.FirstMessage")
+	assert.False(t, ok)
+}
+
+func TestCopyType(t *testing.T) {
+	property := &jsonschema.Type{Type: "string"}
+	original := &jsonschema.Type{Type: "object", Properties: orderedmap.New(), Required: []string{"name"}}
+	original.Properties.Set("name", property)
+	setAdditionalPropertiesSchema(original, &jsonschema.Type{Type: "integer"})
+
+	// Changing a copy (however deep) leaves the original alone:
+	copied := copyType(original)
+	copied.Required[0] = "id"
+	copiedProperty, _ := copied.Properties.Get("name")
+	copiedProperty.(*jsonschema.Type).Type = "integer"
+	additionalPropertiesSchema(copied).Type = "string"
+	assert.Equal(t, []string{"name"}, original.Required)
+	assert.Equal(t, "string", property.Type)
+	assert.Equal(t, "integer", additionalPropertiesSchema(original).Type)
+}
@@ -0,0 +1,95 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/iancoleman/orderedmap"
+)
+
+const slugEscape = '_'
+
+// SlugDefinitions wraps a naming strategy so that the definition names it comes up with are always safe to address by
+// fragment (in URI fragments, JSON pointers, and as plain-name $anchors), for registries which address subschemas that
+// way. Characters which aren't (eg "/", "~", spaces or non-ASCII) are escaped as "_XX" (the hex of each of their bytes),
+// and so is the escape itself wherever it would be ambiguous, so different names always get different slugs:
+func SlugDefinitions(strategy NamingStrategy) NamingStrategy {
+	return slugNaming{NamingStrategy: strategy}
+}
+
+// slugNaming is a naming strategy with slugged definition names:
+type slugNaming struct {
+	NamingStrategy
+}
+
+func (n slugNaming) DefinitionName(fullName string) string {
+	return definitionSlug(n.NamingStrategy.DefinitionName(fullName))
+}
+
+// definitionSlug escapes a definition name to match the plain-name anchors of JSON-Schema ("^[A-Za-z_][-A-Za-z0-9._]*$"),
+// which are also safe in URI fragments and JSON pointers. Names which already do (such as fully-qualified proto names) are
+// left as they are, unless they contain something which looks like an escape (eg "Order_AB" becomes "Order_5FAB"):
+func definitionSlug(name string) string {
+	var slug strings.Builder
+	for index := 0; index < len(name); index++ {
+		char := name[index]
+		switch {
+		case char == slugEscape && isSlugEscape(name[index:]):
+			fmt.Fprintf(&slug, "%c%02X", slugEscape, char)
+		case isAnchorChar(char, index == 0):
+			slug.WriteByte(char)
+		default:
+			fmt.Fprintf(&slug, "%c%02X", slugEscape, char)
+		}
+	}
+	return slug.String()
+}
+
+// isAnchorChar tells us whether a character can appear (at the start of) a plain-name anchor:
+func isAnchorChar(char byte, first bool) bool {
+	switch {
+	case char >= 'A' && char <= 'Z', char >= 'a' && char <= 'z', char == slugEscape:
+		return true
+	case char >= '0' && char <= '9', char == '-', char == '.':
+		return !first
+	}
+	return false
+}
+
+// isSlugEscape tells us whether a string starts with an escape ("_" and two upper-case hex digits):
+func isSlugEscape(s string) bool {
+	return len(s) >= 3 && s[0] == slugEscape && isUpperHex(s[1]) && isUpperHex(s[2])
+}
+
+// isUpperHex tells us whether a character is an upper-case hex digit:
+func isUpperHex(char byte) bool {
+	return (char >= '0' && char <= '9') || (char >= 'A' && char <= 'F')
+}
+
+// anchorDefinitions gives every definition a plain-name $anchor of its (slugged) name (2019-09 onwards), so that
+// registries can address it as "#<name>" as well as by JSON pointer:
+func anchorDefinitions(root *orderedmap.OrderedMap) {
+	definitions, _ := root.Get("$defs")
+	if definitions, ok := definitions.(*orderedmap.OrderedMap); ok {
+		for _, name := range definitions.Keys() {
+			if definition, _ := definitions.Get(name); definition != nil {
+				if definition, ok := definition.(*orderedmap.OrderedMap); ok && isAnchorName(name) {
+					definition.Set("$anchor", name)
+				}
+			}
+		}
+	}
+}
+
+// isAnchorName tells us whether a name can be used as a plain-name anchor as it is:
+func isAnchorName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for index := 0; index < len(name); index++ {
+		if !isAnchorChar(name[index], index == 0) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package converter
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// registryNaming defines messages under their type URLs (which aren't safe to address by fragment as they are):
+type registryNaming struct {
+	NamingStrategy
+}
+
+func (n registryNaming) DefinitionName(fullName string) string {
+	return "type.acme.com/" + fullName + "~v1"
+}
+
+// anchorPattern is what plain-name anchors have to look like (which also makes them safe in URIs and JSON pointers):
+var anchorPattern = regexp.MustCompile(`^[A-Za-z_][-A-Za-z0-9._]*$`)
+
+// unslug reverses definitionSlug (to show that no information is lost):
+func unslug(slug string) string {
+	var name []byte
+	for index := 0; index < len(slug); index++ {
+		if isSlugEscape(slug[index:]) {
+			char, _ := strconv.ParseUint(slug[index+1:index+3], 16, 8)
+			name = append(name, byte(char))
+			index += 2
+			continue
+		}
+		name = append(name, slug[index])
+	}
+	return string(name)
+}
+
+func TestDefinitionSlug(t *testing.T) {
+
+	// Proto names are left as they are (unless they look like they contain escapes):
+	assert.Equal(t, "samples.PayloadMessage", definitionSlug("samples.PayloadMessage"))
+	assert.Equal(t, "acme_v1.Order_Line", definitionSlug("acme_v1.Order_Line"))
+	assert.Equal(t, "acme.Order_5FAB", definitionSlug("acme.Order_AB"))
+
+	// Anything else is escaped:
+	assert.Equal(t, "type.acme.com_2Facme.Order_7Ev1", definitionSlug("type.acme.com/acme.Order~v1"))
+	assert.Equal(t, "_31st_20Order", definitionSlug("1st Order"))
+	assert.Equal(t, "_2Ehidden", definitionSlug(".hidden"))
+	assert.Equal(t, "caf_C3_A9", definitionSlug("café"))
+	assert.Equal(t, "_23_2Fdefinitions_2FX", definitionSlug("#/definitions/X"))
+
+	// Every slug is a valid anchor, and different names never get the same slug:
+	slugs := make(map[string]string)
+	for _, name := range []string{"", "_", "__", "_2F", "/", "_/", "_A/", "_AB", "_5F", "_5FAB", "a-b", "-ab", "a.b", "a~b", "a_7Eb", "Order_ab", "Order_AB", "é", "_C3_A9"} {
+		slug := definitionSlug(name)
+		if name != "" {
+			assert.True(t, anchorPattern.MatchString(slug), "%q has an invalid slug: %q", name, slug)
+		}
+		assert.Equal(t, name, unslug(slug))
+		if other, ok := slugs[slug]; ok {
+			t.Errorf("%q and %q have the same slug: %q", name, other, slug)
+		}
+		slugs[slug] = name
+	}
+}
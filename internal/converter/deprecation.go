@@ -0,0 +1,21 @@
+package converter
+
+import (
+	"github.com/alecthomas/jsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const deprecatedKeyword = "x-deprecated"
+
+// stampDeprecated marks a top-level schema as deprecated if its file (or the message / enum it describes) is:
+func (c *Converter) stampDeprecated(file *descriptor.FileDescriptorProto, deprecated bool, jsonSchemaType *jsonschema.Type) {
+	if !deprecated && !file.GetOptions().GetDeprecated() {
+		return
+	}
+	setExtras(jsonSchemaType, map[string]interface{}{deprecatedKeyword: true})
+}
+
+// skippedAsDeprecated tells us whether something deprecated should be left out of generated schemas:
+func (c *Converter) skippedAsDeprecated(deprecated bool) bool {
+	return c.Flags.SkipDeprecated && deprecated
+}
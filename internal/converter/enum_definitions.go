@@ -0,0 +1,22 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/alecthomas/jsonschema"
+)
+
+// enumDefinitionRef adds an ENUM to the definitions of the schema being generated (under its fully-qualified name),
+// and returns a $ref to it. Outside of a message schema there are no definitions, so the ENUM is used as it is:
+func (c *Converter) enumDefinitionRef(fullEnumIdentifier string, enumJSONSchemaType *jsonschema.Type) *jsonschema.Type {
+	if c.enumDefinitions == nil {
+		return enumJSONSchemaType
+	}
+	if c.Flags.DefinitionSlugs {
+		fullEnumIdentifier = definitionSlug(fullEnumIdentifier)
+	}
+	if _, ok := c.enumDefinitions[fullEnumIdentifier]; !ok {
+		c.enumDefinitions[fullEnumIdentifier] = enumJSONSchemaType
+	}
+	return &jsonschema.Type{Ref: fmt.Sprintf("%s%s", c.refPrefix, fullEnumIdentifier)}
+}
@@ -0,0 +1,72 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const defaultEnvelopePayloadField = "payload"
+
+// wrapInEnvelope wraps a message schema in the configured envelope message (the message takes the place of the envelope's payload field):
+func (c *Converter) wrapInEnvelope(msgDesc *descriptor.DescriptorProto, messageJSONSchema *jsonschema.Schema) (*jsonschema.Schema, error) {
+
+	// Find the envelope (template) message:
+	envelopeDesc, envelopePkgName, ok := c.lookupType(c.rootPkg, "."+strings.TrimPrefix(c.envelopeMessage, "."))
+	if !ok {
+		return nil, fmt.Errorf("no such envelope message: %s", c.envelopeMessage)
+	}
+
+	// The envelope doesn't get wrapped in itself:
+	if envelopeDesc == msgDesc {
+		return messageJSONSchema, nil
+	}
+
+	envelopePkg, ok := c.relativelyLookupPackage(c.rootPkg, strings.TrimPrefix(envelopePkgName, "."))
+	if !ok {
+		return nil, fmt.Errorf("no such package found: %s", envelopePkgName)
+	}
+
+	// Convert the envelope:
+	envelopeJSONSchema, err := c.convertMessageType(envelopePkg, envelopeDesc)
+	if err != nil {
+		return nil, err
+	}
+	envelopeJSONSchemaType, ok := envelopeJSONSchema.Definitions[envelopeDesc.GetName()]
+	if !ok || envelopeJSONSchemaType.Properties == nil {
+		return nil, fmt.Errorf("envelope message %s has no fields", c.envelopeMessage)
+	}
+
+	// Replace the payload field with a reference to our message:
+	payloadField := c.envelopePayloadField
+	if payloadField == "" {
+		payloadField = defaultEnvelopePayloadField
+	}
+	payload, ok := envelopeJSONSchemaType.Properties.Get(payloadField)
+	if !ok {
+		return nil, fmt.Errorf("envelope message %s has no %s field", c.envelopeMessage, payloadField)
+	}
+	payloadJSONSchemaType := &jsonschema.Type{Ref: fmt.Sprintf("%s%s", c.refPrefix, msgDesc.GetName())}
+	if payloadType, ok := payload.(*jsonschema.Type); ok {
+		payloadJSONSchemaType.Title = payloadType.Title
+		payloadJSONSchemaType.Description = payloadType.Description
+	}
+	envelopeJSONSchemaType.Properties.Set(payloadField, payloadJSONSchemaType)
+
+	// Merge the definitions (our message's take precedence), with the envelope itself as the root type:
+	definitions := messageJSONSchema.Definitions
+	for name, definition := range envelopeJSONSchema.Definitions {
+		if _, ok := definitions[name]; ok || name == envelopeDesc.GetName() {
+			continue
+		}
+		definitions[name] = definition
+	}
+	envelopeJSONSchemaType.Version = c.schemaVersion
+
+	return &jsonschema.Schema{
+		Type:        envelopeJSONSchemaType,
+		Definitions: definitions,
+	}, nil
+}
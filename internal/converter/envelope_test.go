@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvelopeErrors(t *testing.T) {
+
+	// A missing payload field is an error (see the Envelope sample for envelopes which work):
+	_, err := convertTestRequest(sampleRequest(t, "envelope=acme.Envelope,envelope_payload_field=data", "Envelope.proto"))
+	assert.Error(t, err)
+
+	// So is a missing envelope:
+	_, err = convertTestRequest(sampleRequest(t, "envelope=acme.Missing", "Envelope.proto"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,70 @@
+package converter
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	flagSourceMessageOption = "message option"
+	flagSourceParameter     = "parameter"
+)
+
+// flagNames lists every flag which can be set by name (as generator parameters, in profiles and on routes):
+var flagNames = taggedFlagNames()
+
+// enabled tells us whether a flag (by name) is turned on:
+func (f ConverterFlags) enabled(name string) bool {
+	field, ok := flagFields[name]
+	return ok && reflect.ValueOf(f).Field(field).Bool()
+}
+
+// explainFileConfig logs the effective flags for a proto file, along with where each of them came from:
+func (c *Converter) explainFileConfig(protoFileName string, flagSources map[string]string) {
+	c.explainedMessages = make(map[string]bool)
+
+	// Explanations are logged at info level, so asking for them is asking for (at least) that much logging:
+	if !c.logger.IsLevelEnabled(logrus.InfoLevel) {
+		c.logger.SetLevel(logrus.InfoLevel)
+	}
+
+	for _, name := range flagNames {
+		source, ok := flagSources[name]
+		if !ok {
+			if !c.Flags.enabled(name) {
+				continue
+			}
+			source = flagSourceParameter
+		}
+		c.logger.WithField("proto_filename", protoFileName).WithField("flag", name).WithField("value", c.Flags.enabled(name)).WithField("source", source).Info("Effective option")
+	}
+}
+
+// explainMessageConfig logs any flags which a message's own options have turned on (once per message):
+func (c *Converter) explainMessageConfig(msgDesc *descriptor.DescriptorProto, messageFlags ConverterFlags) {
+	if c.explainedMessages[msgDesc.GetName()] {
+		return
+	}
+	if c.explainedMessages == nil {
+		c.explainedMessages = make(map[string]bool)
+	}
+	c.explainedMessages[msgDesc.GetName()] = true
+
+	var names []string
+	for _, name := range flagNames {
+		if messageFlags.enabled(name) && !c.Flags.enabled(name) {
+			names = append(names, name)
+		}
+	}
+	if messageFlags.EnumsAsConstants && !c.Flags.EnumsAsConstants {
+		names = append(names, "enums_as_constants")
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		c.logger.WithField("msg_name", msgDesc.GetName()).WithField("flag", name).WithField("value", true).WithField("source", flagSourceMessageOption).Info("Effective option")
+	}
+}
@@ -0,0 +1,84 @@
+package converter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+func TestFlagNames(t *testing.T) {
+
+	// Every flag can be set by name (apart from EnumsAsConstants, which is only a proto option, and KeepNewLinesInDescription):
+	assert.Len(t, flagNames, reflect.TypeOf(ConverterFlags{}).NumField()-2)
+	for _, name := range flagNames {
+		var flags ConverterFlags
+		assert.True(t, flags.set(name, true), name)
+		assert.True(t, flags.enabled(name), name)
+	}
+}
+
+func TestExplainConfig(t *testing.T) {
+
+	// Make a Logrus logger (which records what it logs) at the default level of protoc-gen-jsonschema (which explaining
+	// raises to info):
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(logrus.WarnLevel)
+
+	directory, err := ioutil.TempDir("", "protoc-gen-jsonschema")
+	require.NoError(t, err)
+	defer os.RemoveAll(directory)
+	configFileName := filepath.Join(directory, "config.json")
+	require.NoError(t, ioutil.WriteFile(configFileName, []byte(`{"profiles": [{"package": "acme.*", "flags": {"disallow_additional_properties": false}}]}`), 0644))
+
+	// A message which requires all of its fields (with a proto option):
+	messageOptions := &descriptor.MessageOptions{}
+	proto.SetExtension(messageOptions, protoc_gen_jsonschema.E_MessageOptions, &protoc_gen_jsonschema.MessageOptions{AllFieldsRequired: true})
+	fileDesc := &descriptor.FileDescriptorProto{
+		Name:    proto.String("acme/order.proto"),
+		Package: proto.String("acme"),
+		MessageType: []*descriptor.DescriptorProto{
+			{
+				Name:    proto.String("Order"),
+				Options: messageOptions,
+				Field: []*descriptor.FieldDescriptorProto{
+					testStringField("id", 1),
+				},
+			},
+		},
+		Syntax: proto.String("proto3"),
+	}
+
+	response, err := New(logger).convert(fileRequest("acme/order.proto", "explain_config,disallow_additional_properties,route=acme.*=schemas;disallow_additional_properties;enforce_oneof,config_file="+configFileName, fileDesc))
+	require.NoError(t, err)
+	require.Len(t, response.File, 1)
+
+	// The config file beats parameters (including routes), and proto options beat both:
+	assert.Contains(t, response.File[0].GetContent(), `"additionalProperties": true`)
+	assert.Contains(t, response.File[0].GetContent(), `"required": [`)
+
+	// Every effective option gets explained:
+	explained := make(map[string]logrus.Fields)
+	for _, entry := range hook.AllEntries() {
+		if entry.Message == "Effective option" {
+			assert.Equal(t, logrus.InfoLevel, entry.Level)
+			explained[entry.Data["flag"].(string)] = entry.Data
+		}
+	}
+	assert.Equal(t, "config profile acme.*", explained["disallow_additional_properties"]["source"])
+	assert.Equal(t, false, explained["disallow_additional_properties"]["value"])
+	assert.Equal(t, "route acme.*", explained["enforce_oneof"]["source"])
+	assert.Equal(t, "parameter", explained["explain_config"]["source"])
+	assert.Equal(t, "message option", explained["all_fields_required"]["source"])
+	assert.Equal(t, "Order", explained["all_fields_required"]["msg_name"])
+}
@@ -0,0 +1,77 @@
+package converter
+
+import (
+	"github.com/alecthomas/jsonschema"
+	"github.com/xeipuuv/gojsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Patterns of the strings which proto3's JSON mapping accepts when unmarshalling scalars (as implemented by the official
+// unmarshalers), for the faithful_protojson parameter:
+const (
+	// Numbers can be quoted (as JSON number literals, so no leading zeros, "+" signs or whitespace). Integers are only
+	// accepted as plain digits (protojson tolerates integral fractions and exponents such as "1.0" and "1e2" too, but
+	// never writes them, and they can't be told apart from "1.5" by a pattern):
+	protojsonNumberPattern   = `^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`
+	protojsonIntegerPattern  = `^-?(0|[1-9][0-9]*)$`
+	protojsonUnsignedPattern = `^(0|[1-9][0-9]*)$`
+
+	// Bytes are base64 with either the standard or the URL-safe alphabet (but not a mix of the two), padded or not (but
+	// only padded to a multiple of four):
+	protojsonBase64Pattern = `^(([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}(==)?|[A-Za-z0-9+/]{3}=?)?|([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}(==)?|[A-Za-z0-9_-]{3}=?)?)$`
+)
+
+// protojsonFloatStrings are the strings proto3's JSON mapping uses for the floats JSON numbers can't represent:
+var protojsonFloatStrings = []interface{}{"NaN", "Infinity", "-Infinity"}
+
+// protojsonEncodings returns the encodings which proto3's JSON mapping accepts for a numeric type besides JSON numbers
+// (or nil for other types):
+func protojsonEncodings(fieldType descriptor.FieldDescriptorProto_Type) []*jsonschema.Type {
+	switch fieldType {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return []*jsonschema.Type{
+			{Type: gojsonschema.TYPE_STRING, Enum: protojsonFloatStrings},
+			{Type: gojsonschema.TYPE_STRING, Pattern: protojsonNumberPattern},
+		}
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_UINT64:
+		return []*jsonschema.Type{{Type: gojsonschema.TYPE_STRING, Pattern: protojsonUnsignedPattern}}
+	case descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return []*jsonschema.Type{{Type: gojsonschema.TYPE_STRING, Pattern: protojsonIntegerPattern}}
+	}
+	return nil
+}
+
+// faithfulNumber returns the alternatives of a numeric type (its JSON number, with any constraints, followed by the
+// strings proto3's JSON mapping also accepts):
+func faithfulNumber(numberDef *jsonschema.Type, fieldType descriptor.FieldDescriptorProto_Type) []*jsonschema.Type {
+	return append([]*jsonschema.Type{numberDef}, protojsonEncodings(fieldType)...)
+}
+
+// faithfulWellKnownType represents a numeric wrapper (eg google.protobuf.DoubleValue) with the alternatives of the scalar
+// it wraps when faithful_protojson is set (and converts it as usual when it isn't):
+func faithfulWellKnownType(fieldType descriptor.FieldDescriptorProto_Type, jsonType string, convert func(*jsonschema.Type, ConverterFlags)) func(*jsonschema.Type, ConverterFlags) {
+	return func(jsonSchemaType *jsonschema.Type, messageFlags ConverterFlags) {
+		if !messageFlags.FaithfulProtoJSON {
+			convert(jsonSchemaType, messageFlags)
+			return
+		}
+		jsonSchemaType.OneOf = faithfulNumber(&jsonschema.Type{Type: jsonType}, fieldType)
+	}
+}
+
+// convertBytesWellKnownType represents google.protobuf.BytesValue as a string (of base64 when faithful_protojson is set):
+func convertBytesWellKnownType(jsonSchemaType *jsonschema.Type, messageFlags ConverterFlags) {
+	jsonSchemaType.Type = gojsonschema.TYPE_STRING
+	if messageFlags.FaithfulProtoJSON {
+		jsonSchemaType.Pattern = protojsonBase64Pattern
+	}
+}
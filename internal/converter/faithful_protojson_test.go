@@ -0,0 +1,64 @@
+package converter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestFaithfulProtoJSON(t *testing.T) {
+
+	fileDescriptorSet := mustReadProtoFiles(t, sampleProtoDirectory, "ProtoJSONScalars.proto")
+	files, err := protodesc.NewFiles(fileDescriptorSet)
+	require.NoError(t, err)
+	messageDesc, err := files.FindDescriptorByName("samples.ProtoJSONScalars")
+	require.NoError(t, err)
+
+	convert := func(parameters string) string {
+		response, err := convertTestRequest(fileRequest("ProtoJSONScalars.proto", parameters, fileDescriptorSet.GetFile()...))
+		require.NoError(t, err)
+		require.Len(t, response.File, 1)
+		return response.File[0].GetContent()
+	}
+
+	// Schemas accept exactly the values which protojson itself does (whether or not nulls are allowed too):
+	values := map[string][]string{
+		"double_value":          {`1.5`, `"1.5"`, `"-2e10"`, `"NaN"`, `"Infinity"`, `"-Infinity"`, `"nan"`, `"+1"`, `"01"`, `" 1"`, `true`},
+		"int32_value":           {`12`, `"12"`, `"-12"`, `"012"`, `"0x10"`, `"+12"`, `""`, `"1.5"`, `"-1.5e0"`},
+		"uint32_value":          {`7`, `"7"`, `"-7"`, `"7.5"`},
+		"int64_value":           {`9007199254740993`, `"9007199254740993"`, `"-1"`, `"007"`, `"2.5"`},
+		"fixed64_value":         {`18`, `"18"`, `"-18"`, `"1.85e1"`},
+		"bytes_value":           {`""`, `"AAEC"`, `"AAE="`, `"AAE"`, `"AA=="`, `"AA"`, `"+/8="`, `"-_8="`, `"-_8"`, `"+_8="`, `"AA="`, `"A"`, `"AAEC="`, `1`},
+		"repeated_sint32_value": {`[1, "-2"]`, `["02"]`},
+		"double_wrapper":        {`null`, `"Infinity"`, `"1.0"`, `"inf"`},
+		"uint64_wrapper":        {`null`, `12`, `"12"`, `"-12"`},
+		"bytes_wrapper":         {`null`, `"AAEC"`, `"-_8="`, `"+-"`},
+	}
+	for _, parameters := range []string{"faithful_protojson", "faithful_protojson,allow_null_values"} {
+		schema := convert(parameters)
+		for field, fieldValues := range values {
+			for _, value := range fieldValues {
+				data := fmt.Sprintf(`{%q: %s}`, field, value)
+				unmarshalErr := protojson.Unmarshal([]byte(data), dynamicpb.NewMessage(messageDesc.(protoreflect.MessageDescriptor)))
+				valid, err := validateSchema(schema, data)
+				require.NoError(t, err)
+				assert.Equal(t, unmarshalErr == nil, valid, "%s (%s)", data, parameters)
+			}
+		}
+	}
+
+	// Integers are only accepted as plain digits, although protojson tolerates integral fractions and exponents too:
+	schema := convert("faithful_protojson")
+	for _, data := range []string{`{"int32_value": "1.0"}`, `{"int64_value": "1e2"}`, `{"uint32_value": "7.0"}`, `{"fixed64_value": "1.8e1"}`} {
+		require.NoError(t, protojson.Unmarshal([]byte(data), dynamicpb.NewMessage(messageDesc.(protoreflect.MessageDescriptor))), data)
+		valid, err := validateSchema(schema, data)
+		require.NoError(t, err)
+		assert.False(t, valid, data)
+	}
+}
@@ -0,0 +1,79 @@
+package converter
+
+import (
+	"github.com/alecthomas/jsonschema"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+const (
+	docsPrecedenceOptions     = "options"     // Titles and descriptions from field options win (the default)
+	docsPrecedenceComments    = "comments"    // Titles and descriptions from comments win
+	docsPrecedenceConcatenate = "concatenate" // Both are kept, joined with the comment delimiter
+)
+
+// The keywords which the losing titles and descriptions are kept under:
+const (
+	commentTitleKeyword       = "x-comment-title"
+	commentDescriptionKeyword = "x-comment-description"
+	optionTitleKeyword        = "x-option-title"
+	optionDescriptionKeyword  = "x-option-description"
+)
+
+// fieldDocKeywords are the extra keywords which document a field (rather than constraining its values):
+var fieldDocKeywords = map[string]bool{
+	commentTitleKeyword:          true,
+	commentDescriptionKeyword:    true,
+	localizedDescriptionsKeyword: true,
+	optionTitleKeyword:           true,
+	optionDescriptionKeyword:     true,
+	protoCommentKeyword:          true,
+}
+
+// setFieldDocs gives a field the title and description from its comments and from its title / description options. When
+// both provide one, "docs_precedence" decides which wins (or whether they're concatenated), and the loser is kept under
+// an "x-" keyword so that documentation pipelines still have both:
+func (c *Converter) setFieldDocs(desc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type, commentTitle, commentDescription string) {
+	fieldOptions, _ := proto.GetExtension(desc.GetOptions(), protoc_gen_jsonschema.E_FieldOptions).(*protoc_gen_jsonschema.FieldOptions)
+	optionTitle, optionDescription := fieldOptions.GetTitle(), fieldOptions.GetDescription()
+
+	// Titles:
+	title, lostTitle, lostTitleKeyword := c.pickFieldDoc(commentTitle, optionTitle, commentTitleKeyword, optionTitleKeyword)
+	jsonSchemaType.Title = title
+	if lostTitle != "" {
+		setExtras(jsonSchemaType, map[string]interface{}{lostTitleKeyword: lostTitle})
+	}
+
+	// Descriptions (those from comments are subject to the usual comment handling):
+	description, lostDescription, lostDescriptionKeyword := c.pickFieldDoc(commentDescription, optionDescription, commentDescriptionKeyword, optionDescriptionKeyword)
+	if description == optionDescription && description != commentDescription {
+		jsonSchemaType.Description = description
+	} else {
+		c.setDescription(jsonSchemaType, description)
+	}
+	if lostDescription != "" {
+		setExtras(jsonSchemaType, map[string]interface{}{lostDescriptionKeyword: lostDescription})
+	}
+}
+
+// pickFieldDoc returns the winner of a comment and an option (according to our precedence), along with the loser and the
+// keyword to keep it under (if there was a conflict):
+func (c *Converter) pickFieldDoc(fromComments, fromOptions, commentKeyword, optionKeyword string) (string, string, string) {
+	if fromOptions == "" || fromOptions == fromComments {
+		return fromComments, "", ""
+	}
+	if fromComments == "" {
+		return fromOptions, "", ""
+	}
+
+	switch c.docsPrecedence {
+	case docsPrecedenceComments:
+		return fromComments, fromOptions, optionKeyword
+	case docsPrecedenceConcatenate:
+		return fromComments + c.commentDelimiter + fromOptions, "", ""
+	default:
+		return fromOptions, fromComments, commentKeyword
+	}
+}
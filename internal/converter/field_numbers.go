@@ -0,0 +1,45 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// fieldNumberRange is an inclusive range of field numbers (eg 9000-9999):
+type fieldNumberRange struct {
+	start int32
+	end   int32
+}
+
+// parseFieldNumberRange parses a range of field numbers (eg "9000-9999"), or a single field number (eg "42"):
+func parseFieldNumberRange(value string) (fieldNumberRange, error) {
+	start, end := value, value
+	if dash := strings.Index(value, "-"); dash >= 0 {
+		start, end = value[:dash], value[dash+1:]
+	}
+
+	startNumber, err := strconv.ParseInt(strings.TrimSpace(start), 10, 32)
+	if err != nil {
+		return fieldNumberRange{}, fmt.Errorf("invalid field number (%s): %v", start, err)
+	}
+	endNumber, err := strconv.ParseInt(strings.TrimSpace(end), 10, 32)
+	if err != nil {
+		return fieldNumberRange{}, fmt.Errorf("invalid field number (%s): %v", end, err)
+	}
+	if startNumber < 1 || endNumber < startNumber {
+		return fieldNumberRange{}, fmt.Errorf("invalid field number range: %s", value)
+	}
+
+	return fieldNumberRange{start: int32(startNumber), end: int32(endNumber)}, nil
+}
+
+// excludedFieldNumber tells us whether a field number falls within any of the ranges we've been told to leave out:
+func (c *Converter) excludedFieldNumber(number int32) bool {
+	for _, excludedRange := range c.excludedFieldNumbers {
+		if number >= excludedRange.start && number <= excludedRange.end {
+			return true
+		}
+	}
+	return false
+}
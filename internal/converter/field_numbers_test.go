@@ -0,0 +1,22 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFieldNumberRange(t *testing.T) {
+	excludedRange, err := parseFieldNumberRange("9000-9999")
+	assert.NoError(t, err)
+	assert.Equal(t, fieldNumberRange{start: 9000, end: 9999}, excludedRange)
+
+	excludedRange, err = parseFieldNumberRange("42")
+	assert.NoError(t, err)
+	assert.Equal(t, fieldNumberRange{start: 42, end: 42}, excludedRange)
+
+	for _, value := range []string{"", "experiments", "9999-9000", "0-10", "-5", "10-"} {
+		_, err := parseFieldNumberRange(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,44 @@
+package converter
+
+import (
+	"path"
+	"strings"
+)
+
+// Characters which Windows doesn't allow in file names (along with control characters):
+const windowsInvalidCharacters = `<>:"|?*`
+
+// Device names which Windows reserves (regardless of extension):
+var windowsReservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true, "COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true, "LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// sanitizeFilePath makes a generated file path safe to write on any platform: protoc expects forward slashes
+// (and relative paths), and Windows rejects some characters, trailing dots/spaces and reserved device names:
+func sanitizeFilePath(filePath string) string {
+	filePath = path.Clean(strings.ReplaceAll(filePath, `\`, "/"))
+
+	var segments []string
+	for _, segment := range strings.Split(filePath, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			continue // Output has to stay inside the output directory
+		}
+		segment = strings.Map(func(r rune) rune {
+			if r < 32 || strings.ContainsRune(windowsInvalidCharacters, r) {
+				return '_'
+			}
+			return r
+		}, segment)
+		segment = strings.TrimRight(segment, ". ")
+		if baseName := strings.SplitN(segment, ".", 2)[0]; windowsReservedNames[strings.ToUpper(baseName)] {
+			segment = "_" + segment
+		}
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,25 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeFilePath(t *testing.T) {
+	for filePath, expected := range map[string]string{
+		"acme.orders/Order.json":           "acme.orders/Order.json",
+		`public\schemas\Order.json`:        "public/schemas/Order.json",
+		"/absolute/./Order.json":           "absolute/Order.json",
+		"../escaped/Order.json":            "escaped/Order.json",
+		"schemas//v1/Order.json":           "schemas/v1/Order.json",
+		`acme:api/Order<v1>?.json`:         "acme_api/Order_v1__.json",
+		"trailing. /Order.json":            "trailing/Order.json",
+		"con/Aux.json":                     "_con/_Aux.json",
+		"console/Auxiliary.json":           "console/Auxiliary.json",
+		"tab\there/Order.json":             "tab_here/Order.json",
+		"acme.orders/Order.schema.json...": "acme.orders/Order.schema.json",
+	} {
+		assert.Equal(t, expected, sanitizeFilePath(filePath), filePath)
+	}
+}
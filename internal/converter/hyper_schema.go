@@ -0,0 +1,187 @@
+package converter
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	"google.golang.org/protobuf/encoding/protowire"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	hyperSchemaDraft04 = "http://json-schema.org/draft-04/hyper-schema#"
+	hyperSchemaSuffix  = ".hyper"
+
+	// The (google.api.http) option's extension number (see google/api/annotations.proto):
+	httpExtensionNumber protowire.Number = 72295728
+
+	// The fields of google.api.HttpRule (see google/api/http.proto):
+	httpRuleGetFieldNumber                protowire.Number = 2
+	httpRulePutFieldNumber                protowire.Number = 3
+	httpRulePostFieldNumber               protowire.Number = 4
+	httpRuleDeleteFieldNumber             protowire.Number = 5
+	httpRulePatchFieldNumber              protowire.Number = 6
+	httpRuleBodyFieldNumber               protowire.Number = 7
+	httpRuleCustomFieldNumber             protowire.Number = 8
+	httpRuleAdditionalBindingsFieldNumber protowire.Number = 11
+	httpRuleResponseBodyFieldNumber       protowire.Number = 12
+
+	// The fields of google.api.CustomHttpPattern:
+	customHTTPPatternKindFieldNumber protowire.Number = 1
+	customHTTPPatternPathFieldNumber protowire.Number = 2
+)
+
+// httpRuleMethods maps the HttpRule fields which hold paths to their HTTP methods:
+var httpRuleMethods = map[protowire.Number]string{
+	httpRuleGetFieldNumber:    "GET",
+	httpRulePutFieldNumber:    "PUT",
+	httpRulePostFieldNumber:   "POST",
+	httpRuleDeleteFieldNumber: "DELETE",
+	httpRulePatchFieldNumber:  "PATCH",
+}
+
+// pathVariablePattern matches the variables of HttpRule paths (eg "{name=orders/*}" or "{order.id}"):
+var pathVariablePattern = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+// httpBinding is one HTTP binding of a method (from its google.api.http annotation):
+type httpBinding struct {
+	body         string
+	method       string
+	path         string
+	responseBody string
+}
+
+// convertHyperSchema generates a (draft-04) hyper-schema for the services in a file, with a link for every HTTP binding of
+// their methods (or nothing if none of them have any):
+func (c *Converter) convertHyperSchema(pkg *ProtoPackage, file *descriptor.FileDescriptorProto, fileExtension string) (*plugin.CodeGeneratorResponse_File, error) {
+	definitions := jsonschema.Definitions{}
+	var links []*orderedmap.OrderedMap
+	for _, service := range file.GetService() {
+		for _, method := range service.GetMethod() {
+			bindings := parseHTTPRule(method.GetOptions())
+			if len(bindings) == 0 {
+				continue
+			}
+
+			inputRef, inputDesc, err := c.addServiceMessage(pkg, method.GetInputType(), definitions)
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert the input of %s.%s: %v", service.GetName(), method.GetName(), err)
+			}
+			outputRef, outputDesc, err := c.addServiceMessage(pkg, method.GetOutputType(), definitions)
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert the output of %s.%s: %v", service.GetName(), method.GetName(), err)
+			}
+
+			_, description := c.commentSummary(c.sourceInfo.GetMethod(method))
+			for _, binding := range bindings {
+				link := orderedmap.New()
+				link.Set("rel", method.GetName())
+				link.Set("title", fmt.Sprintf("%s.%s", service.GetName(), method.GetName()))
+				if description != "" {
+					link.Set("description", description)
+				}
+				link.Set("href", hrefTemplate(binding.path))
+				link.Set("method", binding.method)
+				link.Set("schema", &jsonschema.Type{Ref: c.bodyRef(inputRef, inputDesc, binding.body)})
+				link.Set("targetSchema", &jsonschema.Type{Ref: c.bodyRef(outputRef, outputDesc, binding.responseBody)})
+				links = append(links, link)
+			}
+		}
+	}
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	hyperSchemaFileName := c.generateSchemaFilename(file, fileExtension, strings.TrimSuffix(path.Base(file.GetName()), ".proto")+hyperSchemaSuffix)
+	c.logger.WithField("proto_filename", file.GetName()).WithField("links", len(links)).WithField("jsonschema_filename", hyperSchemaFileName).Info("Generating hyper-schema for SERVICES")
+
+	hyperSchema := &jsonschema.Schema{
+		Type: &jsonschema.Type{
+			Version: hyperSchemaDraft04,
+			Title:   file.GetPackage(),
+			Extras:  map[string]interface{}{"links": links},
+		},
+		Definitions: definitions,
+	}
+	return c.generateResponseFile(hyperSchemaFileName, hyperSchema)
+}
+
+// bodyRef refers to a message, or to one of its fields if the binding only uses that field as the body (eg `body: "order"`):
+func (c *Converter) bodyRef(messageRef string, msgDesc *descriptor.DescriptorProto, body string) string {
+	if body == "" || body == "*" {
+		return messageRef
+	}
+	for _, fieldDesc := range msgDesc.GetField() {
+		if fieldDesc.GetName() == body {
+			return fmt.Sprintf("%s/properties/%s", messageRef, c.fieldNames(fieldDesc)[0])
+		}
+	}
+	return messageRef
+}
+
+// hrefTemplate turns an HttpRule path into a URI template: "{name=orders/*}" matches several path segments
+// (so it becomes a reserved expansion, "{+name}"), while "{id}" stays as it is:
+func hrefTemplate(rulePath string) string {
+	return pathVariablePattern.ReplaceAllStringFunc(rulePath, func(variable string) string {
+		matches := pathVariablePattern.FindStringSubmatch(variable)
+		if matches[2] != "" && matches[2] != "=*" {
+			return "{+" + matches[1] + "}"
+		}
+		return "{" + matches[1] + "}"
+	})
+}
+
+// parseHTTPRule reads the HTTP bindings of a method (including any additional bindings) from its google.api.http annotation,
+// which we don't have the types of (so they are read straight from the unknown fields of its options):
+func parseHTTPRule(options *descriptor.MethodOptions) []httpBinding {
+	if options == nil {
+		return nil
+	}
+
+	var bindings []httpBinding
+	rangeBytesFields(options.ProtoReflect().GetUnknown(), func(number protowire.Number, value []byte) {
+		if number == httpExtensionNumber {
+			bindings = append(bindings, decodeHTTPRule(value)...)
+		}
+	})
+	return bindings
+}
+
+// decodeHTTPRule decodes an (encoded) HttpRule into its bindings:
+func decodeHTTPRule(encoded []byte) []httpBinding {
+	var binding httpBinding
+	var additionalBindings []httpBinding
+	rangeBytesFields(encoded, func(number protowire.Number, value []byte) {
+		if method, ok := httpRuleMethods[number]; ok {
+			binding.method, binding.path = method, string(value)
+			return
+		}
+		switch number {
+		case httpRuleBodyFieldNumber:
+			binding.body = string(value)
+		case httpRuleResponseBodyFieldNumber:
+			binding.responseBody = string(value)
+		case httpRuleCustomFieldNumber:
+			rangeBytesFields(value, func(number protowire.Number, value []byte) {
+				switch number {
+				case customHTTPPatternKindFieldNumber:
+					binding.method = string(value)
+				case customHTTPPatternPathFieldNumber:
+					binding.path = string(value)
+				}
+			})
+		case httpRuleAdditionalBindingsFieldNumber:
+			additionalBindings = append(additionalBindings, decodeHTTPRule(value)...)
+		}
+	})
+
+	if binding.path == "" {
+		return additionalBindings
+	}
+	return append([]httpBinding{binding}, additionalBindings...)
+}
@@ -0,0 +1,13 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHrefTemplate(t *testing.T) {
+	assert.Equal(t, "/v1/orders/{order_id}", hrefTemplate("/v1/orders/{order_id}"))
+	assert.Equal(t, "/v1/orders/{order.id}", hrefTemplate("/v1/orders/{order.id=*}"))
+	assert.Equal(t, "/v1/{+name}:cancel", hrefTemplate("/v1/{name=customers/*/orders/*}:cancel"))
+}
@@ -0,0 +1,57 @@
+package converter
+
+import (
+	"regexp"
+)
+
+// identifierPattern matches names which can be used as plain identifiers by (most) schema consumers:
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// reservedWords are JavaScript's reserved words (which make for awkward property names in generated code):
+var reservedWords = map[string]bool{
+	"await": true, "break": true, "case": true, "catch": true, "class": true, "const": true, "continue": true,
+	"debugger": true, "default": true, "delete": true, "do": true, "else": true, "enum": true, "export": true,
+	"extends": true, "false": true, "finally": true, "for": true, "function": true, "if": true, "implements": true,
+	"import": true, "in": true, "instanceof": true, "interface": true, "let": true, "new": true, "null": true,
+	"package": true, "private": true, "protected": true, "public": true, "return": true, "static": true,
+	"super": true, "switch": true, "this": true, "throw": true, "true": true, "try": true, "typeof": true,
+	"var": true, "void": true, "while": true, "with": true, "yield": true,
+}
+
+// identifierProblem describes why a name would be awkward for downstream schema consumers (or returns "" if it's fine):
+func identifierProblem(name string) string {
+	switch {
+	case reservedWords[name]:
+		return "reserved word"
+	case name != "" && name[0] >= '0' && name[0] <= '9':
+		return "starts with a digit"
+	case !identifierPattern.MatchString(name):
+		return "contains characters which aren't valid in identifiers"
+	default:
+		return ""
+	}
+}
+
+// checkIdentifier reports names which would be awkward for downstream schema consumers (once each):
+func (c *Converter) checkIdentifier(kind, name string) {
+	if !c.Flags.CheckIdentifiers || c.reportedIdentifiers[kind+":"+name] {
+		return
+	}
+	if problem := identifierProblem(name); problem != "" {
+		if c.reportedIdentifiers == nil {
+			c.reportedIdentifiers = make(map[string]bool)
+		}
+		c.reportedIdentifiers[kind+":"+name] = true
+		c.logger.WithField(kind, name).WithField("problem", problem).Warn("Awkward identifier for schema consumers (consider renaming it in the config file)")
+	}
+}
+
+// renamedProperty applies any property renames from the config file:
+func (c *Converter) renamedProperty(name string) string {
+	if c.config != nil {
+		if renamed, ok := c.config.Renames[name]; ok {
+			return renamed
+		}
+	}
+	return name
+}
@@ -0,0 +1,71 @@
+package converter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestIdentifierProblem(t *testing.T) {
+	assert.Equal(t, "", identifierProblem("name"))
+	assert.Equal(t, "", identifierProblem("$ref_count"))
+	assert.Equal(t, "reserved word", identifierProblem("class"))
+	assert.Equal(t, "starts with a digit", identifierProblem("3dModel"))
+	assert.Equal(t, "contains characters which aren't valid in identifiers", identifierProblem("first-name"))
+}
+
+func TestIdentifierRenames(t *testing.T) {
+
+	// Make a Logrus logger (which records what it logs):
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(logrus.WarnLevel)
+
+	// Write a config file with some renames:
+	directory, err := ioutil.TempDir("", "protoc-gen-jsonschema")
+	require.NoError(t, err)
+	defer os.RemoveAll(directory)
+	configFileName := filepath.Join(directory, "config.json")
+	require.NoError(t, ioutil.WriteFile(configFileName, []byte(`{"renames": {"class": "class_name"}}`), 0644))
+
+	fileDesc := testProtoFile("acme/student.proto", "acme", testMessage("Student", testStringField("class", 1), testStringField("default", 2)))
+	response, err := New(logger).convert(testRequest("check_identifiers,config_file="+configFileName, fileDesc))
+	require.NoError(t, err)
+	require.Len(t, response.File, 1)
+
+	// Renamed properties are used instead of the originals:
+	assert.Contains(t, response.File[0].GetContent(), `"class_name"`)
+	assert.NotContains(t, response.File[0].GetContent(), `"class"`)
+
+	// Whatever is left gets reported:
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, "default", hook.LastEntry().Data["property_name"])
+}
+
+func TestPropertyNameCollisions(t *testing.T) {
+
+	// Two fields which only collide once we use JSON names:
+	givenNameField := testStringField("given_name", 1)
+	givenNameField.JsonName = proto.String("name")
+	fileDesc := testProtoFile("acme/customer.proto", "acme", testMessage("Customer", givenNameField, testStringField("name", 2)))
+	convert := func(parameter string) error {
+		_, err := convertTestRequest(testRequest(parameter, fileDesc))
+		return err
+	}
+
+	// Proto field names are distinct:
+	assert.NoError(t, convert(""))
+
+	// JSON names collide (and so do the proto name of one with the JSON name of the other):
+	for _, parameter := range []string{"json_fieldnames", "proto_and_json_fieldnames"} {
+		err := convert(parameter)
+		require.Error(t, err, parameter)
+		assert.Contains(t, err.Error(), `fields given_name and name of message Customer both map to the JSON property "name"`, parameter)
+	}
+}
@@ -0,0 +1,41 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+)
+
+const inferredKeyword = "x-inferred"
+
+// inferredFormats are the string formats implied by conventional field names (either the whole name, or its last "_" segment):
+var inferredFormats = map[string][]string{
+	"email": {"email"},
+	"ip":    {"ipv4", "ipv6"},
+	"url":   {"uri"},
+	"uuid":  {"uuid"},
+}
+
+// inferStringFormat gives a string field a format based on its name (eg "contact_email"), marking it as inferred.
+// Fields which already have a pattern or format are left alone (annotations know better than naming conventions):
+func inferStringFormat(fieldName string, stringDef *jsonschema.Type) {
+	if stringDef.Pattern != "" || stringDef.Format != "" {
+		return
+	}
+
+	segments := strings.Split(strings.ToLower(fieldName), "_")
+	formats, ok := inferredFormats[segments[len(segments)-1]]
+	if !ok {
+		return
+	}
+
+	// Some conventions allow more than one format (eg IP addresses can be v4 or v6):
+	if len(formats) == 1 {
+		stringDef.Format = formats[0]
+	} else {
+		for _, format := range formats {
+			stringDef.AnyOf = append(stringDef.AnyOf, &jsonschema.Type{Format: format})
+		}
+	}
+	setExtras(stringDef, map[string]interface{}{inferredKeyword: true})
+}
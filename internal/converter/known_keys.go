@@ -0,0 +1,76 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+// knownKeysOptions returns the field options of a field which declares known_keys (or known_keys_only):
+func knownKeysOptions(fieldDesc *descriptor.FieldDescriptorProto) (*protoc_gen_jsonschema.FieldOptions, bool) {
+	fieldOptions, ok := proto.GetExtension(fieldDesc.GetOptions(), protoc_gen_jsonschema.E_FieldOptions).(*protoc_gen_jsonschema.FieldOptions)
+	if !ok || (len(fieldOptions.GetKnownKeys()) == 0 && !fieldOptions.GetKnownKeysOnly()) {
+		return nil, false
+	}
+	return fieldOptions, true
+}
+
+// checkKnownKeys makes sure known_keys are only given to map fields:
+func (c *Converter) checkKnownKeys(curPkg *ProtoPackage, fieldDesc *descriptor.FieldDescriptorProto) error {
+	if _, ok := knownKeysOptions(fieldDesc); !ok {
+		return nil
+	}
+	if recordType, _, ok := c.lookupType(curPkg, fieldDesc.GetTypeName()); ok && recordType.GetOptions().GetMapEntry() {
+		return nil
+	}
+	return fmt.Errorf("known_keys only apply to map fields (and %s is not one)", fieldDesc.GetName())
+}
+
+// applyKnownKeys describes the keys a map field expects (eg a loosely-typed bag of options) as properties, each with
+// the schema from its known_keys snippet (or the map's value schema). Other keys still get the value schema, unless the
+// field is known_keys_only:
+func (c *Converter) applyKnownKeys(fieldDesc *descriptor.FieldDescriptorProto, jsonSchemaType, valueJSONSchemaType *jsonschema.Type) error {
+	fieldOptions, ok := knownKeysOptions(fieldDesc)
+	if !ok {
+		return nil
+	}
+	if len(fieldOptions.GetKnownKeys()) == 0 {
+		return fmt.Errorf("known_keys_only for field %s needs some known_keys", fieldDesc.GetName())
+	}
+
+	properties := orderedmap.New()
+	for _, knownKey := range fieldOptions.GetKnownKeys() {
+
+		// Keys either come alone (eg "colour"), or with a snippet (eg `colour={"enum": ["red", "blue"]}`):
+		key, snippet := knownKey, ""
+		if equals := strings.Index(knownKey, "="); equals >= 0 {
+			key, snippet = knownKey[:equals], knownKey[equals+1:]
+		}
+		if key == "" {
+			return fmt.Errorf("invalid known_keys for field %s: %s has no key", fieldDesc.GetName(), knownKey)
+		}
+
+		if snippet == "" {
+			properties.Set(key, valueJSONSchemaType)
+			continue
+		}
+		keywords, err := c.parseSchemaSnippet("known_keys", fieldDesc, snippet)
+		if err != nil {
+			return err
+		}
+		properties.Set(key, &jsonschema.Type{Extras: keywords})
+	}
+	jsonSchemaType.Properties = properties
+
+	if fieldOptions.GetKnownKeysOnly() {
+		jsonSchemaType.PatternProperties = nil
+		setAdditionalProperties(jsonSchemaType, false)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+func TestKnownKeysMisuse(t *testing.T) {
+
+	withKnownKeys := func(knownKeysOptions *protoc_gen_jsonschema.FieldOptions) *descriptor.FieldDescriptorProto {
+		fieldOptions := &descriptor.FieldOptions{}
+		proto.SetExtension(fieldOptions, protoc_gen_jsonschema.E_FieldOptions, knownKeysOptions)
+		return &descriptor.FieldDescriptorProto{Name: proto.String("options"), Type: descriptor.FieldDescriptorProto_TYPE_STRING.Enum(), Options: fieldOptions}
+	}
+	protoConverter := New(newTestLogger())
+
+	// Known keys only describe maps:
+	err := protoConverter.checkKnownKeys(protoConverter.rootPkg, withKnownKeys(&protoc_gen_jsonschema.FieldOptions{KnownKeys: []string{"colour"}}))
+	assert.Contains(t, err.Error(), "known_keys only apply to map fields")
+
+	// Keys can't be empty, and their snippets have to be valid:
+	for _, knownKey := range []string{"", `={"type": "string"}`, `colour={"type": "text"}`} {
+		err := protoConverter.applyKnownKeys(withKnownKeys(&protoc_gen_jsonschema.FieldOptions{KnownKeys: []string{knownKey}}), &jsonschema.Type{}, &jsonschema.Type{})
+		assert.Error(t, err, knownKey)
+	}
+
+	// Only accepting known keys needs some known keys:
+	err = protoConverter.applyKnownKeys(withKnownKeys(&protoc_gen_jsonschema.FieldOptions{KnownKeysOnly: true}), &jsonschema.Type{}, &jsonschema.Type{})
+	assert.Contains(t, err.Error(), "known_keys_only for field options needs some known_keys")
+}
@@ -0,0 +1,118 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// lintWarning describes a problem found in a generated schema:
+type lintWarning struct {
+	path    string
+	message string
+}
+
+// lintSchema checks a generated schema for common quality problems, logging a warning for each one.
+// In strict mode any warnings cause an error to be returned:
+func (c *Converter) lintSchema(jsonSchemaFileName string, jsonSchemaJSON []byte) error {
+	var schema map[string]interface{}
+	if err := json.Unmarshal(jsonSchemaJSON, &schema); err != nil {
+		return err
+	}
+
+	// Gather the definitions (so we can check references against them):
+	definitions, _ := schema["definitions"].(map[string]interface{})
+
+	var warnings []lintWarning
+	lintSchemaNode(schema, "#", definitions, &warnings)
+
+	for _, warning := range warnings {
+		c.logger.WithField("jsonschema_filename", jsonSchemaFileName).WithField("path", warning.path).Warn(warning.message)
+	}
+
+	if c.Flags.LintStrict && len(warnings) > 0 {
+		var messages []string
+		for _, warning := range warnings {
+			messages = append(messages, fmt.Sprintf("%s: %s", warning.path, warning.message))
+		}
+		return fmt.Errorf("%d lint warning(s): %s", len(warnings), strings.Join(messages, "; "))
+	}
+
+	return nil
+}
+
+// lintSchemaNode recursively lints a (decoded) schema node:
+func lintSchemaNode(node map[string]interface{}, path string, definitions map[string]interface{}, warnings *[]lintWarning) {
+
+	// References should resolve to one of our definitions (we can't check references into other documents, eg a shared schema):
+	if ref, ok := node["$ref"].(string); ok && strings.HasPrefix(ref, "#") {
+		if !strings.HasPrefix(ref, defaultRefPrefix) || definitions[strings.TrimPrefix(ref, defaultRefPrefix)] == nil {
+			*warnings = append(*warnings, lintWarning{path, fmt.Sprintf("Reference %s does not resolve to a definition", ref)})
+		}
+	}
+
+	// Enums with only one value are probably a mistake:
+	if enum, ok := node["enum"].([]interface{}); ok {
+		var strs, others int
+		for _, value := range enum {
+			if _, isString := value.(string); isString {
+				strs++
+			} else {
+				others++
+			}
+		}
+		if strs <= 1 && others <= 1 {
+			*warnings = append(*warnings, lintWarning{path, "Enum only has one value"})
+		}
+	}
+
+	// Objects with no properties which accept anything don't validate much:
+	if node["type"] == "object" && node["properties"] == nil && node["additionalProperties"] == true {
+		*warnings = append(*warnings, lintWarning{path, "Object has no properties and allows additional properties"})
+	}
+
+	// Properties should be described:
+	if properties, ok := node["properties"].(map[string]interface{}); ok {
+		for _, name := range sortedKeys(properties) {
+			property, ok := properties[name].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if _, isRef := property["$ref"]; !isRef && property["description"] == nil && property[protoCommentKeyword] == nil {
+				*warnings = append(*warnings, lintWarning{path + "/properties/" + name, "Property has no description"})
+			}
+		}
+	}
+
+	// Recurse into anything which could contain more schemas:
+	for _, key := range sortedKeys(node) {
+		switch value := node[key].(type) {
+		case map[string]interface{}:
+			if key == "properties" || key == "definitions" || key == "patternProperties" {
+				for _, name := range sortedKeys(value) {
+					if child, ok := value[name].(map[string]interface{}); ok {
+						lintSchemaNode(child, path+"/"+key+"/"+name, definitions, warnings)
+					}
+				}
+				continue
+			}
+			lintSchemaNode(value, path+"/"+key, definitions, warnings)
+		case []interface{}:
+			for i, item := range value {
+				if child, ok := item.(map[string]interface{}); ok {
+					lintSchemaNode(child, fmt.Sprintf("%s/%s/%d", path, key, i), definitions, warnings)
+				}
+			}
+		}
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
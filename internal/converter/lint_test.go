@@ -0,0 +1,60 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testLintSchema = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/LintMessage",
+    "definitions": {
+        "LintMessage": {
+            "properties": {
+                "described": {
+                    "type": "string",
+                    "description": "This one is fine"
+                },
+                "undescribed": {
+                    "type": "string"
+                },
+                "lonely_enum": {
+                    "enum": ["ONLY", 0],
+                    "description": "An enum with just one value"
+                },
+                "anything": {
+                    "type": "object",
+                    "additionalProperties": true,
+                    "description": "Anything goes"
+                },
+                "dangling": {
+                    "$ref": "#/definitions/Missing"
+                },
+                "shared": {
+                    "$ref": "common.json#/definitions/acme.RequestHeader"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    }
+}`
+
+func TestLintSchema(t *testing.T) {
+
+	// Normal linting only warns:
+	protoConverter := New(newTestLogger())
+	protoConverter.Flags.Lint = true
+	assert.NoError(t, protoConverter.lintSchema("LintMessage.json", []byte(testLintSchema)))
+
+	// Strict linting fails, and reports every problem:
+	protoConverter.Flags.LintStrict = true
+	err := protoConverter.lintSchema("LintMessage.json", []byte(testLintSchema))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "4 lint warning(s)")
+	assert.Contains(t, err.Error(), "#/definitions/LintMessage/properties/undescribed: Property has no description")
+	assert.Contains(t, err.Error(), "#/definitions/LintMessage/properties/lonely_enum: Enum only has one value")
+	assert.Contains(t, err.Error(), "#/definitions/LintMessage/properties/anything: Object has no properties and allows additional properties")
+	assert.Contains(t, err.Error(), "#/definitions/LintMessage/properties/dangling: Reference #/definitions/Missing does not resolve to a definition")
+}
@@ -0,0 +1,55 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	"github.com/xeipuuv/gojsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	listSchemaSuffix         = "List"
+	listStylePaginated       = "paginated"
+	listItemsPropertyName    = "items"
+	nextPageTokenFieldName   = "next_page_token"
+	nextPageTokenJSONName    = "nextPageToken"
+	nextPageTokenDescription = "A token which can be sent to retrieve the next page (empty if there are no more pages)."
+)
+
+// listSchema builds a "<Message>List" schema (either a plain array of the message, or a paginated wrapper with "items" and "next_page_token"):
+func (c *Converter) listSchema(msgDesc *descriptor.DescriptorProto, messageJSONSchema *jsonschema.Schema) *jsonschema.Schema {
+	itemsJSONSchemaType := &jsonschema.Type{
+		Type:  gojsonschema.TYPE_ARRAY,
+		Items: &jsonschema.Type{Ref: fmt.Sprintf("%s%s", c.refPrefix, msgDesc.GetName())},
+	}
+
+	listJSONSchemaType := itemsJSONSchemaType
+	if c.listStyle == listStylePaginated {
+		nextPageTokenName := nextPageTokenFieldName
+		if c.Flags.UseJSONFieldnamesOnly {
+			nextPageTokenName = nextPageTokenJSONName
+		}
+
+		listJSONSchemaType = &jsonschema.Type{
+			Type:       gojsonschema.TYPE_OBJECT,
+			Properties: orderedmap.New(),
+		}
+		setAdditionalProperties(listJSONSchemaType, !c.Flags.DisallowAdditionalProperties)
+		listJSONSchemaType.Properties.Set(listItemsPropertyName, itemsJSONSchemaType)
+		listJSONSchemaType.Properties.Set(nextPageTokenName, &jsonschema.Type{
+			Type:        gojsonschema.TYPE_STRING,
+			Description: nextPageTokenDescription,
+		})
+	}
+
+	listJSONSchemaType.Title = fmt.Sprintf("%s%s", msgDesc.GetName(), listSchemaSuffix)
+	listJSONSchemaType.Version = c.schemaVersion
+
+	// The definitions get stamped (eg with package versions) along with the schema, so each one needs its own copies:
+	return &jsonschema.Schema{
+		Type:        listJSONSchemaType,
+		Definitions: copyTypeMap(messageJSONSchema.Definitions),
+	}
+}
@@ -0,0 +1,45 @@
+package converter
+
+import (
+	"regexp"
+	"strings"
+)
+
+const localizedDescriptionsKeyword = "x-descriptions"
+
+// languageTagPattern finds the language tags which start each translation within a comment (eg "@en:", "@de:", "@pt-BR:"):
+var languageTagPattern = regexp.MustCompile(`(?:^|\s)@([a-z]{2,3}(?:-[A-Za-z]{2,4})?):`)
+
+// splitLanguages splits a description into its untagged text, and the text following each language tag:
+//
+//	Order placed by a customer
+//	@en: Order placed by a customer
+//	@de: Von einem Kunden aufgegebene Bestellung
+func splitLanguages(description string) (string, map[string]string) {
+	tags := languageTagPattern.FindAllStringSubmatchIndex(description, -1)
+	if len(tags) == 0 {
+		return description, nil
+	}
+
+	byLanguage := make(map[string]string, len(tags))
+	for index, tag := range tags {
+		end := len(description)
+		if index+1 < len(tags) {
+			end = tags[index+1][0]
+		}
+		byLanguage[description[tag[2]:tag[3]]] = strings.TrimSpace(description[tag[1]:end])
+	}
+	return strings.TrimSpace(description[:tags[0][0]]), byLanguage
+}
+
+// localizeDescription picks the translation for the configured locale (falling back to the untagged text):
+func (c *Converter) localizeDescription(description string) string {
+	if c.locale == "" {
+		return description
+	}
+	untagged, byLanguage := splitLanguages(description)
+	if translation, ok := byLanguage[c.locale]; ok {
+		return translation
+	}
+	return untagged
+}
@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitLanguages(t *testing.T) {
+	untagged, byLanguage := splitLanguages("An order @en: An order placed by a customer @de: Eine Bestellung @pt-BR: Um pedido")
+	assert.Equal(t, "An order", untagged)
+	assert.Equal(t, map[string]string{"en": "An order placed by a customer", "de": "Eine Bestellung", "pt-BR": "Um pedido"}, byLanguage)
+
+	// Descriptions without tags (including ones which just mention email addresses) are left alone:
+	untagged, byLanguage = splitLanguages("Contact orders@de: sorry")
+	assert.Equal(t, "Contact orders@de: sorry", untagged)
+	assert.Empty(t, byLanguage)
+}
@@ -0,0 +1,28 @@
+package converter
+
+import "os"
+
+// openLogFile sends all logging to a file instead (appending to it, so parallel builds don't clobber each other's logs):
+func (c *Converter) openLogFile(fileName string) error {
+	c.closeLogFile() // Only the last log file counts
+
+	logFile, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	c.logFile = logFile
+	c.logOutput = c.logger.Out
+	c.logger.SetOutput(logFile)
+	return nil
+}
+
+// closeLogFile puts logging back to wherever it was going before:
+func (c *Converter) closeLogFile() {
+	if c.logFile == nil {
+		return
+	}
+	c.logger.SetOutput(c.logOutput)
+	c.logFile.Close()
+	c.logFile = nil
+}
@@ -0,0 +1,74 @@
+package converter
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestLogFile(t *testing.T) {
+
+	// Make a Logrus logger (which we can check the output of):
+	stderr := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.SetLevel(logrus.InfoLevel)
+	logger.SetOutput(stderr)
+
+	tempDir, err := ioutil.TempDir("", "protoc-gen-jsonschema")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	logFileName := filepath.Join(tempDir, "protoc-gen-jsonschema.log")
+
+	_, err = New(logger).convert(&plugin.CodeGeneratorRequest{
+		FileToGenerate: []string{"acme/order.proto"},
+		Parameter:      proto.String("debug,log_file=" + logFileName),
+		ProtoFile: []*descriptor.FileDescriptorProto{
+			{
+				Name:        proto.String("acme/order.proto"),
+				Package:     proto.String("acme"),
+				MessageType: []*descriptor.DescriptorProto{{Name: proto.String("Order")}},
+				Syntax:      proto.String("proto3"),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Everything went to the log file (including debug output):
+	logContent, err := ioutil.ReadFile(logFileName)
+	require.NoError(t, err)
+	assert.Contains(t, string(logContent), "Generating JSON-schema for MESSAGE")
+	assert.Contains(t, string(logContent), "level=debug")
+	assert.Empty(t, stderr.String())
+
+	// Logging goes back to where it was afterwards:
+	logger.Info("After conversion")
+	assert.Contains(t, stderr.String(), "After conversion")
+}
+
+func TestLogLevel(t *testing.T) {
+
+	// Make a Logrus logger (at the default level of our binary):
+	logger := logrus.New()
+	logger.SetLevel(logrus.WarnLevel)
+	logger.SetOutput(&bytes.Buffer{})
+
+	protoConverter := New(logger)
+	protoConverter.parseGeneratorParameters("log_level=info")
+	assert.Equal(t, logrus.InfoLevel, logger.GetLevel())
+
+	// Invalid levels leave things as they were:
+	protoConverter.parseGeneratorParameters("log_level=chatty")
+	assert.Equal(t, logrus.InfoLevel, logger.GetLevel())
+
+	protoConverter.parseGeneratorParameters("debug")
+	assert.Equal(t, logrus.DebugLevel, logger.GetLevel())
+}
@@ -0,0 +1,87 @@
+package converter
+
+import (
+	"container/list"
+	"sync"
+)
+
+// defaultTypeCacheSize is how many resolved type names we remember (which covers the references of all but the very
+// largest descriptor sets):
+const defaultTypeCacheSize = 65536
+
+// lookupCache is a concurrency-safe LRU cache of resolved type lookups, keyed by fully-qualified name. Type names get
+// looked up over and over again (for every field of that type), and each lookup walks the package tree. A nil cache
+// remembers nothing:
+type lookupCache struct {
+	capacity int
+	entries  map[string]*list.Element
+	mutex    sync.Mutex
+	order    *list.List // Most recently used first
+}
+
+// lookupCacheEntry is a cached lookup (of a message or an enum), along with the package it was found in:
+type lookupCacheEntry struct {
+	name    string
+	desc    interface{}
+	pkgName string
+}
+
+// newLookupCache returns a cache of (up to) this many lookups (or nil, to not cache anything):
+func newLookupCache(capacity int) *lookupCache {
+	if capacity < 1 {
+		return nil
+	}
+	return &lookupCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// get returns a cached lookup (marking it as the most recently used):
+func (c *lookupCache) get(name string) (interface{}, string, bool) {
+	if c == nil {
+		return nil, "", false
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[name]
+	if !ok {
+		return nil, "", false
+	}
+	c.order.MoveToFront(element)
+	entry := element.Value.(*lookupCacheEntry)
+	return entry.desc, entry.pkgName, true
+}
+
+// add caches a lookup, evicting the least recently used one if the cache is full:
+func (c *lookupCache) add(name string, desc interface{}, pkgName string) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.entries[name]; ok {
+		element.Value = &lookupCacheEntry{name: name, desc: desc, pkgName: pkgName}
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[name] = c.order.PushFront(&lookupCacheEntry{name: name, desc: desc, pkgName: pkgName})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lookupCacheEntry).name)
+	}
+}
+
+// len returns the number of cached lookups:
+func (c *lookupCache) len() int {
+	if c == nil {
+		return 0
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.order.Len()
+}
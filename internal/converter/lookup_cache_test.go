@@ -0,0 +1,93 @@
+package converter
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupCache(t *testing.T) {
+
+	// The least recently used lookups are evicted first:
+	cache := newLookupCache(2)
+	cache.add(".acme.A", "a", "acme")
+	cache.add(".acme.B", "b", "acme")
+	_, _, ok := cache.get(".acme.A")
+	assert.True(t, ok)
+	cache.add(".acme.C", "c", "acme")
+	assert.Equal(t, 2, cache.len())
+	_, _, ok = cache.get(".acme.B")
+	assert.False(t, ok)
+	desc, pkgName, ok := cache.get(".acme.A")
+	assert.True(t, ok)
+	assert.Equal(t, "a", desc)
+	assert.Equal(t, "acme", pkgName)
+
+	// Nil caches (of no size) don't remember anything:
+	cache = newLookupCache(0)
+	cache.add(".acme.A", "a", "acme")
+	_, _, ok = cache.get(".acme.A")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.len())
+
+	// Caches can be used from lots of goroutines at the same time (run with -race to check):
+	cache = newLookupCache(100)
+	var waitGroup sync.WaitGroup
+	for goroutine := 0; goroutine < 10; goroutine++ {
+		waitGroup.Add(1)
+		go func(goroutine int) {
+			defer waitGroup.Done()
+			for index := 0; index < 1000; index++ {
+				name := fmt.Sprintf(".acme.Type%d", (goroutine*index)%150)
+				if _, _, ok := cache.get(name); !ok {
+					cache.add(name, name, "acme")
+				}
+			}
+		}(goroutine)
+	}
+	waitGroup.Wait()
+	assert.Equal(t, 100, cache.len())
+}
+
+func TestTypeCacheSize(t *testing.T) {
+
+	fileDescriptorSet := mustReadProtoFiles(t, sampleProtoDirectory, "NestedMessage.proto")
+	convert := func(parameter string) (*Converter, string) {
+		protoConverter := New(newTestLogger())
+		response, err := protoConverter.convert(fileRequest("NestedMessage.proto", parameter, fileDescriptorSet.GetFile()...))
+		require.NoError(t, err)
+		require.Len(t, response.File, 1)
+		return protoConverter, response.File[0].GetContent()
+	}
+
+	// The messages and enums referred to get cached (and the schema is the same without caching):
+	protoConverter, content := convert("")
+	cached := protoConverter.resolvedTypes.len()
+	assert.True(t, cached > 0)
+	msgDesc, pkgName, ok := protoConverter.lookupType(nil, ".samples.PayloadMessage")
+	assert.True(t, ok)
+	assert.Equal(t, "PayloadMessage", msgDesc.GetName())
+	assert.Equal(t, ".samples", pkgName)
+	assert.Equal(t, cached, protoConverter.resolvedTypes.len())
+	enumDesc, _, ok := protoConverter.lookupEnum(nil, ".samples.PayloadMessage.Topology")
+	assert.True(t, ok)
+	assert.Equal(t, "Topology", enumDesc.GetName())
+	enumDesc, _, ok = protoConverter.lookupEnum(nil, ".samples.PayloadMessage.Topology")
+	assert.True(t, ok)
+	assert.Equal(t, "Topology", enumDesc.GetName())
+
+	// Messages and enums never get mistaken for each other:
+	_, _, ok = protoConverter.lookupEnum(nil, ".samples.PayloadMessage")
+	assert.False(t, ok)
+
+	uncachedConverter, uncachedContent := convert("type_cache_size=0")
+	assert.Nil(t, uncachedConverter.resolvedTypes)
+	assert.Equal(t, content, uncachedContent)
+
+	// Invalid sizes are ignored:
+	protoConverter, _ = convert("type_cache_size=lots")
+	assert.Equal(t, defaultTypeCacheSize, protoConverter.typeCacheSize)
+}
@@ -0,0 +1,31 @@
+package converter
+
+import (
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// mapKeyPatterns are the patterns which map keys of non-string types follow once they're rendered as JSON property names
+// (see https://developers.google.com/protocol-buffers/docs/proto3#json):
+var mapKeyPatterns = map[descriptor.FieldDescriptorProto_Type]string{
+	descriptor.FieldDescriptorProto_TYPE_BOOL:     "^(true|false)$",
+	descriptor.FieldDescriptorProto_TYPE_FIXED32:  "^[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_FIXED64:  "^[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_INT32:    "^-?[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_INT64:    "^-?[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_SFIXED32: "^-?[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_SFIXED64: "^-?[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_SINT32:   "^-?[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_SINT64:   "^-?[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_UINT32:   "^[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_UINT64:   "^[0-9]+$",
+}
+
+// mapKeyPattern returns the pattern the keys of a map entry follow (or "" if they can be any string):
+func mapKeyPattern(mapEntryDesc *descriptor.DescriptorProto) string {
+	for _, fieldDesc := range mapEntryDesc.GetField() {
+		if fieldDesc.GetNumber() == 1 {
+			return mapKeyPatterns[fieldDesc.GetType()]
+		}
+	}
+	return ""
+}
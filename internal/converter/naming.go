@@ -0,0 +1,114 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/alecthomas/jsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// NamingStrategy decides what generated schemas (and the things in them) are called. Library embedders can implement
+// their own (embedding one of the built-in strategies to only override some of the names), instead of forking for bespoke
+// naming conventions. A strategy takes the place of the naming flags (json_fieldnames, proto_and_json_fieldnames and
+// prefix_schema_files_with_package), but options (eg property_name and schema_filename) and renames still apply:
+type NamingStrategy interface {
+
+	// SchemaFileName names the schema generated for a message (before it gets versioned or routed). The name is usually
+	// the message name, unless the schema_filename option or type_url_names have changed it:
+	SchemaFileName(pkgName, name, fileExtension string) string
+
+	// DefinitionName is the key a referenced message is defined under (given its fully-qualified name, eg "acme.Order").
+	// Each schema's own message is always defined under its (short) name:
+	DefinitionName(fullName string) string
+
+	// PropertyNames are the property name(s) a field appears under:
+	PropertyNames(fieldDesc *descriptor.FieldDescriptorProto) []string
+
+	// SchemaID is the $id of a message's schema (or "" for none, leaving it to parameters like id_template):
+	SchemaID(pkgName, messageName, schemaFileName string) string
+}
+
+// builtInNaming is the naming the converter does by itself (according to its flags):
+type builtInNaming struct {
+	jsonFieldNames  bool
+	protoFieldNames bool
+	packagePrefixed bool
+}
+
+// The built-in naming strategies:
+var (
+	// ProtoNaming names properties after proto field names (the default):
+	ProtoNaming NamingStrategy = builtInNaming{protoFieldNames: true}
+
+	// JSONNaming names properties after JSON field names (like json_fieldnames):
+	JSONNaming NamingStrategy = builtInNaming{jsonFieldNames: true}
+
+	// ProtoAndJSONNaming accepts properties under both names (like proto_and_json_fieldnames):
+	ProtoAndJSONNaming NamingStrategy = builtInNaming{jsonFieldNames: true, protoFieldNames: true}
+
+	// PackagePrefixedNaming puts schemas into directories named after their packages (like prefix_schema_files_with_package):
+	PackagePrefixedNaming NamingStrategy = builtInNaming{protoFieldNames: true, packagePrefixed: true}
+)
+
+func (n builtInNaming) SchemaFileName(pkgName, name, fileExtension string) string {
+	schemaFileName := fmt.Sprintf("%s.%s", name, fileExtension)
+	if n.packagePrefixed && pkgName != "" {
+		schemaFileName = fmt.Sprintf("%s/%s", pkgName, schemaFileName)
+	}
+	return schemaFileName
+}
+
+func (n builtInNaming) DefinitionName(fullName string) string {
+	return fullName
+}
+
+func (n builtInNaming) PropertyNames(fieldDesc *descriptor.FieldDescriptorProto) []string {
+	var names []string
+	if n.protoFieldNames {
+		names = append(names, fieldDesc.GetName())
+	}
+	if n.jsonFieldNames {
+		names = append(names, fieldDesc.GetJsonName())
+	}
+	return names
+}
+
+func (n builtInNaming) SchemaID(pkgName, messageName, schemaFileName string) string {
+	return ""
+}
+
+// naming returns the naming strategy we've been given (or the built-in naming our flags describe), with slugged
+// definition names if we've been asked for them:
+func (c *Converter) naming() NamingStrategy {
+	var strategy NamingStrategy = builtInNaming{
+		jsonFieldNames:  c.Flags.UseJSONFieldnamesOnly || c.Flags.UseProtoAndJSONFieldNames,
+		protoFieldNames: !c.Flags.UseJSONFieldnamesOnly,
+		packagePrefixed: c.Flags.PrefixSchemaFilesWithPackage,
+	}
+	if c.namingStrategy != nil {
+		strategy = c.namingStrategy
+	}
+	if c.Flags.DefinitionSlugs {
+		strategy = SlugDefinitions(strategy)
+	}
+	return strategy
+}
+
+// stampSchemaID gives a message's schema the $id our naming strategy comes up with (if it has one):
+func (c *Converter) stampSchemaID(pkgName, messageName, schemaFileName string, jsonSchemaType *jsonschema.Type) {
+	schemaID := c.naming().SchemaID(pkgName, messageName, schemaFileName)
+	if schemaID == "" {
+		return
+	}
+
+	setSchemaID(jsonSchemaType, schemaID)
+}
+
+// setSchemaID identifies a schema (draft-04 calls the keyword "id", later drafts use "$id"):
+func setSchemaID(jsonSchemaType *jsonschema.Type, schemaID string) {
+	idKeyword := "$id"
+	if jsonSchemaType.Version == versionDraft04 {
+		idKeyword = "id"
+	}
+	setExtras(jsonSchemaType, map[string]interface{}{idKeyword: schemaID})
+}
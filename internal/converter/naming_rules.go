@@ -0,0 +1,125 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// namingRulesFile is the structure of the (JSON) rules file:
+type namingRulesFile struct {
+	Rules []*namingRule `json:"rules"`
+}
+
+// namingRule adds a schema fragment to every field whose name matches a pattern (optionally only for one type of field),
+// so that naming conventions can become constraints (eg "_email$" => {"format": "email"}):
+type namingRule struct {
+	Field  string          `json:"field"`
+	Type   string          `json:"type"`
+	Schema json.RawMessage `json:"schema"`
+
+	fieldPattern *regexp.Regexp
+}
+
+// loadNamingRules reads and checks a rules file:
+func loadNamingRules(fileName string) ([]*namingRule, error) {
+	rulesJSON, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	rulesFile := &namingRulesFile{}
+	if err := json.Unmarshal(rulesJSON, rulesFile); err != nil {
+		return nil, err
+	}
+
+	// Make sure every rule makes sense:
+	for _, rule := range rulesFile.Rules {
+		if rule.Field == "" {
+			return nil, fmt.Errorf("rules must specify a field pattern")
+		}
+		if rule.fieldPattern, err = regexp.Compile(rule.Field); err != nil {
+			return nil, fmt.Errorf("invalid field pattern (%s): %v", rule.Field, err)
+		}
+		var fragment map[string]interface{}
+		if err := json.Unmarshal(rule.Schema, &fragment); err != nil || len(fragment) == 0 {
+			return nil, fmt.Errorf("rule for %s must have a schema (object)", rule.Field)
+		}
+	}
+
+	return rulesFile.Rules, nil
+}
+
+// fieldTypeName names the type of a field the way rules refer to it: scalars by their proto names (eg "string", "int64"),
+// and messages / enums by their fully-qualified names (eg "google.protobuf.Timestamp"):
+func fieldTypeName(desc *descriptor.FieldDescriptorProto) string {
+	if desc.GetTypeName() != "" {
+		return strings.TrimPrefix(desc.GetTypeName(), ".")
+	}
+	return strings.ToLower(strings.TrimPrefix(desc.GetType().String(), "TYPE_"))
+}
+
+// applyNamingRules merges the schema fragments of every matching rule (in order) into a field's schema:
+func (c *Converter) applyNamingRules(desc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type) error {
+	for _, rule := range c.namingRules {
+		if !rule.fieldPattern.MatchString(desc.GetName()) {
+			continue
+		}
+		if rule.Type != "" && rule.Type != fieldTypeName(desc) {
+			continue
+		}
+		c.logger.WithField("field_name", desc.GetName()).WithField("rule", rule.Field).Debug("Applying naming rule")
+		if err := mergeSchemaFragment(jsonSchemaType, rule.Schema); err != nil {
+			return fmt.Errorf("unable to apply rule for %s to field %s: %v", rule.Field, desc.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// mergeSchemaFragment sets every keyword of a fragment on a schema: keywords we model directly are unmarshaled onto it,
+// and anything else (eg "x-" keywords) becomes an extra:
+func mergeSchemaFragment(jsonSchemaType *jsonschema.Type, fragment json.RawMessage) error {
+	var keywords map[string]json.RawMessage
+	if err := json.Unmarshal(fragment, &keywords); err != nil {
+		return err
+	}
+
+	// Find out which keywords we model (by round-tripping them through an empty schema):
+	modelled := new(jsonschema.Type)
+	if err := json.Unmarshal(fragment, modelled); err != nil {
+		return err
+	}
+	modelledJSON, err := json.Marshal(modelled)
+	if err != nil {
+		return err
+	}
+	var modelledKeywords map[string]json.RawMessage
+	if err := json.Unmarshal(modelledJSON, &modelledKeywords); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(fragment, jsonSchemaType); err != nil {
+		return err
+	}
+	if _, ok := modelledKeywords["additionalProperties"]; ok {
+		delete(jsonSchemaType.Extras, additionalPropertiesKeyword) // The fragment's value wins
+	}
+
+	for keyword, value := range keywords {
+		if _, ok := modelledKeywords[keyword]; ok {
+			continue
+		}
+		var extra interface{}
+		if err := json.Unmarshal(value, &extra); err != nil {
+			return err
+		}
+		setExtras(jsonSchemaType, map[string]interface{}{keyword: extra})
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package converter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadNamingRules(t *testing.T) {
+	directory, err := ioutil.TempDir("", "protoc-gen-jsonschema")
+	require.NoError(t, err)
+	defer os.RemoveAll(directory)
+	rulesFileName := filepath.Join(directory, "rules.json")
+
+	for rulesJSON, expectedError := range map[string]string{
+		`{"rules": [{"field": "_email$", "schema": {"format": "email"}}]}`: "",
+		`{"rules": [{"schema": {"format": "email"}}]}`:                     "rules must specify a field pattern",
+		`{"rules": [{"field": "(", "schema": {"format": "email"}}]}`:       "invalid field pattern (()",
+		`{"rules": [{"field": "_email$"}]}`:                                "rule for _email$ must have a schema (object)",
+		`{"rules": [{"field": "_email$", "schema": "email"}]}`:             "rule for _email$ must have a schema (object)",
+	} {
+		require.NoError(t, ioutil.WriteFile(rulesFileName, []byte(rulesJSON), 0644))
+		_, err := loadNamingRules(rulesFileName)
+		if expectedError == "" {
+			assert.NoError(t, err, rulesJSON)
+		} else {
+			require.Error(t, err, rulesJSON)
+			assert.Contains(t, err.Error(), expectedError, rulesJSON)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// kebabNaming follows some bespoke conventions (only overriding some of the built-in JSON naming):
+type kebabNaming struct {
+	NamingStrategy
+}
+
+func (n kebabNaming) SchemaFileName(pkgName, name, fileExtension string) string {
+	return strings.ToLower(name) + ".schema." + fileExtension
+}
+
+func (n kebabNaming) DefinitionName(fullName string) string {
+	return strings.Replace(fullName, ".", "_", -1)
+}
+
+func (n kebabNaming) SchemaID(pkgName, messageName, schemaFileName string) string {
+	return "https://schemas.acme.com/" + schemaFileName
+}
+
+func TestNamingStrategy(t *testing.T) {
+
+	// The built-in strategies are what the naming flags describe:
+	for flags, namingStrategy := range map[ConverterFlags]NamingStrategy{
+		{}:                                   ProtoNaming,
+		{UseJSONFieldnamesOnly: true}:        JSONNaming,
+		{UseProtoAndJSONFieldNames: true}:    ProtoAndJSONNaming,
+		{PrefixSchemaFilesWithPackage: true}: PackagePrefixedNaming,
+	} {
+		assert.Equal(t, namingStrategy, NewWithOptions(newTestLogger(), ConvertOptions{Flags: flags}).naming())
+	}
+
+	fieldDesc := &descriptor.FieldDescriptorProto{Name: proto.String("order_id"), JsonName: proto.String("orderId")}
+	assert.Equal(t, []string{"order_id"}, ProtoNaming.PropertyNames(fieldDesc))
+	assert.Equal(t, []string{"orderId"}, JSONNaming.PropertyNames(fieldDesc))
+	assert.Equal(t, []string{"order_id", "orderId"}, ProtoAndJSONNaming.PropertyNames(fieldDesc))
+	assert.Equal(t, "acme/Order.json", PackagePrefixedNaming.SchemaFileName("acme", "Order", "json"))
+	assert.Equal(t, "Order.json", PackagePrefixedNaming.SchemaFileName("", "Order", "json"))
+
+	// A strategy of our own takes the place of the naming flags:
+	protoConverter := NewWithOptions(newTestLogger(), ConvertOptions{
+		Flags:  ConverterFlags{PrefixSchemaFilesWithPackage: true},
+		Naming: kebabNaming{NamingStrategy: JSONNaming},
+	})
+	assert.Equal(t, []string{"orderId"}, protoConverter.fieldNames(fieldDesc))
+}
@@ -0,0 +1,62 @@
+package converter
+
+import (
+	"github.com/alecthomas/jsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	defaultMaxDepth  = 100
+	truncatedKeyword = "x-truncated"
+)
+
+// definitionDepths measures how deeply each message a schema refers to is nested (along the shortest chain of fields from
+// the root message, at depth 0), so that references count towards the maximum depth just like inlined messages do:
+func (c *Converter) definitionDepths(curPkg *ProtoPackage, msgDesc *descriptor.DescriptorProto) map[*descriptor.DescriptorProto]int {
+	depths := map[*descriptor.DescriptorProto]int{msgDesc: 0}
+	for queue := []*descriptor.DescriptorProto{msgDesc}; len(queue) > 0; queue = queue[1:] {
+		for _, desc := range queue[0].GetField() {
+			if c.excludedField(queue[0], desc) || rawFieldSchema(desc) != "" {
+				continue
+			}
+			for _, typeName := range append([]string{desc.GetTypeName()}, anyFieldTypes(desc)...) {
+				if typeName == "" {
+					continue
+				}
+				recordType, _, ok := c.lookupType(curPkg, typeName)
+				if !ok {
+					continue
+				}
+				if _, measured := depths[recordType]; measured {
+					continue
+				}
+				depths[recordType] = depths[queue[0]] + 1
+				queue = append(queue, recordType)
+			}
+		}
+	}
+	return depths
+}
+
+// truncatedMessage stands in for messages nested more deeply than the maximum depth (with a schema which accepts anything,
+// and is marked as such), so that pathological protos (eg from other generators) can't produce enormous
+// schemas. Messages which are deep enough to be truncated never get converted:
+func (c *Converter) truncatedMessage(msgDesc *descriptor.DescriptorProto) *jsonschema.Type {
+
+	// Map entries are part of the field they describe (and have to keep their "value"):
+	if c.maxDepth < 1 || c.messageDepth <= c.maxDepth || msgDesc.GetOptions().GetMapEntry() {
+		return nil
+	}
+
+	c.logger.WithField("message_name", msgDesc.GetName()).WithField("max_depth", c.maxDepth).Warn("Message is nested too deeply - truncating it")
+	return &jsonschema.Type{Extras: map[string]interface{}{truncatedKeyword: true}}
+}
+
+// reusableDefinition tells us whether a definition converted at another depth comes out the same at this one, which it
+// does as long as the maximum depth truncated nothing within it (and wouldn't here either):
+func (c *Converter) reusableDefinition(cached *cachedDefinition) bool {
+	if c.maxDepth < 1 || cached.depth == c.messageDepth {
+		return true
+	}
+	return cached.depth+cached.nesting <= c.maxDepth && c.messageDepth+cached.nesting <= c.maxDepth
+}
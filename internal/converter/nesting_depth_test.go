@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxDepthParameter(t *testing.T) {
+
+	// Invalid limits are ignored:
+	protoConverter := New(logrus.New())
+	protoConverter.parseGeneratorParameters("max_depth=deep")
+	assert.Equal(t, defaultMaxDepth, protoConverter.maxDepth)
+	protoConverter.parseGeneratorParameters("max_depth=0")
+	assert.Equal(t, 0, protoConverter.maxDepth)
+}
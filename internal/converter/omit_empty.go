@@ -0,0 +1,57 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/iancoleman/orderedmap"
+)
+
+// meaningfullyEmptyKeywords are the keywords whose empty values mean something different from leaving them out
+// (eg `"not": {}` rejects everything, `"enum": []` doesn't allow any values, `"const": {}` only allows an empty object
+// and `"if": {}` always applies its "then"), or which are values rather than schemas (eg `"default": []`):
+var meaningfullyEmptyKeywords = map[string]bool{
+	"const":    true,
+	"contains": true,
+	"default":  true,
+	"enum":     true,
+	"examples": true,
+	"if":       true,
+	"not":      true,
+}
+
+// omitEmpty removes keywords with empty objects or arrays as their values (eg `"properties": {}` or `"required": []`)
+// from every schema in a document, for minimal schemas:
+func omitEmpty(jsonSchemaJSON []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(jsonSchemaJSON))
+	decoder.UseNumber()
+	decoded, err := decodeOrderedJSON(decoder)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := decoded.(*orderedmap.OrderedMap)
+	if !ok {
+		return jsonSchemaJSON, nil
+	}
+
+	walkSchema(root, func(schema *orderedmap.OrderedMap) {
+		for _, keyword := range append([]string(nil), schema.Keys()...) {
+			if meaningfullyEmptyKeywords[keyword] {
+				continue
+			}
+			value, _ := schema.Get(keyword)
+			switch value := value.(type) {
+			case *orderedmap.OrderedMap:
+				if len(value.Keys()) == 0 {
+					schema.Delete(keyword)
+				}
+			case []interface{}:
+				if len(value) == 0 {
+					schema.Delete(keyword)
+				}
+			}
+		}
+	})
+
+	return json.MarshalIndent(root, "", "    ")
+}
@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOmitEmpty(t *testing.T) {
+
+	// Empty keywords are removed (at every level), but empty schemas are left alone, and so are empty keywords which mean something:
+	minimal, err := omitEmpty([]byte(`{
+		"$ref": "#/definitions/Order",
+		"definitions": {
+			"Order": {
+				"type": "object",
+				"properties": {
+					"anything": {},
+					"notes": {"type": "array", "items": {"type": "string", "properties": {}}, "examples": []},
+					"impossible": {"not": {}, "enum": []},
+					"labels": {"type": "object", "const": {}, "default": {}, "if": {}, "then": {"maxProperties": 0}}
+				},
+				"required": [],
+				"patternProperties": {},
+				"x-annotations": {"empty": {}}
+			}
+		}
+	}`))
+	require.NoError(t, err)
+
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(minimal, &schema))
+	order := schema["definitions"].(map[string]interface{})["Order"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"anything":   map[string]interface{}{},
+			"notes":      map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}, "examples": []interface{}{}},
+			"impossible": map[string]interface{}{"not": map[string]interface{}{}, "enum": []interface{}{}},
+			"labels":     map[string]interface{}{"type": "object", "const": map[string]interface{}{}, "default": map[string]interface{}{}, "if": map[string]interface{}{}, "then": map[string]interface{}{"maxProperties": float64(0)}},
+		},
+		"x-annotations": map[string]interface{}{"empty": map[string]interface{}{}},
+	}, order)
+
+	_, err = omitEmpty([]byte(`{"type": `))
+	assert.Error(t, err)
+}
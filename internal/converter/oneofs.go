@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"github.com/alecthomas/jsonschema"
+	"github.com/xeipuuv/gojsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// enforcedOneof reports whether a field belongs to a OneOf which should be enforced
+// (proto3 "optional" fields belong to synthetic OneOfs, which aren't real choices):
+func (c *Converter) enforcedOneof(fieldDesc *descriptor.FieldDescriptorProto) bool {
+	return c.Flags.EnforceOneOf && fieldDesc.OneofIndex != nil && !fieldDesc.GetProto3Optional()
+}
+
+// oneofAlternative requires a field to be present (under any of the property names it appears as):
+func (c *Converter) oneofAlternative(fieldDesc *descriptor.FieldDescriptorProto) *jsonschema.Type {
+	propertyNames := c.fieldNames(fieldDesc)
+	if len(propertyNames) == 1 {
+		return &jsonschema.Type{Required: propertyNames}
+	}
+
+	alternative := &jsonschema.Type{}
+	for _, propertyName := range propertyNames {
+		alternative.AnyOf = append(alternative.AnyOf, &jsonschema.Type{Required: []string{propertyName}})
+	}
+	return alternative
+}
+
+// enforceOneofs requires exactly one field from each OneOf. Nullable messages only constrain their object branch
+// (null satisfies every "required" alternative), and messages with several OneOfs need all of them satisfied:
+func enforceOneofs(jsonSchemaType *jsonschema.Type, oneofs [][]*jsonschema.Type) {
+	if len(oneofs) == 0 {
+		return
+	}
+
+	constrainedJSONSchemaType := jsonSchemaType
+	for _, alternative := range jsonSchemaType.OneOf {
+		if alternative.Type == gojsonschema.TYPE_OBJECT {
+			constrainedJSONSchemaType = alternative
+		}
+	}
+
+	if len(oneofs) == 1 {
+		constrainedJSONSchemaType.OneOf = oneofs[0]
+		return
+	}
+	for _, alternatives := range oneofs {
+		constrainedJSONSchemaType.AllOf = append(constrainedJSONSchemaType.AllOf, &jsonschema.Type{OneOf: alternatives})
+	}
+}
@@ -0,0 +1,284 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	"google.golang.org/protobuf/encoding/protowire"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	openAPIVersion                                  = "3.0.3"
+	openAPIServicesSuffix                           = ".openapi"
+	openAPIUnversioned                              = "unversioned"
+	oauth2SecurityScheme                            = "oauth2"
+	googleAuthorizationURL                          = "https://accounts.google.com/o/oauth2/auth"
+	googleTokenURL                                  = "https://oauth2.googleapis.com/token"
+	tag_ServiceOptions_defaultHost protowire.Number = 1049 // (google.api.default_host)
+	tag_ServiceOptions_oauthScopes protowire.Number = 1050 // (google.api.oauth_scopes)
+)
+
+// connectPath is the path a unary method is called at by Connect and HTTP/JSON transcoding proxies (eg
+// "/acme.v1.OrderService/PlaceOrder", or "/OrderService/PlaceOrder" for files without a package):
+func connectPath(pkgName, serviceName, methodName string) string {
+	return "/" + qualifiedName(pkgName, serviceName) + "/" + methodName
+}
+
+// serviceAnnotations are the (google.api) client annotations of a service:
+type serviceAnnotations struct {
+	defaultHost string
+	oauthScopes []string
+}
+
+// convertServices generates an OpenAPI document for the services in a file: every service becomes a tag, and every
+// unary method an operation (POST /<package>.<Service>/<Method>, as used by Connect and HTTP/JSON transcoding proxies):
+func (c *Converter) convertServices(pkg *ProtoPackage, file *descriptor.FileDescriptorProto, fileExtension string) (*plugin.CodeGeneratorResponse_File, error) {
+	openAPIFileName := c.generateSchemaFilename(file, fileExtension, strings.TrimSuffix(path.Base(file.GetName()), ".proto")+openAPIServicesSuffix)
+	c.logger.WithField("proto_filename", file.GetName()).WithField("services", len(file.GetService())).WithField("openapi_filename", openAPIFileName).Info("Generating OpenAPI document for SERVICES")
+
+	componentsSchema := &jsonschema.Schema{Type: &jsonschema.Type{}, Definitions: jsonschema.Definitions{}}
+	var servers, tags []interface{}
+	serverHosts := make(map[string]bool)
+	paths := orderedmap.New()
+	scopes := orderedmap.New()
+	for _, service := range file.GetService() {
+		annotations := parseServiceAnnotations(service.GetOptions())
+		if annotations.defaultHost != "" && !serverHosts[annotations.defaultHost] {
+			serverHosts[annotations.defaultHost] = true
+			server := orderedmap.New()
+			server.Set("url", "https://"+annotations.defaultHost)
+			servers = append(servers, server)
+		}
+		for _, scope := range annotations.oauthScopes {
+			scopes.Set(scope, "")
+		}
+
+		tag := orderedmap.New()
+		tag.Set("name", service.GetName())
+		if _, description := c.commentSummary(c.sourceInfo.GetService(service)); description != "" {
+			tag.Set("description", description)
+		}
+		tags = append(tags, tag)
+
+		for _, method := range service.GetMethod() {
+			if method.GetClientStreaming() || method.GetServerStreaming() {
+				c.logger.WithField("service", service.GetName()).WithField("method", method.GetName()).Debug("Skipping streaming method")
+				continue
+			}
+			operation, err := c.serviceOperation(pkg, service, method, annotations, componentsSchema)
+			if err != nil {
+				return nil, err
+			}
+			pathItem := orderedmap.New()
+			pathItem.Set("post", operation)
+			paths.Set(connectPath(file.GetPackage(), service.GetName(), method.GetName()), pathItem)
+		}
+	}
+
+	// The messages are converted as OpenAPI components, the same way as the rest of our schemas:
+	components, err := c.openAPIComponentsOf(openAPIFileName, componentsSchema)
+	if err != nil {
+		return nil, err
+	}
+	if len(scopes.Keys()) > 0 {
+		components.Set("securitySchemes", oauth2SecuritySchemes(scopes))
+	}
+
+	info := orderedmap.New()
+	info.Set("title", file.GetPackage())
+	info.Set("version", openAPIUnversioned)
+	if c.sourceRevision != "" {
+		info.Set("version", c.sourceRevision)
+	}
+
+	document := orderedmap.New()
+	document.Set("openapi", openAPIVersion)
+	document.Set("info", info)
+	if len(servers) > 0 {
+		document.Set("servers", servers)
+	}
+	document.Set("tags", tags)
+	document.Set("paths", paths)
+	document.Set("components", components)
+
+	documentJSON, err := json.MarshalIndent(document, "", "    ")
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to encode OpenAPI document")
+		return nil, err
+	}
+	return c.responseFile(openAPIFileName, documentJSON)
+}
+
+// serviceOperation describes a method as an operation (adding its request and response messages to the components):
+func (c *Converter) serviceOperation(pkg *ProtoPackage, service *descriptor.ServiceDescriptorProto, method *descriptor.MethodDescriptorProto, annotations serviceAnnotations, componentsSchema *jsonschema.Schema) (*orderedmap.OrderedMap, error) {
+	operation := orderedmap.New()
+	operation.Set("tags", []string{service.GetName()})
+	summary, description := c.commentSummary(c.sourceInfo.GetMethod(method))
+	if summary != "" {
+		operation.Set("summary", summary)
+	}
+	if description != summary {
+		operation.Set("description", description)
+	}
+	operation.Set("operationId", fmt.Sprintf("%s_%s", service.GetName(), method.GetName()))
+
+	inputRef, _, err := c.addServiceMessage(pkg, method.GetInputType(), componentsSchema.Definitions)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the input of %s.%s: %v", service.GetName(), method.GetName(), err)
+	}
+	requestBody := orderedmap.New()
+	requestBody.Set("required", true)
+	requestBody.Set("content", jsonContent(strings.Replace(inputRef, c.refPrefix, openAPIRefPrefix, 1)))
+	operation.Set("requestBody", requestBody)
+
+	outputRef, _, err := c.addServiceMessage(pkg, method.GetOutputType(), componentsSchema.Definitions)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the output of %s.%s: %v", service.GetName(), method.GetName(), err)
+	}
+	response := orderedmap.New()
+	response.Set("description", "A successful response.")
+	response.Set("content", jsonContent(strings.Replace(outputRef, c.refPrefix, openAPIRefPrefix, 1)))
+	responses := orderedmap.New()
+	responses.Set("200", response)
+	operation.Set("responses", responses)
+
+	if len(annotations.oauthScopes) > 0 {
+		operation.Set("security", []map[string][]string{{oauth2SecurityScheme: annotations.oauthScopes}})
+	}
+
+	return operation, nil
+}
+
+// addServiceMessage converts the request (or response) message of a method, adding it (and everything it refers to) to
+// a set of definitions, and returns a reference to it:
+func (c *Converter) addServiceMessage(pkg *ProtoPackage, typeName string, definitions jsonschema.Definitions) (string, *descriptor.DescriptorProto, error) {
+	msgDesc, pkgName, ok := c.lookupType(pkg, typeName)
+	if !ok {
+		return "", nil, fmt.Errorf("no such message type named %s", typeName)
+	}
+	msgPkg, ok := c.relativelyLookupPackage(c.rootPkg, strings.TrimPrefix(pkgName, "."))
+	if !ok {
+		return "", nil, fmt.Errorf("no such package found: %s", pkgName)
+	}
+
+	messageJSONSchema, err := c.convertMessageType(msgPkg, msgDesc)
+	if err != nil {
+		return "", nil, err
+	}
+	for name, definition := range messageJSONSchema.Definitions {
+		if _, ok := definitions[name]; !ok {
+			definitions[name] = definition
+		}
+	}
+	return messageJSONSchema.Ref, msgDesc, nil
+}
+
+// openAPIComponentsOf renders the schemas of messages as OpenAPI components:
+func (c *Converter) openAPIComponentsOf(openAPIFileName string, componentsSchema *jsonschema.Schema) (*orderedmap.OrderedMap, error) {
+	componentsJSON, err := json.Marshal(componentsSchema)
+	if err != nil {
+		return nil, err
+	}
+	if componentsJSON, err = c.convertSchemaVersion(openAPIFileName, componentsJSON); err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(componentsJSON))
+	decoder.UseNumber()
+	decoded, err := decodeOrderedJSON(decoder)
+	if err != nil {
+		return nil, err
+	}
+	components, _ := decoded.(*orderedmap.OrderedMap).Get("components")
+	return components.(*orderedmap.OrderedMap), nil
+}
+
+// commentSummary splits a comment into a summary (its first line) and a description (all of it):
+func (c *Converter) commentSummary(sl *descriptor.SourceCodeInfo_Location) (summary, description string) {
+	description = strings.TrimSpace(sl.GetLeadingComments())
+	if description == "" {
+		description = strings.TrimSpace(sl.GetTrailingComments())
+	}
+	summary = strings.TrimSpace(strings.SplitN(description, "\n", 2)[0])
+	if !c.Flags.KeepNewLinesInDescription {
+		description = strings.Join(strings.Fields(description), " ")
+	}
+	return summary, description
+}
+
+// parseServiceAnnotations reads the (google.api) client annotations of a service, which we don't have the types of
+// (so they are read straight from the unknown fields of its options):
+func parseServiceAnnotations(options *descriptor.ServiceOptions) serviceAnnotations {
+	var annotations serviceAnnotations
+	if options == nil {
+		return annotations
+	}
+
+	rangeBytesFields(options.ProtoReflect().GetUnknown(), func(number protowire.Number, value []byte) {
+		switch number {
+		case tag_ServiceOptions_defaultHost:
+			annotations.defaultHost = string(value)
+		case tag_ServiceOptions_oauthScopes:
+			for _, scope := range strings.Split(string(value), ",") {
+				if scope = strings.TrimSpace(scope); scope != "" {
+					annotations.oauthScopes = append(annotations.oauthScopes, scope)
+				}
+			}
+		}
+	})
+
+	return annotations
+}
+
+// rangeBytesFields calls a function for every length-delimited field (strings, bytes and messages) in encoded proto data:
+func rangeBytesFields(encoded []byte, fn func(number protowire.Number, value []byte)) {
+	for len(encoded) > 0 {
+		number, wireType, tagLength := protowire.ConsumeTag(encoded)
+		if tagLength < 0 {
+			return
+		}
+		fieldLength := protowire.ConsumeFieldValue(number, wireType, encoded[tagLength:])
+		if fieldLength < 0 {
+			return
+		}
+		if wireType == protowire.BytesType {
+			value, _ := protowire.ConsumeBytes(encoded[tagLength:])
+			fn(number, value)
+		}
+		encoded = encoded[tagLength+fieldLength:]
+	}
+}
+
+// oauth2SecuritySchemes describes Google's OAuth 2.0 authorization (which is what the oauth_scopes annotation refers to):
+func oauth2SecuritySchemes(scopes *orderedmap.OrderedMap) *orderedmap.OrderedMap {
+	authorizationCode := orderedmap.New()
+	authorizationCode.Set("authorizationUrl", googleAuthorizationURL)
+	authorizationCode.Set("tokenUrl", googleTokenURL)
+	authorizationCode.Set("scopes", scopes)
+	flows := orderedmap.New()
+	flows.Set("authorizationCode", authorizationCode)
+	securityScheme := orderedmap.New()
+	securityScheme.Set("type", oauth2SecurityScheme)
+	securityScheme.Set("flows", flows)
+	securitySchemes := orderedmap.New()
+	securitySchemes.Set(oauth2SecurityScheme, securityScheme)
+	return securitySchemes
+}
+
+// jsonContent describes JSON content of a referenced schema:
+func jsonContent(ref string) *orderedmap.OrderedMap {
+	schema := orderedmap.New()
+	schema.Set("$ref", ref)
+	mediaType := orderedmap.New()
+	mediaType.Set("schema", schema)
+	content := orderedmap.New()
+	content.Set("application/json", mediaType)
+	return content
+}
@@ -0,0 +1,23 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPIServicesOnlyInOpenAPIOutput(t *testing.T) {
+
+	// Services are only described in OpenAPI output (see the OpenAPIServices sample):
+	response, err := convertTestRequest(sampleRequest(t, "", "OpenAPIServices.proto"))
+	require.NoError(t, err)
+	for _, file := range response.GetFile() {
+		assert.NotEqual(t, "OpenAPIServices.openapi.json", file.GetName())
+	}
+}
+
+func TestConnectPath(t *testing.T) {
+	assert.Equal(t, "/acme.v1.OrderService/PlaceOrder", connectPath("acme.v1", "OrderService", "PlaceOrder"))
+	assert.Equal(t, "/OrderService/PlaceOrder", connectPath("", "OrderService", "PlaceOrder"))
+}
@@ -0,0 +1,31 @@
+package converter
+
+import (
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// markProto2Messages remembers the messages (nested ones included) defined by a proto2 file, whose "optional" fields are
+// explicitly optional:
+func (c *Converter) markProto2Messages(fileDesc *descriptor.FileDescriptorProto) {
+	if fileDesc.GetSyntax() != "" && fileDesc.GetSyntax() != "proto2" {
+		return
+	}
+
+	var mark func(msgDescs []*descriptor.DescriptorProto)
+	mark = func(msgDescs []*descriptor.DescriptorProto) {
+		for _, msgDesc := range msgDescs {
+			c.proto2Messages[msgDesc] = true
+			mark(msgDesc.GetNestedType())
+		}
+	}
+	mark(fileDesc.GetMessageType())
+}
+
+// optionalField tells us whether a field is explicitly optional (with proto3's "optional" keyword, or proto2's "optional" label),
+// which some encoders write as null when it isn't set:
+func (c *Converter) optionalField(msgDesc *descriptor.DescriptorProto, fieldDesc *descriptor.FieldDescriptorProto) bool {
+	if fieldDesc.GetProto3Optional() {
+		return true
+	}
+	return c.proto2Messages[msgDesc] && fieldDesc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_OPTIONAL
+}
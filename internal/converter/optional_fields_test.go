@@ -0,0 +1,41 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestOptionalField(t *testing.T) {
+
+	optional := descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	proto2Message := &descriptor.DescriptorProto{
+		Name: proto.String("Legacy"),
+		Field: []*descriptor.FieldDescriptorProto{
+			{Name: proto.String("nickname"), Label: optional},
+			{Name: proto.String("name"), Label: descriptor.FieldDescriptorProto_LABEL_REQUIRED.Enum()},
+		},
+	}
+	proto2Message.NestedType = []*descriptor.DescriptorProto{{Name: proto.String("Nested"), Field: []*descriptor.FieldDescriptorProto{{Name: proto.String("note"), Label: optional}}}}
+	proto3Message := &descriptor.DescriptorProto{
+		Name: proto.String("Modern"),
+		Field: []*descriptor.FieldDescriptorProto{
+			{Name: proto.String("nickname"), Label: optional, Proto3Optional: proto.Bool(true)},
+			{Name: proto.String("name"), Label: optional},
+		},
+	}
+
+	converter := New(newTestLogger())
+	converter.proto2Messages = make(map[*descriptor.DescriptorProto]bool)
+	converter.markProto2Messages(&descriptor.FileDescriptorProto{Name: proto.String("legacy.proto"), MessageType: []*descriptor.DescriptorProto{proto2Message}})
+	converter.markProto2Messages(&descriptor.FileDescriptorProto{Name: proto.String("modern.proto"), Syntax: proto.String("proto3"), MessageType: []*descriptor.DescriptorProto{proto3Message}})
+
+	// Proto2's optional label makes fields explicitly optional (in nested messages too), but proto3 needs the keyword:
+	assert.True(t, converter.optionalField(proto2Message, proto2Message.Field[0]))
+	assert.False(t, converter.optionalField(proto2Message, proto2Message.Field[1]))
+	assert.True(t, converter.optionalField(proto2Message.NestedType[0], proto2Message.NestedType[0].Field[0]))
+	assert.True(t, converter.optionalField(proto3Message, proto3Message.Field[0]))
+	assert.False(t, converter.optionalField(proto3Message, proto3Message.Field[1]))
+}
@@ -0,0 +1,151 @@
+package converter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+const outputJournalFileName = ".protoc-gen-jsonschema.journal"
+
+// outputJournal records which files a run writing straight to an output directory has written (the SHA-256 digest of
+// each one's content). It only exists while a run is incomplete, so that an interrupted run can be resumed (and so that
+// anything watching the directory can tell when it is safe to load the schemas). Each file gets a line of its own,
+// appended once it has been written (so that recording a file doesn't mean rewriting everything recorded before it):
+type outputJournal struct {
+	Written map[string]string
+	file    *os.File
+}
+
+// outputJournalEntry is a line of the journal:
+type outputJournalEntry struct {
+	Name   string `json:"name"`
+	Digest string `json:"digest"`
+}
+
+// loadOutputJournal reads a journal (a missing journal simply means nothing has been written yet), and opens it to record
+// more files:
+func loadOutputJournal(fileName string) (*outputJournal, error) {
+	journal := &outputJournal{Written: make(map[string]string)}
+
+	journalFile, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	journal.file = journalFile
+
+	// A line which was cut short (by an interruption) is where the journal ends, so it is cut off before recording more:
+	decoder := json.NewDecoder(journalFile)
+	var entriesEnd int64
+	for {
+		var entry outputJournalEntry
+		if err := decoder.Decode(&entry); err == io.EOF {
+			break
+		} else if err != nil {
+			if err := journalFile.Truncate(entriesEnd); err != nil {
+				journalFile.Close()
+				return nil, err
+			}
+			if entriesEnd > 0 {
+				if _, err := journalFile.Write([]byte("\n")); err != nil {
+					journalFile.Close()
+					return nil, err
+				}
+			}
+			break
+		}
+		journal.Written[entry.Name] = entry.Digest
+		entriesEnd = decoder.InputOffset()
+	}
+
+	return journal, nil
+}
+
+// record appends a file which has been written to the journal:
+func (j *outputJournal) record(name, digest string) error {
+	entryJSON, err := json.Marshal(outputJournalEntry{Name: name, Digest: digest})
+	if err != nil {
+		return err
+	}
+	if _, err := j.file.Write(append(entryJSON, '\n')); err != nil {
+		return err
+	}
+	j.Written[name] = digest
+	return nil
+}
+
+// close closes the journal file:
+func (j *outputJournal) close() error {
+	return j.file.Close()
+}
+
+// writeOutputFiles writes generated files straight into the output directory (instead of handing them back to protoc).
+// Each file is written atomically, and recorded in the journal once it has been, so that an interrupted run never leaves
+// half-written schemas behind, and re-running it only writes what is missing or has changed. The journal is removed once
+// everything has been written:
+func (c *Converter) writeOutputFiles(files []*plugin.CodeGeneratorResponse_File) error {
+	journalFileName := filepath.Join(c.outputDirectory, outputJournalFileName)
+	if err := os.MkdirAll(c.outputDirectory, 0755); err != nil {
+		return err
+	}
+
+	journal, err := loadOutputJournal(journalFileName)
+	if err != nil {
+		return fmt.Errorf("unable to load output journal from %s: %v", journalFileName, err)
+	}
+	defer journal.close()
+
+	for _, file := range files {
+		fileName := filepath.Join(c.outputDirectory, filepath.FromSlash(file.GetName()))
+		digest := sha256.Sum256([]byte(file.GetContent()))
+
+		// Anything written by an earlier (interrupted) run is left as it is (as long as it hasn't been touched since):
+		if journal.Written[file.GetName()] == hex.EncodeToString(digest[:]) && fileDigest(fileName) == hex.EncodeToString(digest[:]) {
+			c.logger.WithField("jsonschema_filename", file.GetName()).Debug("Already written")
+			continue
+		}
+
+		// Clear away any temporary files an interrupted run left behind:
+		staleFileNames, _ := filepath.Glob(fileName + ".*.tmp")
+		for _, staleFileName := range staleFileNames {
+			os.Remove(staleFileName)
+		}
+
+		c.logger.WithField("jsonschema_filename", file.GetName()).WithField("output_dir", c.outputDirectory).Debug("Writing JSON-schema")
+		if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+			return err
+		}
+		if err := writeFileAtomically(fileName, []byte(file.GetContent())); err != nil {
+			return fmt.Errorf("failed to write %s: %v", fileName, err)
+		}
+
+		if err := journal.record(file.GetName(), hex.EncodeToString(digest[:])); err != nil {
+			return fmt.Errorf("unable to record %s in output journal %s: %v", file.GetName(), journalFileName, err)
+		}
+	}
+
+	if err := journal.close(); err != nil {
+		return err
+	}
+	if err := os.Remove(journalFileName); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// fileDigest returns the SHA-256 digest of a file's content (or nothing if it can't be read):
+func fileDigest(fileName string) string {
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return ""
+	}
+	digest := sha256.Sum256(content)
+	return hex.EncodeToString(digest[:])
+}
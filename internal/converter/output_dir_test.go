@@ -0,0 +1,90 @@
+package converter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestWriteOutputFiles(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "output")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	journalFileName := filepath.Join(tempDir, outputJournalFileName)
+
+	files := []*plugin.CodeGeneratorResponse_File{
+		{Name: proto.String("A.json"), Content: proto.String(`{"title": "A"}`)},
+		{Name: proto.String("nested/B.json"), Content: proto.String(`{"title": "B"}`)},
+		{Name: proto.String("C.json"), Content: proto.String(`{"title": "C"}`)},
+	}
+
+	// A file in the way of the "nested" directory interrupts the run after A:
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tempDir, "nested"), nil, 0644))
+	protoConverter := New(newTestLogger())
+	protoConverter.parseGeneratorParameters("output_dir=" + tempDir)
+	assert.Error(t, protoConverter.writeOutputFiles(files))
+
+	journal, err := loadOutputJournal(journalFileName)
+	require.NoError(t, err)
+	assert.Len(t, journal.Written, 1)
+	assert.Contains(t, journal.Written, "A.json")
+	require.NoError(t, journal.close())
+	assert.NoFileExists(t, filepath.Join(tempDir, "C.json"))
+
+	// Resuming (with C changed, and a temporary B left behind by a crash) leaves A alone, and writes everything else:
+	require.NoError(t, os.Remove(filepath.Join(tempDir, "nested")))
+	require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "nested"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tempDir, "nested", "B.json.123.tmp"), []byte(`{"tit`), 0644))
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(filepath.Join(tempDir, "A.json"), past, past))
+	files[2].Content = proto.String(`{"title": "C2"}`)
+
+	protoConverter = New(newTestLogger())
+	protoConverter.parseGeneratorParameters("output_dir=" + tempDir)
+	assert.NoError(t, protoConverter.writeOutputFiles(files))
+
+	aInfo, err := os.Stat(filepath.Join(tempDir, "A.json"))
+	require.NoError(t, err)
+	assert.Equal(t, past, aInfo.ModTime())
+	for _, file := range files {
+		content, err := ioutil.ReadFile(filepath.Join(tempDir, file.GetName()))
+		require.NoError(t, err)
+		assert.Equal(t, file.GetContent(), string(content))
+	}
+
+	// Nothing but the schemas is left behind:
+	assert.NoFileExists(t, journalFileName)
+	leftovers, err := filepath.Glob(filepath.Join(tempDir, "nested", "*.tmp"))
+	require.NoError(t, err)
+	assert.Empty(t, leftovers)
+
+	// A schema which has been changed on disk since it was journalled is written again:
+	journal, err = loadOutputJournal(journalFileName)
+	require.NoError(t, err)
+	require.NoError(t, journal.record("A.json", fileDigest(filepath.Join(tempDir, "A.json"))))
+	require.NoError(t, journal.close())
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tempDir, "A.json"), []byte(`{"ti`), 0644))
+	assert.NoError(t, protoConverter.writeOutputFiles(files))
+	content, err := ioutil.ReadFile(filepath.Join(tempDir, "A.json"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"title": "A"}`, string(content))
+
+	// Files are recorded a line at a time, and a line cut short by an interruption is ignored:
+	require.NoError(t, ioutil.WriteFile(journalFileName, []byte(`{"name":"A.json","digest":"`+fileDigest(filepath.Join(tempDir, "A.json"))+`"}`+"\n"+`{"name":"C.js`), 0644))
+	journal, err = loadOutputJournal(journalFileName)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"A.json": fileDigest(filepath.Join(tempDir, "A.json"))}, journal.Written)
+	require.NoError(t, journal.record("C.json", "digest"))
+	require.NoError(t, journal.close())
+	journal, err = loadOutputJournal(journalFileName)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"A.json": fileDigest(filepath.Join(tempDir, "A.json")), "C.json": "digest"}, journal.Written)
+	require.NoError(t, journal.close())
+}
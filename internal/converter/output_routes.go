@@ -0,0 +1,65 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+)
+
+const outputRouteFlagDelimiter = ";"
+
+// outputRoute sends schemas generated from matching packages into a different output directory (optionally with extra flags):
+type outputRoute struct {
+	packagePattern string
+	directory      string
+	flags          []string
+}
+
+// parseOutputRoute parses a route in the form "<package pattern>=<directory>[;flag;flag...]":
+func parseOutputRoute(value string) (outputRoute, error) {
+	parts := strings.Split(value, outputRouteFlagDelimiter)
+
+	routeParts := strings.SplitN(parts[0], "=", 2)
+	if len(routeParts) != 2 || routeParts[0] == "" || routeParts[1] == "" {
+		return outputRoute{}, fmt.Errorf("routes must be in the form <package pattern>=<directory>")
+	}
+
+	route := outputRoute{
+		packagePattern: routeParts[0],
+		directory:      routeParts[1],
+	}
+
+	for _, flag := range parts[1:] {
+		if !new(ConverterFlags).set(flag, true) {
+			return outputRoute{}, fmt.Errorf("unknown flag: %s", flag)
+		}
+		route.flags = append(route.flags, flag)
+	}
+
+	return route, nil
+}
+
+// matches tells us whether a package matches this route:
+func (r outputRoute) matches(pkgName string) bool {
+	return packageMatches(r.packagePattern, pkgName)
+}
+
+// packageMatches tells us whether a package matches a pattern ("acme.api.*" matches "acme.api" and anything beneath it):
+func packageMatches(pattern, pkgName string) bool {
+	if pattern == "*" {
+		return true
+	}
+	if prefix := strings.TrimSuffix(pattern, ".*"); prefix != pattern {
+		return pkgName == prefix || strings.HasPrefix(pkgName, prefix+".")
+	}
+	return pkgName == pattern
+}
+
+// lookupOutputRoute returns the first output route matching a package:
+func (c *Converter) lookupOutputRoute(pkgName string) (outputRoute, bool) {
+	for _, route := range c.outputRoutes {
+		if route.matches(pkgName) {
+			return route, true
+		}
+	}
+	return outputRoute{}, false
+}
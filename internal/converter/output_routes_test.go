@@ -0,0 +1,21 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOutputRoute(t *testing.T) {
+
+	// Routes have flags (after their directories):
+	route, err := parseOutputRoute("acme.api.*=public-schemas;disallow_additional_properties")
+	assert.NoError(t, err)
+	assert.Equal(t, outputRoute{packagePattern: "acme.api.*", directory: "public-schemas", flags: []string{"disallow_additional_properties"}}, route)
+
+	// Invalid routes are rejected:
+	_, err = parseOutputRoute("acme.api.*")
+	assert.Error(t, err)
+	_, err = parseOutputRoute("acme.api.*=public;no_such_flag")
+	assert.Error(t, err)
+}
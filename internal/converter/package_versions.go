@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+)
+
+const (
+	packageChannelKeyword = "x-api-channel"
+	packageVersionKeyword = "x-api-version"
+)
+
+// Versioned package segments look like "v1", "v2beta1" or "v1alpha" (see https://google.aip.dev/185):
+var packageVersionPattern = regexp.MustCompile(`^v[0-9]+(alpha|beta)?[0-9]*$`)
+
+// packageVersion is the API version (and release channel) embedded in a proto package name:
+type packageVersion struct {
+	version string
+	channel string
+}
+
+// parsePackageVersion finds the (last) versioned segment of a package name (eg "v2beta1" in "acme.orders.v2beta1"):
+func parsePackageVersion(pkgName string) (packageVersion, bool) {
+	segments := strings.Split(pkgName, ".")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if matches := packageVersionPattern.FindStringSubmatch(segments[i]); matches != nil {
+			channel := matches[1]
+			if channel == "" {
+				channel = stabilityStable
+			}
+			return packageVersion{version: segments[i], channel: channel}, true
+		}
+	}
+	return packageVersion{}, false
+}
+
+// versionedSchemaFilename puts schemas into a directory for their package version (eg "v1/Order.json"):
+func (c *Converter) versionedSchemaFilename(pkgName, schemaFilename string) string {
+	if !c.Flags.PackageVersions || c.Flags.PrefixSchemaFilesWithPackage {
+		return schemaFilename // Package directories already include the version
+	}
+	if version, ok := parsePackageVersion(pkgName); ok {
+		return path.Join(version.version, schemaFilename)
+	}
+	return schemaFilename
+}
+
+// embedPackageVersion adds the package version to the title and keywords of a top-level schema
+// (along with an ID, if we've been given a base URL to build one from):
+func (c *Converter) embedPackageVersion(pkgName, jsonSchemaFileName string, jsonSchemaType *jsonschema.Type, definitions jsonschema.Definitions) {
+	if !c.Flags.PackageVersions {
+		return
+	}
+	version, ok := parsePackageVersion(pkgName)
+	if !ok {
+		return
+	}
+
+	// The title lives on the root definition (unless the schema doesn't have one), defaulting to the definition name:
+	titledJSONSchemaType := jsonSchemaType
+	definitionName := strings.TrimPrefix(jsonSchemaType.Ref, c.refPrefix)
+	if definition, ok := definitions[definitionName]; ok {
+		titledJSONSchemaType = definition
+		if titledJSONSchemaType.Title == "" {
+			titledJSONSchemaType.Title = definitionName
+		}
+	}
+	if titledJSONSchemaType.Title != "" {
+		titledJSONSchemaType.Title = fmt.Sprintf("%s (%s)", titledJSONSchemaType.Title, version.version)
+	}
+
+	setExtras(jsonSchemaType, map[string]interface{}{
+		packageChannelKeyword: version.channel,
+		packageVersionKeyword: version.version,
+	})
+
+	// Schemas can be identified by where they are published (under the base we were given):
+	if c.schemaIDBase != "" {
+		setSchemaID(jsonSchemaType, fmt.Sprintf("%s/%s", strings.TrimSuffix(c.schemaIDBase, "/"), jsonSchemaFileName))
+	}
+}
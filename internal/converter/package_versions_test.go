@@ -0,0 +1,22 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePackageVersion(t *testing.T) {
+	for pkgName, expected := range map[string]packageVersion{
+		"acme.orders.v1":       {version: "v1", channel: "stable"},
+		"acme.orders.v2beta1":  {version: "v2beta1", channel: "beta"},
+		"acme.v1alpha.orders":  {version: "v1alpha", channel: "alpha"},
+		"acme.v1.internal.v3":  {version: "v3", channel: "stable"},
+		"acme.orders.version2": {},
+		"acme.orders":          {},
+	} {
+		version, ok := parsePackageVersion(pkgName)
+		assert.Equal(t, expected.version != "", ok, pkgName)
+		assert.Equal(t, expected, version, pkgName)
+	}
+}
@@ -0,0 +1,91 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// postProcessSchema pipes a generated schema through the user-specified post-processing command (if there is one):
+func (c *Converter) postProcessSchema(jsonSchemaFileName string, jsonSchemaJSON []byte) ([]byte, error) {
+	if c.postProcessCommand == "" {
+		return jsonSchemaJSON, nil
+	}
+
+	c.logger.WithField("jsonschema_filename", jsonSchemaFileName).WithField("command", c.postProcessCommand).Debug("Post-processing JSON-schema")
+
+	// Run the command directly (not through a shell, which Windows doesn't have), feeding the schema in on STDIN:
+	commandArgs, err := splitCommand(c.postProcessCommand)
+	if err != nil {
+		return nil, err
+	}
+	if len(commandArgs) == 0 {
+		return jsonSchemaJSON, nil
+	}
+	stdoutBuf := bytes.Buffer{}
+	stderrBuf := bytes.Buffer{}
+	cmd := exec.Command(commandArgs[0], commandArgs[1:]...)
+	cmd.Env = append(os.Environ(), "JSONSCHEMA_FILENAME="+jsonSchemaFileName)
+	cmd.Stdin = bytes.NewReader(jsonSchemaJSON)
+	cmd.Stdout = &stdoutBuf
+	cmd.Stderr = &stderrBuf
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("post-processing command (%s) failed for %s: %v: %s", c.postProcessCommand, jsonSchemaFileName, err, strings.TrimSpace(stderrBuf.String()))
+	}
+
+	// Leave the output as it is, apart from ending it the way the schema ended (commands like jq add a newline):
+	output := bytes.TrimRight(stdoutBuf.Bytes(), "\r\n")
+	if bytes.HasSuffix(jsonSchemaJSON, []byte("\n")) {
+		output = append(output, '\n')
+	}
+	return output, nil
+}
+
+// splitCommand splits a command up into its arguments (on whitespace). Arguments can be single-quoted (taken literally) or
+// double-quoted (with backslashes escaping quotes or backslashes inside them), eg `jq -c '.a, .b'`. Backslashes anywhere
+// else are left alone (so Windows paths don't need quoting):
+func splitCommand(command string) ([]string, error) {
+	var args []string
+	var arg strings.Builder
+	var escaped, inArg bool
+	var quote rune
+
+	for _, r := range command {
+		switch {
+		case escaped:
+			arg.WriteRune(r)
+			escaped = false
+		case quote == '\'' && r == '\'':
+			quote = 0
+		case quote == '\'':
+			arg.WriteRune(r)
+		case quote == '"' && r == '\\':
+			escaped = true
+		case quote == '"' && r == '"':
+			quote = 0
+		case quote == '"':
+			arg.WriteRune(r)
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, arg.String())
+			}
+			arg.Reset()
+			inArg = false
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+
+	if quote != 0 {
+		return args, fmt.Errorf("unterminated quote in post-processing command: %s", command)
+	}
+	return args, nil
+}
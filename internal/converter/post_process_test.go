@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostProcessSchema(t *testing.T) {
+
+	// Without a command the schema is passed through untouched:
+	protoConverter := New(newTestLogger())
+	output, err := protoConverter.postProcessSchema("Test.json", []byte(`{"type": "object"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type": "object"}`, string(output))
+
+	// Commands are given the schema on STDIN, and their STDOUT is used instead:
+	protoConverter.parseGeneratorParameters("post_process_cmd=sed 's/\"object\"/\"a string\"/'")
+	output, err = protoConverter.postProcessSchema("Test.json", []byte("{\n    \"type\": \"object\"\n}\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n    \"type\": \"a string\"\n}\n", string(output))
+
+	// Which ends the way the schema did:
+	protoConverter.parseGeneratorParameters("post_process_cmd=echo {}")
+	output, err = protoConverter.postProcessSchema("Test.json", []byte(`{"type": "object"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(output))
+	protoConverter.parseGeneratorParameters("post_process_cmd=printf {}")
+	output, err = protoConverter.postProcessSchema("Test.json", []byte("{\"type\": \"object\"}\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "{}\n", string(output))
+
+	// Failing commands fail the generation:
+	protoConverter.parseGeneratorParameters("post_process_cmd=cat /nonexistent/schema.json")
+	_, err = protoConverter.postProcessSchema("Test.json", []byte(`{"type": "object"}`))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "/nonexistent/schema.json")
+}
+
+func TestSplitCommand(t *testing.T) {
+
+	// Arguments are split on whitespace, and can be quoted:
+	args, err := splitCommand(`jq -c  '.a, .b' "say \"hi\"" C:\tools\jq.exe ''`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"jq", "-c", ".a, .b", `say "hi"`, `C:\tools\jq.exe`, ""}, args)
+
+	// Unterminated quotes are reported:
+	_, err = splitCommand(`jq -c '.a`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,65 @@
+package converter
+
+import "sort"
+
+// legacyOutputParameters reproduce exactly the output of older releases (messages as $ref'd definitions, enums of names and
+// numbers, additionalProperties allowed, 64-bit integers as plain strings, non-nullable wrappers and Values, only the
+// well-known types they knew, untyped enum items, unconstrained map keys and no depth limit), so that consumers can
+// upgrade without schema churn. Whenever a default changes, these get whatever parameters bring the old behaviour back
+// (and TestLegacyOutputPreset checks them against the schemas those releases generated):
+var legacyOutputParameters = []string{"max_depth=0", "list_style=array", "bigints_as_plain_strings", "binary_encoding", "legacy_well_known_types", "non_canonical_json", "non_nullable_values", "non_nullable_wrappers", "unconstrained_map_keys", "untyped_enum_items"}
+
+// presets bundle coherent sets of generator parameters for the ecosystems schemas get used in (eg "preset=openapi3"):
+var presets = map[string][]string{
+
+	// Ajv (in strict mode) defaults to draft-07, and is happiest with tight schemas of protojson's field names:
+	"ajv-strict": {"schema_version=draft-07", "disallow_additional_properties", "enforce_oneof", "json_fieldnames", "omit_empty"},
+
+	// Route schemas for the validators built into Node HTTP frameworks (Fastify, and Ajv middleware for Express), which
+	// default to draft-07 and coerce types (eg of query strings) to those listed in type arrays. Schemas only ever refer to
+	// their own definitions (unless messages are shared), so they can be dropped straight into route definitions:
+	"fastify": {"schema_version=draft-07", "type_arrays", "json_fieldnames"},
+
+	// How schemas used to be generated (the same as legacy_output):
+	"legacy": legacyOutputParameters,
+
+	// Exactly the output of older releases:
+	"legacy_output": legacyOutputParameters,
+
+	// OpenAPI 3.0 documents (which expect enums of one type, and the JSON field names):
+	"openapi3": {"schema_version=openapi3", "json_fieldnames", "enums_as_strings_only", "omit_empty"},
+
+	// Accept exactly what protojson accepts (either field name, nulls for unset fields, at most one field of each oneof, and
+	// no unknown fields):
+	"protojson-faithful": {"proto_and_json_fieldnames", "allow_null_values", "enforce_oneof", "unknown_fields=reject", "faithful_protojson"},
+}
+
+// presetNames lists the presets, in name order:
+func presetNames() []string {
+	var names []string
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// expandPresets swaps any "preset=<name>" parameters for the parameters they bundle. These go before everything else, so
+// that parameters given explicitly (eg "preset=openapi3,schema_version=2020-12") take precedence:
+func (c *Converter) expandPresets(parameters []string) []string {
+	var presetParameters, otherParameters []string
+	for _, parameter := range parameters {
+		name, ok := parameterValue(parameter, "preset")
+		if !ok {
+			otherParameters = append(otherParameters, parameter)
+			continue
+		}
+		preset, ok := presets[name]
+		if !ok {
+			c.logger.WithField("preset", name).WithField("presets", presetNames()).Warn("Ignoring unknown preset")
+			continue
+		}
+		presetParameters = append(presetParameters, preset...)
+	}
+	return append(presetParameters, otherParameters...)
+}
@@ -0,0 +1,140 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/chrusty/protoc-gen-jsonschema/internal/converter/testdata"
+)
+
+func TestPresets(t *testing.T) {
+
+	// Every preset only bundles parameters we understand:
+	for name, parameters := range presets {
+		for _, parameter := range parameters {
+			protoConverter := New(newTestLogger())
+			if protoConverter.Flags.set(parameter, true) {
+				continue
+			}
+			valueName := strings.SplitN(parameter, "=", 2)[0]
+			assert.Contains(t, []string{"list_style", "max_depth", "schema_version", "unknown_fields"}, valueName, "%s: %s", name, parameter)
+		}
+	}
+
+	// Presets are expanded ahead of everything else (and unknown ones are ignored):
+	protoConverter := New(newTestLogger())
+	assert.Equal(t,
+		[]string{"schema_version=openapi3", "json_fieldnames", "enums_as_strings_only", "omit_empty", "schema_version=2020-12", "allow_null_values"},
+		protoConverter.expandPresets([]string{"schema_version=2020-12", "preset=openapi3", "allow_null_values", "preset=nonsense"}),
+	)
+
+	// So they turn on their flags, but explicit parameters take precedence:
+	protoConverter.parseGeneratorParameters("schema_version=2020-12,preset=openapi3")
+	assert.True(t, protoConverter.Flags.UseJSONFieldnamesOnly)
+	assert.True(t, protoConverter.Flags.EnumsAsStringsOnly)
+	assert.True(t, protoConverter.Flags.OmitEmpty)
+	assert.Equal(t, "2020-12", protoConverter.targetSchemaVersion)
+
+	protoConverter = New(newTestLogger())
+	protoConverter.parseGeneratorParameters("preset=protojson-faithful")
+	assert.True(t, protoConverter.Flags.UseProtoAndJSONFieldNames)
+	assert.True(t, protoConverter.Flags.AllowNullValues)
+	assert.True(t, protoConverter.Flags.EnforceOneOf)
+	assert.Equal(t, unknownFieldsReject, protoConverter.unknownFieldsPolicy)
+	assert.True(t, protoConverter.Flags.FaithfulProtoJSON)
+
+	// Legacy brings back the older output (just as legacy_output does), and presets leave the logging alone (only log_level
+	// changes it):
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	protoConverter = New(logger)
+	assert.Equal(t, protoConverter.expandPresets([]string{"preset=legacy_output"}), protoConverter.expandPresets([]string{"preset=legacy"}))
+	protoConverter.parseGeneratorParameters("preset=legacy")
+	assert.True(t, protoConverter.Flags.NonCanonicalJSON)
+	assert.True(t, protoConverter.Flags.BigIntsAsPlainStrings)
+	assert.False(t, protoConverter.Flags.WellKnownTypesAsMessages)
+	assert.Equal(t, logrus.ErrorLevel, protoConverter.logger.GetLevel())
+
+	// Older releases didn't truncate deeply nested schemas either:
+	protoConverter = New(newTestLogger())
+	protoConverter.parseGeneratorParameters("preset=legacy_output")
+	assert.Equal(t, 0, protoConverter.maxDepth)
+	assert.True(t, protoConverter.Flags.NonCanonicalJSON)
+}
+
+func TestLegacyOutputPreset(t *testing.T) {
+
+	// The sample protos which older releases had (plus WellKnownTypes, which they could convert too), and the schemas they
+	// generated wherever those have changed since:
+	legacySchemas := map[string][]string{
+		"AllRequired": nil, "ArrayOfMessages": nil, "ArrayOfObjects": nil, "BigIntAsString": nil, "Comments": nil,
+		"CyclicalReference": nil, "EnumNestedReference": nil, "EnumWithMessage": nil, "EnumCeption": nil,
+		"GoogleInt64ValueAllowNull": nil, "GoogleInt64ValueDisallowStringAllowNull": nil, "ImportedEnum": nil, "Maps": nil,
+		"NestedMessage": nil, "NestedObject": nil, "NoPackage": nil, "OneOf": nil, "OptionAllowNullValues": nil,
+		"OptionDisallowAdditionalProperties": nil, "OptionEnumsAsConstants": nil, "OptionEnumsAsStringsOnly": nil,
+		"OptionEnumsTrimPrefix": nil, "OptionFileExtension": nil, "OptionIgnoredEnum": nil, "OptionIgnoredFile": nil,
+		"OptionIgnoredField": nil, "OptionIgnoredMessage": nil, "OptionRequiredField": nil, "OptionMinLength": nil,
+		"OptionMaxLength": nil, "OptionPattern": nil, "OptionRequiredMessage": nil, "PackagePrefix": nil,
+		"PayloadMessage": nil, "Proto2NestedMessage": nil, "Proto2NestedObject": nil, "Proto2Required": nil,
+		"SelfReference": nil, "SeveralEnums": nil, "SeveralMessages": nil, "Timestamp": nil,
+		"ArrayOfEnums":                   {testdata.LegacyArrayOfEnums},
+		"ArrayOfPrimitives":              {testdata.LegacyArrayOfPrimitives},
+		"ArrayOfPrimitivesDouble":        {testdata.LegacyArrayOfPrimitivesDouble},
+		"BytesPayload":                   {testdata.LegacyBytesPayload},
+		"GoogleInt64Value":               {testdata.LegacyGoogleInt64Value},
+		"GoogleInt64ValueDisallowString": {testdata.LegacyGoogleInt64ValueDisallowString},
+		"GoogleValue":                    {testdata.LegacyGoogleValue},
+		"JSONFields":                     {testdata.LegacyJSONFields},
+		"TargetedMessages":               {testdata.MessageKind10, testdata.MessageKind11, testdata.LegacyMessageKind12},
+		"WellKnown":                      {testdata.LegacyWellKnown},
+		"WellKnownTypes":                 {testdata.LegacyWellKnownTypes},
+
+		// Left out: ValidationOptions (whose proto has grown since).
+	}
+
+	// Every one of them converts to exactly the schemas older releases generated with the preset:
+	sampleProtos := configureSampleProtos()
+	for name, expectedJSONSchemas := range legacySchemas {
+		sampleProto, ok := sampleProtos[name]
+		require.True(t, ok, name)
+		if expectedJSONSchemas == nil {
+			expectedJSONSchemas = sampleProto.ExpectedJSONSchema
+		}
+		t.Run(name, func(t *testing.T) {
+			parameters := "preset=legacy_output"
+			if len(sampleProto.TargetedMessages) > 0 {
+				parameters += fmt.Sprintf(",messages=[%s]", strings.Join(sampleProto.TargetedMessages, messageDelimiter))
+			}
+			protoConverter := New(newTestLogger())
+			protoConverter.Flags = sampleProto.Flags
+			response, err := protoConverter.convert(&plugin.CodeGeneratorRequest{
+				FileToGenerate: sampleProto.FilesToGenerate,
+				Parameter:      proto.String(parameters),
+				ProtoFile:      mustReadProtoFiles(t, sampleProtoDirectory, sampleProto.ProtoFileName).GetFile(),
+			})
+			require.NoError(t, err)
+			require.Len(t, response.File, len(expectedJSONSchemas))
+			for responseFileIndex, responseFile := range response.File {
+				expectedJSONSchema, content := expectedJSONSchemas[responseFileIndex], responseFile.GetContent()
+
+				// The goldens shared with TestGenerateJsonSchema are canonical, so only the Legacy ones (which are kept in the
+				// order older releases generated them in) check the order of keywords too:
+				if responseFileIndex < len(sampleProto.ExpectedJSONSchema) && expectedJSONSchema == sampleProto.ExpectedJSONSchema[responseFileIndex] {
+					canonical, err := canonicalJSON([]byte(content))
+					require.NoError(t, err)
+					content = string(canonical)
+				} else {
+					expectedJSONSchema = strings.TrimSpace(expectedJSONSchema)
+				}
+				assert.Equal(t, expectedJSONSchema, content)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	plugin "google.golang.org/protobuf/types/pluginpb"
 )
 
 // ProtoPackage describes a package of Protobuf, which is an container of message types.
@@ -30,9 +31,29 @@ func newProtoPackage(parent *ProtoPackage, name string) *ProtoPackage {
 	}
 }
 
+// qualifiedName is the fully-qualified name of a message or enum (without a leading dot), which is just its name for
+// files without a package:
+func qualifiedName(pkgName, name string) string {
+	if pkgName == "" {
+		return name
+	}
+	return pkgName + "." + name
+}
+
 func (c *Converter) lookupType(pkg *ProtoPackage, name string) (*descriptor.DescriptorProto, string, bool) {
 	if strings.HasPrefix(name, ".") {
-		return c.relativelyLookupType(globalPkg, name[1:])
+
+		// Fully-qualified names get looked up over and over again (for every field of that type), so we remember them:
+		if desc, pkgName, ok := c.resolvedTypes.get(name); ok {
+			if desc, ok := desc.(*descriptor.DescriptorProto); ok {
+				return desc, pkgName, true
+			}
+		}
+		desc, pkgName, ok := c.relativelyLookupType(c.rootPkg, name[1:])
+		if ok {
+			c.resolvedTypes.add(name, desc, pkgName)
+		}
+		return desc, pkgName, ok
 	}
 
 	for ; pkg != nil; pkg = pkg.parent {
@@ -45,7 +66,16 @@ func (c *Converter) lookupType(pkg *ProtoPackage, name string) (*descriptor.Desc
 
 func (c *Converter) lookupEnum(pkg *ProtoPackage, name string) (*descriptor.EnumDescriptorProto, string, bool) {
 	if strings.HasPrefix(name, ".") {
-		return c.relativelyLookupEnum(globalPkg, name[1:])
+		if desc, pkgName, ok := c.resolvedTypes.get(name); ok {
+			if desc, ok := desc.(*descriptor.EnumDescriptorProto); ok {
+				return desc, pkgName, true
+			}
+		}
+		desc, pkgName, ok := c.relativelyLookupEnum(c.rootPkg, name[1:])
+		if ok {
+			c.resolvedTypes.add(name, desc, pkgName)
+		}
+		return desc, pkgName, ok
 	}
 
 	for ; pkg != nil; pkg = pkg.parent {
@@ -154,7 +184,12 @@ func (c *Converter) relativelyLookupNestedEnum(desc *descriptor.DescriptorProto,
 	return nil, false
 }
 
+// relativelyLookupPackage finds a package by name (relative to another one, usually the root). The empty package is
+// the root itself:
 func (c *Converter) relativelyLookupPackage(pkg *ProtoPackage, name string) (*ProtoPackage, bool) {
+	if name == "" {
+		return pkg, true
+	}
 	components := strings.Split(name, ".")
 	for _, c := range components {
 		var ok bool
@@ -165,3 +200,28 @@ func (c *Converter) relativelyLookupPackage(pkg *ProtoPackage, name string) (*Pr
 	}
 	return pkg, true
 }
+
+// reachableFiles lists the files being generated, along with everything they (transitively) import. Nothing else can be
+// referred to, so there's no need to register the types of any other files we've been given (eg from a large descriptor set):
+func reachableFiles(request *plugin.CodeGeneratorRequest) map[string]bool {
+	dependencies := make(map[string][]string)
+	for _, fileDesc := range request.GetProtoFile() {
+		dependencies[fileDesc.GetName()] = fileDesc.GetDependency()
+	}
+
+	reachable := make(map[string]bool)
+	var reach func(fileName string)
+	reach = func(fileName string) {
+		if reachable[fileName] {
+			return
+		}
+		reachable[fileName] = true
+		for _, dependency := range dependencies[fileName] {
+			reach(dependency)
+		}
+	}
+	for _, fileName := range request.GetFileToGenerate() {
+		reach(fileName)
+	}
+	return reachable
+}
@@ -0,0 +1,22 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQualifiedName(t *testing.T) {
+	assert.Equal(t, "acme.v1.Order", qualifiedName("acme.v1", "Order"))
+	assert.Equal(t, "Order", qualifiedName("", "Order"))
+}
+
+func TestEmptyPackageLeftAlone(t *testing.T) {
+
+	// The request isn't given a made-up package:
+	fileDescriptorSet := mustReadProtoFiles(t, sampleProtoDirectory, "NoPackage.proto")
+	_, err := convertTestRequest(fileRequest("NoPackage.proto", "", fileDescriptorSet.GetFile()...))
+	require.NoError(t, err)
+	assert.Nil(t, fileDescriptorSet.GetFile()[0].Package)
+}
@@ -0,0 +1,213 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ConvertMessage converts a single message (described by a protoreflect.MessageDescriptor) into a JSON-Schema (rendered
+// just as it would be in a file). This allows a schema to be generated at runtime for any message compiled into a Go binary:
+func (c *Converter) ConvertMessage(messageDescriptor protoreflect.MessageDescriptor) (json.RawMessage, error) {
+	defer c.startConversion(c.defaultParameters)()
+
+	pkg, msgDesc, err := c.lookupMessageDescriptor(messageDescriptor)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.WithField("msg_name", msgDesc.GetName()).WithField("package_name", messageDescriptor.ParentFile().Package()).Debug("Converting message descriptor")
+	messageJSONSchema, err := c.convertMessageType(pkg, msgDesc)
+	if err != nil {
+		return nil, err
+	}
+	return c.descriptorSchemaJSON(messageDescriptor.ParentFile(), messageJSONSchema)
+}
+
+// ConvertEnum converts a single enum (described by a protoreflect.EnumDescriptor) into a JSON-Schema:
+func (c *Converter) ConvertEnum(enumDescriptor protoreflect.EnumDescriptor) (json.RawMessage, error) {
+	defer c.startConversion(c.defaultParameters)()
+
+	pkg, err := c.registerFileDescriptors(enumDescriptor.ParentFile())
+	if err != nil {
+		return nil, err
+	}
+	enumDesc, _, ok := c.lookupEnum(pkg, relativeName(enumDescriptor))
+	if !ok {
+		return nil, fmt.Errorf("no such enum type named %s", enumDescriptor.FullName())
+	}
+
+	c.logger.WithField("enum_name", enumDesc.GetName()).WithField("package_name", enumDescriptor.ParentFile().Package()).Debug("Converting enum descriptor")
+	enumJSONSchemaType, err := c.convertEnumType(enumDesc, ConverterFlags{})
+	if err != nil {
+		return nil, err
+	}
+	enumJSONSchemaType.Version = c.schemaVersion
+	enumJSONSchema := &jsonschema.Schema{Type: &enumJSONSchemaType}
+	return c.descriptorSchemaJSON(enumDescriptor.ParentFile(), enumJSONSchema)
+}
+
+// ConvertField converts a single field (described by a protoreflect.FieldDescriptor) into a JSON-Schema, exactly as it
+// would be as a property of its message (eg to power a form widget for just that field). Any messages it refers to are
+// included as definitions:
+func (c *Converter) ConvertField(fieldDescriptor protoreflect.FieldDescriptor) (json.RawMessage, error) {
+	defer c.startConversion(c.defaultParameters)()
+
+	if fieldDescriptor.IsExtension() {
+		return nil, fmt.Errorf("%s is an extension, which can't be converted on its own", fieldDescriptor.FullName())
+	}
+	pkg, msgDesc, err := c.lookupMessageDescriptor(fieldDescriptor.ContainingMessage())
+	if err != nil {
+		return nil, err
+	}
+	var fieldDesc *descriptor.FieldDescriptorProto
+	for _, candidate := range msgDesc.GetField() {
+		if candidate.GetNumber() == int32(fieldDescriptor.Number()) {
+			fieldDesc = candidate
+		}
+	}
+	if fieldDesc == nil {
+		return nil, fmt.Errorf("no such field named %s", fieldDescriptor.FullName())
+	}
+
+	// Convert the message with nothing but this field (so that its options and the rest still apply), then take the field
+	// out of it. Oneofs and nested types are kept, as the field may refer to them:
+	fieldOnlyMsgDesc := &descriptor.DescriptorProto{
+		Name:       msgDesc.Name,
+		Field:      []*descriptor.FieldDescriptorProto{fieldDesc},
+		NestedType: msgDesc.GetNestedType(),
+		EnumType:   msgDesc.GetEnumType(),
+		OneofDecl:  msgDesc.GetOneofDecl(),
+		Options:    msgDesc.GetOptions(),
+	}
+	duplicatedMessages, err := c.findNestedMessages(pkg, fieldOnlyMsgDesc)
+	if err != nil {
+		return nil, err
+	}
+	delete(duplicatedMessages, fieldOnlyMsgDesc)
+
+	definitions := jsonschema.Definitions{}
+	c.enumDefinitions = definitions
+	defer func() { c.enumDefinitions = nil }()
+	depths := c.definitionDepths(pkg, fieldOnlyMsgDesc)
+	defer func() { c.messageDepth = 0 }()
+	for refMsgDesc, name := range duplicatedMessages {
+		c.messageDepth = depths[refMsgDesc]
+		if c.isSharedMessage(name) || (c.maxDepth > 0 && c.messageDepth > c.maxDepth+1) {
+			continue
+		}
+		if definitions[name], err = c.convertMessageDefinition(pkg, refMsgDesc, duplicatedMessages); err != nil {
+			return nil, err
+		}
+	}
+
+	c.messageDepth = 0
+	c.logger.WithField("field_name", fieldDesc.GetName()).WithField("msg_name", msgDesc.GetName()).Debug("Converting field descriptor")
+	msgJSONSchemaType, err := c.recursiveConvertMessageType(pkg, fieldOnlyMsgDesc, "", duplicatedMessages, true)
+	if err != nil {
+		return nil, err
+	}
+	property, ok := msgJSONSchemaType.Properties.Get(c.fieldNames(fieldDesc)[0])
+	if !ok {
+		return nil, fmt.Errorf("%s is left out of schemas (eg it is ignored or excluded)", fieldDescriptor.FullName())
+	}
+	fieldJSONSchemaType := property.(*jsonschema.Type)
+	fieldJSONSchemaType.Version = c.schemaVersion
+	c.addTypedPayloadDefinitions(definitions, fieldJSONSchemaType)
+
+	fieldJSONSchema := &jsonschema.Schema{Type: fieldJSONSchemaType}
+	if len(definitions) > 0 {
+		fieldJSONSchema.Definitions = definitions
+	}
+	return c.descriptorSchemaJSON(fieldDescriptor.ParentFile(), fieldJSONSchema)
+}
+
+// descriptorSchemaJSON renders a schema converted from a descriptor just as it would be written to a file (for the draft
+// given by the "schema_version" parameter, which the typed model can't describe):
+func (c *Converter) descriptorSchemaJSON(file protoreflect.FileDescriptor, jsonSchema *jsonschema.Schema) (json.RawMessage, error) {
+	jsonSchemaJSON, err := json.MarshalIndent(jsonSchema, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+
+	if c.targetSchemaVersion != "" {
+		if jsonSchemaJSON, err = c.convertSchemaVersion(file.Path(), jsonSchemaJSON); err != nil {
+			c.logger.WithError(err).WithField("schema_version", c.targetSchemaVersion).Error("Failed to convert jsonSchema")
+			return nil, err
+		}
+	}
+
+	return c.generatedJSON(jsonSchemaJSON)
+}
+
+// registerFileDescriptors registers the messages and enums from a file and everything it imports (in a fresh tree of
+// packages), and returns the package of the file:
+func (c *Converter) registerFileDescriptors(file protoreflect.FileDescriptor) (*ProtoPackage, error) {
+
+	// Gather the file, along with everything it imports:
+	fileDescs := collectFileDescriptorProtos(file, make(map[string]bool))
+
+	// Get the source-code info (runtime descriptors often won't include any, but it is worth a try):
+	c.sourceInfo = newSourceCodeInfo(fileDescs)
+
+	// Register the messages and enums from every file:
+	c.rootPkg = newProtoPackage(nil, "")
+	c.resolvedTypes = newLookupCache(c.typeCacheSize)
+	c.definitionCache = nil
+	c.proto2Messages = make(map[*descriptor.DescriptorProto]bool)
+	for _, fileDesc := range fileDescs {
+		for _, msgDesc := range fileDesc.GetMessageType() {
+			c.registerType(fileDesc.GetPackage(), msgDesc)
+		}
+		c.markProto2Messages(fileDesc)
+		for _, en := range fileDesc.GetEnumType() {
+			c.registerEnum(fileDesc.GetPackage(), en)
+		}
+	}
+
+	pkgName := string(file.Package())
+	pkg, ok := c.relativelyLookupPackage(c.rootPkg, pkgName)
+	if !ok {
+		return nil, fmt.Errorf("no such package found: %s", pkgName)
+	}
+	return pkg, nil
+}
+
+// lookupMessageDescriptor registers the file defining a message, and finds the package and descriptor of the message:
+func (c *Converter) lookupMessageDescriptor(messageDescriptor protoreflect.MessageDescriptor) (*ProtoPackage, *descriptor.DescriptorProto, error) {
+	pkg, err := c.registerFileDescriptors(messageDescriptor.ParentFile())
+	if err != nil {
+		return nil, nil, err
+	}
+	msgDesc, _, ok := c.lookupType(pkg, relativeName(messageDescriptor))
+	if !ok {
+		return nil, nil, fmt.Errorf("no such message type named %s", messageDescriptor.FullName())
+	}
+	return pkg, msgDesc, nil
+}
+
+// relativeName is the name of a message or enum relative to its package (eg "Order.Status"):
+func relativeName(desc protoreflect.Descriptor) string {
+	return strings.TrimPrefix(string(desc.FullName()), string(desc.ParentFile().Package())+".")
+}
+
+// collectFileDescriptorProtos returns FileDescriptorProtos for a file and its (transitive) imports, dependencies first:
+func collectFileDescriptorProtos(file protoreflect.FileDescriptor, seen map[string]bool) []*descriptor.FileDescriptorProto {
+	if seen[file.Path()] {
+		return nil
+	}
+	seen[file.Path()] = true
+
+	var fileDescs []*descriptor.FileDescriptorProto
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		fileDescs = append(fileDescs, collectFileDescriptorProtos(imports.Get(i).FileDescriptor, seen)...)
+	}
+
+	return append(fileDescs, protodesc.ToFileDescriptorProto(file))
+}
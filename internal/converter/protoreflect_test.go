@@ -0,0 +1,110 @@
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xeipuuv/gojsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+func TestConvertMessage(t *testing.T) {
+
+	// Convert one of our own option messages (which is compiled into this binary):
+	protoConverter := New(newTestLogger())
+	schemaJSON, err := protoConverter.ConvertMessage((&protoc_gen_jsonschema.MessageOptions{}).ProtoReflect().Descriptor())
+	assert.NoError(t, err)
+	assert.Contains(t, string(schemaJSON), `"$ref": "#/definitions/MessageOptions"`)
+
+	// Make sure the generated schema validates the JSON we'd expect:
+	valid, err := validateSchema(string(schemaJSON), `{"ignore": true, "enums_as_constants": false}`)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	valid, err = validateSchema(string(schemaJSON), `{"ignore": 12345}`)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// The parameters given as options apply too:
+	protoConverter = NewWithOptions(newTestLogger(), ConvertOptions{Parameters: "schema_version=draft-07,disallow_additional_properties"})
+	schemaJSON, err = protoConverter.ConvertMessage((&protoc_gen_jsonschema.MessageOptions{}).ProtoReflect().Descriptor())
+	require.NoError(t, err)
+	assert.Contains(t, string(schemaJSON), `"$schema": "`+versionDraft07+`"`)
+	valid, err = validateSchema(string(schemaJSON), `{"ignore": true, "unknown": 12345}`)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// Including drafts whose keywords the typed model doesn't have:
+	protoConverter = NewWithOptions(newTestLogger(), ConvertOptions{Parameters: "schema_version=2020-12"})
+	schemaJSON, err = protoConverter.ConvertMessage((&protoc_gen_jsonschema.MessageOptions{}).ProtoReflect().Descriptor())
+	require.NoError(t, err)
+	assert.Contains(t, string(schemaJSON), `"$ref": "#/$defs/MessageOptions"`)
+	assert.NotContains(t, string(schemaJSON), `"definitions"`)
+}
+
+func TestConvertEnum(t *testing.T) {
+
+	// Convert an enum which is compiled into this binary:
+	schemaJSON, err := New(newTestLogger()).ConvertEnum(descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Descriptor())
+	require.NoError(t, err)
+	var schema struct{ Enum []interface{} }
+	require.NoError(t, json.Unmarshal(schemaJSON, &schema))
+	assert.Equal(t, []interface{}{"LABEL_OPTIONAL", 1.0, "LABEL_REQUIRED", 2.0, "LABEL_REPEATED", 3.0}, schema.Enum)
+	valid, err := validateSchema(string(schemaJSON), `"LABEL_REPEATED"`)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	valid, err = validateSchema(string(schemaJSON), `"LABEL_SOMETIMES"`)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// The parameters given as options apply too:
+	protoConverter := NewWithOptions(newTestLogger(), ConvertOptions{Parameters: "schema_version=draft-07,enums_as_strings_only"})
+	schemaJSON, err = protoConverter.ConvertEnum(descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Descriptor())
+	require.NoError(t, err)
+	assert.Contains(t, string(schemaJSON), `"$schema": "`+versionDraft07+`"`)
+	valid, err = validateSchema(string(schemaJSON), `3`)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestConvertField(t *testing.T) {
+
+	protoConverter := New(newTestLogger())
+
+	// Fields are converted just as they would be as properties of their messages:
+	fields := (&descriptor.FieldDescriptorProto{}).ProtoReflect().Descriptor().Fields()
+	schemaJSON, err := protoConverter.ConvertField(fields.ByName("name"))
+	require.NoError(t, err)
+	var schema struct {
+		Schema string `json:"$schema"`
+		Type   string
+	}
+	require.NoError(t, json.Unmarshal(schemaJSON, &schema))
+	assert.Equal(t, gojsonschema.TYPE_STRING, schema.Type)
+	assert.Equal(t, versionDraft04, schema.Schema)
+
+	schemaJSON, err = protoConverter.ConvertField(fields.ByName("options"))
+	require.NoError(t, err)
+	valid, err := validateSchema(string(schemaJSON), `{"deprecated": true, "jstype": "JS_STRING"}`)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	valid, err = validateSchema(string(schemaJSON), `{"deprecated": "yes"}`)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// The parameters given as options apply too:
+	protoConverter = NewWithOptions(newTestLogger(), ConvertOptions{Parameters: "schema_version=draft-07,disallow_additional_properties"})
+	schemaJSON, err = protoConverter.ConvertField(fields.ByName("options"))
+	require.NoError(t, err)
+	assert.Contains(t, string(schemaJSON), `"$schema": "`+versionDraft07+`"`)
+	valid, err = validateSchema(string(schemaJSON), `{"deprecated": true, "unknown": 12345}`)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// Extensions don't belong to the messages they extend:
+	_, err = protoConverter.ConvertField(protoc_gen_jsonschema.E_FieldOptions.TypeDescriptor())
+	assert.Error(t, err)
+}
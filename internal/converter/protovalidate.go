@@ -0,0 +1,81 @@
+package converter
+
+import (
+	"github.com/alecthomas/jsonschema"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	protovalidateFieldExtension = "buf.validate.field"
+	protovalidateProtoFile      = "buf/validate/validate.proto"
+)
+
+// usesProtovalidate tells us whether protovalidate's options are among the proto files we've been given
+// (we don't depend on protovalidate, so its options get decoded with the extensions found in the request):
+func usesProtovalidate(fileDescs []*descriptor.FileDescriptorProto) bool {
+	for _, fileDesc := range fileDescs {
+		if fileDesc.GetName() == protovalidateProtoFile {
+			return true
+		}
+	}
+	return false
+}
+
+// protovalidateExamples returns the example values of a field's protovalidate rules
+// (eg `[(buf.validate.field).string.example = "jane@example.com"]`):
+func (c *Converter) protovalidateExamples(desc *descriptor.FieldDescriptorProto) []interface{} {
+	if c.extensionTypes == nil || desc.GetOptions() == nil {
+		return nil
+	}
+	extensionType, err := c.extensionTypes.FindExtensionByName(protovalidateFieldExtension)
+	if err != nil {
+		return nil
+	}
+
+	// The rules are an unknown field of the options until we decode them with the extension:
+	optionsBytes, err := proto.Marshal(desc.GetOptions())
+	if err != nil {
+		return nil
+	}
+	options := &descriptor.FieldOptions{}
+	if err := (proto.UnmarshalOptions{Resolver: c.extensionTypes}).Unmarshal(optionsBytes, options); err != nil {
+		c.logger.WithError(err).WithField("field_name", desc.GetName()).Debug("Unable to decode protovalidate rules")
+		return nil
+	}
+	if !options.ProtoReflect().Has(extensionType.TypeDescriptor()) {
+		return nil
+	}
+
+	// Examples belong to the rules for the type of the field (eg "string", "int32"):
+	fieldRules := options.ProtoReflect().Get(extensionType.TypeDescriptor()).Message()
+	typeOneof := fieldRules.Descriptor().Oneofs().ByName("type")
+	if typeOneof == nil {
+		return nil
+	}
+	typeField := fieldRules.WhichOneof(typeOneof)
+	if typeField == nil || typeField.Message() == nil {
+		return nil
+	}
+	rules := fieldRules.Get(typeField).Message()
+	exampleField := rules.Descriptor().Fields().ByName("example")
+	if exampleField == nil || !exampleField.IsList() || !rules.Has(exampleField) {
+		return nil
+	}
+
+	var examples []interface{}
+	for list, index := rules.Get(exampleField).List(), 0; index < list.Len(); index++ {
+		examples = append(examples, protoValueToJSON(exampleField, list.Get(index)))
+	}
+	return examples
+}
+
+// setProtovalidateExamples carries a field's protovalidate examples through to the "examples" keyword:
+func (c *Converter) setProtovalidateExamples(desc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type) {
+	examples := c.protovalidateExamples(desc)
+	if len(examples) == 0 {
+		return
+	}
+	jsonSchemaType.Examples = append(jsonSchemaType.Examples, examples...)
+	c.schemaVersion = versionDraft06 // Examples require draft-06
+}
@@ -0,0 +1,320 @@
+package converter
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	defaultPublishBackoff = 500 * time.Millisecond
+	defaultPublishRetries = 4
+	defaultS3Region       = "us-east-1"
+	envAWSEndpoint        = "AWS_ENDPOINT_URL"
+	envGCSAccessToken     = "GOOGLE_OAUTH_ACCESS_TOKEN"
+	envGCSEmulatorHost    = "STORAGE_EMULATOR_HOST"
+	envPublishToken       = "JSONSCHEMA_PUBLISH_TOKEN"
+	envS3Endpoint         = "AWS_ENDPOINT_URL_S3"
+	maxPublishBackoff     = 30 * time.Second
+	publishContentType    = "application/schema+json"
+	publishTimeout        = 30 * time.Second
+)
+
+// publishFailure is an upload which failed, but which may succeed if it's tried again:
+type publishFailure struct {
+	message    string
+	retryable  bool
+	retryAfter time.Duration // How long the target asked us to wait (with a Retry-After header)
+}
+
+func (f *publishFailure) Error() string {
+	return f.message
+}
+
+// publishLimiter spaces requests out (to at most a number per second), across every upload in progress:
+type publishLimiter struct {
+	interval time.Duration
+	mutex    sync.Mutex
+	next     time.Time
+}
+
+func newPublishLimiter(rate float64) *publishLimiter {
+	if rate <= 0 {
+		return &publishLimiter{}
+	}
+	return &publishLimiter{interval: time.Duration(float64(time.Second) / rate)}
+}
+
+// wait blocks until the next request is allowed:
+func (l *publishLimiter) wait() {
+	if l.interval == 0 {
+		return
+	}
+	l.mutex.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mutex.Unlock()
+	time.Sleep(delay)
+}
+
+// publishSchemas uploads generated schemas to the configured target (HTTP(S) PUT, S3, or GCS). They go up in batches (in
+// order, with the schemas within each batch uploaded in parallel), at a limited rate, retrying failed uploads with
+// exponential backoff. Progress is recorded in the state file (if we have one) after every batch:
+func (c *Converter) publishSchemas(files []*plugin.CodeGeneratorResponse_File) error {
+	target, err := url.Parse(c.publishURL)
+	if err != nil {
+		return fmt.Errorf("invalid publish_url (%s): %v", c.publishURL, err)
+	}
+	switch target.Scheme {
+	case "http", "https", "s3", "gs":
+	default:
+		return fmt.Errorf("unsupported publish_url scheme: %s", target.Scheme)
+	}
+
+	state, err := loadPublishState(c.publishStateFileName)
+	if err != nil {
+		return fmt.Errorf("unable to load publish state from %s: %v", c.publishStateFileName, err)
+	}
+
+	client := &http.Client{Timeout: publishTimeout}
+	limiter := newPublishLimiter(c.publishRate)
+	batchSize := c.publishBatchSize
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	for start := 0; start < len(files); start += batchSize {
+		end := start + batchSize
+		if end > len(files) {
+			end = len(files)
+		}
+
+		errs := make([]error, end-start)
+		var waitGroup sync.WaitGroup
+		for i, file := range files[start:end] {
+			content := []byte(file.GetContent())
+			objectPath := c.publishPath(target.Path, file.GetName(), content)
+			destination := fmt.Sprintf("%s://%s%s", target.Scheme, target.Host, objectPath)
+			digest := sha256.Sum256(content)
+
+			// Anything published by an earlier (interrupted) run is left as it is:
+			if state.published(destination, hex.EncodeToString(digest[:])) {
+				c.logger.WithField("jsonschema_filename", file.GetName()).WithField("destination", destination).Debug("Already published")
+				continue
+			}
+
+			waitGroup.Add(1)
+			go func(i int, fileName string) {
+				defer waitGroup.Done()
+				if errs[i] = c.publishFile(client, limiter, target, fileName, objectPath, content); errs[i] == nil {
+					state.record(destination, hex.EncodeToString(digest[:]))
+				}
+			}(i, file.GetName())
+		}
+		waitGroup.Wait()
+
+		// Save our progress (even if some of the batch failed), so that a failed publish can be resumed:
+		if c.publishStateFileName != "" {
+			if err := state.save(c.publishStateFileName); err != nil {
+				return fmt.Errorf("unable to save publish state to %s: %v", c.publishStateFileName, err)
+			}
+		}
+		for _, err := range errs {
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// publishFile uploads a schema, retrying (with exponential backoff) if that fails in a way which might not last:
+func (c *Converter) publishFile(client *http.Client, limiter *publishLimiter, target *url.URL, fileName, objectPath string, content []byte) error {
+	for attempt := 0; ; attempt++ {
+		limiter.wait()
+		err := c.putObject(client, target, fileName, objectPath, content)
+		failure, ok := err.(*publishFailure)
+		if err == nil || !ok || !failure.retryable || attempt >= c.publishRetries {
+			return err
+		}
+
+		delay := c.publishBackoff << uint(attempt)
+		if delay > maxPublishBackoff || delay <= 0 {
+			delay = maxPublishBackoff
+		}
+		if failure.retryAfter > delay {
+			delay = failure.retryAfter
+		}
+		c.logger.WithError(err).WithField("jsonschema_filename", fileName).WithField("delay", delay).Warn("Retrying publish")
+		time.Sleep(delay)
+	}
+}
+
+// putObject makes a single attempt at uploading a schema:
+func (c *Converter) putObject(client *http.Client, target *url.URL, fileName, objectPath string, content []byte) error {
+
+	// Build a request for the type of target we're publishing to:
+	var req *http.Request
+	var err error
+	switch target.Scheme {
+	case "http", "https":
+		req, err = newPublishRequest(fmt.Sprintf("%s://%s%s", target.Scheme, target.Host, objectPath), content)
+		if err != nil {
+			return err
+		}
+		if token := os.Getenv(envPublishToken); token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+	case "s3":
+		req, err = newS3PublishRequest(target.Host, strings.TrimPrefix(objectPath, "/"), content)
+		if err != nil {
+			return err
+		}
+
+	case "gs":
+		req, err = newGCSPublishRequest(target.Host, objectPath, content)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.logger.WithField("jsonschema_filename", fileName).WithField("url", req.URL.String()).Info("Publishing JSON-schema")
+	res, err := client.Do(req)
+	if err != nil {
+		return &publishFailure{message: fmt.Sprintf("failed to publish %s: %v", fileName, err), retryable: true}
+	}
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return &publishFailure{
+			message:    fmt.Sprintf("failed to publish %s: %s: %s", fileName, res.Status, strings.TrimSpace(string(body))),
+			retryable:  res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500,
+			retryAfter: parseRetryAfter(res.Header.Get("Retry-After")),
+		}
+	}
+	return nil
+}
+
+// parseRetryAfter reads a Retry-After header (which is either a number of seconds or a date):
+func parseRetryAfter(retryAfter string) time.Duration {
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
+
+// publishPath works out where a schema should be published to (versioned, content-addressed, or as-is):
+func (c *Converter) publishPath(prefix, fileName string, content []byte) string {
+	switch {
+	case c.publishVersion != "":
+		return path.Join("/", prefix, c.publishVersion, fileName)
+	case c.publishContentAddressed:
+		digest := sha256.Sum256(content)
+		return path.Join("/", prefix, hex.EncodeToString(digest[:]), fileName)
+	default:
+		return path.Join("/", prefix, fileName)
+	}
+}
+
+func newPublishRequest(objectURL string, content []byte) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodPut, objectURL, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", publishContentType)
+	return req, nil
+}
+
+// newS3PublishRequest prepares a PUT request for an S3 object, signed (AWS SigV4) with credentials from the environment.
+// An endpoint from the environment (eg for an S3-compatible store) is addressed path-style, with the bucket in the path:
+func newS3PublishRequest(bucket, key string, content []byte) (*http.Request, error) {
+	credentials := awsCredentials{
+		accessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if credentials.accessKeyID == "" || credentials.secretAccessKey == "" {
+		return nil, fmt.Errorf("publishing to S3 requires AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+	}
+
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	if region == "" {
+		region = defaultS3Region
+	}
+
+	objectURL := &url.URL{Scheme: "https", Host: fmt.Sprintf("%s.s3.%s.amazonaws.com", bucket, region), Path: "/" + key}
+	if endpoint := firstEnv(envS3Endpoint, envAWSEndpoint); endpoint != "" {
+		endpointURL, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid S3 endpoint %q: %v", endpoint, err)
+		}
+		objectURL = &url.URL{Scheme: endpointURL.Scheme, Host: endpointURL.Host, Path: path.Join("/", endpointURL.Path, bucket) + "/" + key}
+	}
+
+	// Send the path exactly as it is signed:
+	objectURL.RawPath = awsURIEncode(objectURL.Path, false)
+	req, err := newPublishRequest(objectURL.String(), content)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadHash := sha256.Sum256(content)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+	signAWSRequest(req, hex.EncodeToString(payloadHash[:]), credentials, region, "s3", time.Now())
+	return req, nil
+}
+
+// newGCSPublishRequest prepares a PUT request for a GCS object, with a bearer token from the environment (and to an
+// emulator instead, if there is one):
+func newGCSPublishRequest(bucket, objectPath string, content []byte) (*http.Request, error) {
+	objectURL := fmt.Sprintf("https://storage.googleapis.com/%s%s", bucket, objectPath)
+	if emulatorHost := os.Getenv(envGCSEmulatorHost); emulatorHost != "" {
+		if !strings.Contains(emulatorHost, "://") {
+			emulatorHost = "http://" + emulatorHost
+		}
+		objectURL = fmt.Sprintf("%s/%s%s", strings.TrimSuffix(emulatorHost, "/"), bucket, objectPath)
+	}
+
+	req, err := newPublishRequest(objectURL, content)
+	if err != nil {
+		return nil, err
+	}
+	if token := os.Getenv(envGCSAccessToken); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return req, nil
+}
+
+// firstEnv returns the first of the given environment variables which is set:
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,128 @@
+package converter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// awsCredentials are what AWS requests are signed with:
+type awsCredentials struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+// signAWSRequest signs a request (AWS SigV4) for the given region and service, covering its host and every header it
+// already has. Paths are encoded once (as S3 expects), so should be sent as awsURIEncode gives them:
+func signAWSRequest(req *http.Request, payloadHash string, credentials awsCredentials, region, service string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	shortDate := now.UTC().Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	if credentials.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", credentials.sessionToken)
+	}
+
+	// Canonicalise the headers (lowercase names, sorted, with runs of whitespace in their values collapsed):
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		if strings.EqualFold(name, "Authorization") {
+			continue
+		}
+		trimmedValues := make([]string, len(values))
+		for i, value := range values {
+			trimmedValues[i] = strings.Join(strings.Fields(value), " ")
+		}
+		headers[strings.ToLower(name)] = strings.Join(trimmedValues, ",")
+	}
+	var headerNames []string
+	for name := range headers {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+	var canonicalHeaders strings.Builder
+	for _, name := range headerNames {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	// Build the canonical request and string-to-sign:
+	canonicalURI := awsURIEncode(req.URL.Path, false)
+	if canonicalURI == "" {
+		canonicalURI = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI,
+		awsCanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	scope := fmt.Sprintf("%s/%s/%s/aws4_request", shortDate, region, service)
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hex.EncodeToString(canonicalRequestHash[:])}, "\n")
+
+	// Derive the signing key and sign:
+	signingKey := hmacSHA256([]byte("AWS4"+credentials.secretAccessKey), shortDate)
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", credentials.accessKeyID, scope, signedHeaders, signature))
+}
+
+// awsURIEncode percent-encodes every byte but the unreserved characters (A-Z, a-z, 0-9, "-", ".", "_" and "~"), and
+// slashes unless asked to. Unlike url.PathEscape, this includes the likes of "!", "$", "'", "(", ")" and "*":
+func awsURIEncode(value string, encodeSlash bool) string {
+	var encoded strings.Builder
+	for i := 0; i < len(value); i++ {
+		switch char := value[i]; {
+		case 'A' <= char && char <= 'Z', 'a' <= char && char <= 'z', '0' <= char && char <= '9',
+			char == '-', char == '.', char == '_', char == '~', char == '/' && !encodeSlash:
+			encoded.WriteByte(char)
+		default:
+			fmt.Fprintf(&encoded, "%%%02X", char)
+		}
+	}
+	return encoded.String()
+}
+
+// awsCanonicalQuery encodes query parameters (sorted by name, then value) the way SigV4 expects:
+func awsCanonicalQuery(query url.Values) string {
+	var names []string
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return awsURIEncode(names[i], true) < awsURIEncode(names[j], true) })
+
+	var parameters []string
+	for _, name := range names {
+		values := make([]string, len(query[name]))
+		for i, value := range query[name] {
+			values[i] = awsURIEncode(value, true)
+		}
+		sort.Strings(values)
+		for _, value := range values {
+			parameters = append(parameters, awsURIEncode(name, true)+"="+value)
+		}
+	}
+	return strings.Join(parameters, "&")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,65 @@
+package converter
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+// publishState records what has already been published (the SHA-256 digest of the content at each destination), so that
+// an interrupted publish can be resumed without uploading everything again:
+type publishState struct {
+	Published map[string]string `json:"published"`
+	mutex     sync.Mutex
+}
+
+// loadPublishState reads a state file (a missing file simply means nothing has been published yet):
+func loadPublishState(fileName string) (*publishState, error) {
+	state := &publishState{Published: make(map[string]string)}
+	if fileName == "" {
+		return state, nil
+	}
+
+	stateJSON, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(stateJSON, state); err != nil {
+		return nil, err
+	}
+	if state.Published == nil {
+		state.Published = make(map[string]string)
+	}
+
+	return state, nil
+}
+
+// published tells us whether this content has already been published to a destination:
+func (s *publishState) published(destination, digest string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.Published[destination] == digest
+}
+
+// record notes that content has been published to a destination:
+func (s *publishState) record(destination, digest string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.Published[destination] = digest
+}
+
+// save writes the state back to disk:
+func (s *publishState) save(fileName string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	stateJSON, err := json.MarshalIndent(s, "", "    ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomically(fileName, stateJSON)
+}
@@ -0,0 +1,72 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+// rawFieldSchema returns the raw JSON-Schema snippet a field has been given (if it has one):
+func rawFieldSchema(desc *descriptor.FieldDescriptorProto) string {
+	opt := proto.GetExtension(desc.GetOptions(), protoc_gen_jsonschema.E_FieldOptions)
+	if fieldOptions, ok := opt.(*protoc_gen_jsonschema.FieldOptions); ok {
+		return fieldOptions.GetRawSchema()
+	}
+	return ""
+}
+
+// convertRawSchema checks a raw JSON-Schema snippet against the metaschema (of the draft we're targeting), then uses it
+// verbatim as the schema for a field:
+func (c *Converter) convertRawSchema(desc *descriptor.FieldDescriptorProto, rawSchema string) (*jsonschema.Type, error) {
+	extras, err := c.parseSchemaSnippet("raw_schema", desc, rawSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every keyword is carried as an extra, so nothing gets lost along the way:
+	return &jsonschema.Type{Extras: extras}, nil
+}
+
+// parseSchemaSnippet checks a JSON-Schema snippet (given to a field with one of our options) against the metaschema
+// (of the draft we're targeting), and decodes its keywords:
+func (c *Converter) parseSchemaSnippet(optionName string, desc *descriptor.FieldDescriptorProto, snippet string) (map[string]interface{}, error) {
+	schemaLoader := gojsonschema.NewSchemaLoader()
+	schemaLoader.Validate = true
+	schemaLoader.Draft = c.snippetDraft()
+	if err := schemaLoader.AddSchemas(gojsonschema.NewStringLoader(snippet)); err != nil {
+		return nil, fmt.Errorf("invalid %s for field %s: %s", optionName, desc.GetName(), strings.TrimSpace(err.Error()))
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(snippet))
+	decoder.UseNumber()
+	keywords := make(map[string]interface{})
+	if err := decoder.Decode(&keywords); err != nil {
+		return nil, fmt.Errorf("invalid %s for field %s: %v", optionName, desc.GetName(), err)
+	}
+	return keywords, nil
+}
+
+// snippetDraft is the draft whose metaschema snippets are checked against: the one given by schema_version (with
+// draft-07 standing in for later drafts, which gojsonschema doesn't have metaschemas for, and draft-04 for OpenAPI 3.0,
+// whose schemas are a subset of it), otherwise the one we generate:
+func (c *Converter) snippetDraft() gojsonschema.Draft {
+	switch c.targetSchemaVersion {
+	case "draft-04", schemaVersionOpenAPI3:
+		return gojsonschema.Draft4
+	case "draft-06":
+		return gojsonschema.Draft6
+	case "draft-07", "2019-09", "2020-12":
+		return gojsonschema.Draft7
+	}
+	if c.schemaVersion == versionDraft06 {
+		return gojsonschema.Draft6
+	}
+	return gojsonschema.Draft4
+}
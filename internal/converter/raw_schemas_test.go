@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+func TestConvertRawSchema(t *testing.T) {
+
+	fieldDesc := &descriptor.FieldDescriptorProto{Name: proto.String("currency")}
+
+	// Valid snippets are carried over verbatim:
+	jsonSchemaType, err := New(newTestLogger()).convertRawSchema(fieldDesc, `{"type": "string", "pattern": "^[A-Z]{3}$", "x-unit": "ISO-4217"}`)
+	require.NoError(t, err)
+	schemaJSON, err := jsonSchemaType.MarshalJSON()
+	require.NoError(t, err)
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(schemaJSON, &schema))
+	assert.Equal(t, map[string]interface{}{"type": "string", "pattern": "^[A-Z]{3}$", "x-unit": "ISO-4217"}, schema)
+
+	// Anything the metaschema doesn't accept is rejected:
+	for _, rawSchema := range []string{`{"type": "text"}`, `{"minLength": -1}`, `"string"`, `{"type": `} {
+		_, err := New(newTestLogger()).convertRawSchema(fieldDesc, rawSchema)
+		assert.Error(t, err, rawSchema)
+	}
+
+	// Snippets are checked against the metaschema of the draft we're targeting (draft-07 has "if", draft-04 doesn't):
+	_, err = New(newTestLogger()).convertRawSchema(fieldDesc, `{"type": "string", "if": 5}`)
+	assert.NoError(t, err)
+	protoConverter := New(newTestLogger())
+	protoConverter.parseGeneratorParameters("schema_version=draft-07")
+	_, err = protoConverter.convertRawSchema(fieldDesc, `{"type": "string", "if": 5}`)
+	assert.Error(t, err)
+
+	// Including when they're used in a field option:
+	fieldOptions := &descriptor.FieldOptions{}
+	proto.SetExtension(fieldOptions, protoc_gen_jsonschema.E_FieldOptions, &protoc_gen_jsonschema.FieldOptions{RawSchema: `{"type": "text"}`})
+	fieldDesc.Options = fieldOptions
+	protoConverter = New(newTestLogger())
+	_, err = protoConverter.convertField(protoConverter.rootPkg, fieldDesc, &descriptor.DescriptorProto{Name: proto.String("Price")}, nil, ConverterFlags{})
+	assert.Contains(t, err.Error(), "invalid raw_schema for field currency")
+}
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/chrusty/protoc-gen-jsonschema/internal/converter/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecursiveMaps(t *testing.T) {
+
+	fileDescriptorSet := mustReadProtoFiles(t, sampleProtoDirectory, "RecursiveMaps.proto")
+
+	// Maps of the messages which contain them stay finite (as $refs) and validate the same, whatever else we're doing:
+	for _, parameter := range []string{"", "root=Node", "max_depth=3", "split_threshold=1", "enforce_oneof", "allow_null_values", "schema_version=draft-07"} {
+		t.Run(parameter, func(t *testing.T) {
+			response, err := convertTestRequest(fileRequest("RecursiveMaps.proto", parameter, fileDescriptorSet.GetFile()...))
+			require.NoError(t, err)
+
+			var nodeSchema string
+			for _, responseFile := range response.File {
+				if responseFile.GetName() == "Node.json" {
+					nodeSchema = responseFile.GetContent()
+				}
+			}
+			require.True(t, nodeSchema != "", "Node.json wasn't generated")
+
+			valid, err := validateSchema(nodeSchema, testdata.RecursiveMapsNodePass)
+			assert.NoError(t, err)
+			assert.True(t, valid)
+
+			valid, err = validateSchema(nodeSchema, testdata.RecursiveMapsNodeFail)
+			assert.NoError(t, err)
+			assert.False(t, valid)
+		})
+	}
+}
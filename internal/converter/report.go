@@ -0,0 +1,91 @@
+package converter
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const defaultReportFileName = "report.json"
+
+// The phases of conversion which we time:
+const (
+	phaseResolution = iota
+	phaseRecursion
+	phaseMarshaling
+)
+
+// generationReport records what was generated (and how long it took), to help track down slow generation:
+type generationReport struct {
+	Files          []*fileReport `json:"files"`
+	currentFile    *fileReport
+	currentMessage *messageReport
+}
+
+// fileReport records how long it took to generate the schemas for a proto file:
+type fileReport struct {
+	ProtoFileName string           `json:"proto_file"`
+	Cached        bool             `json:"cached,omitempty"`
+	DurationMS    float64          `json:"duration_ms"`
+	Messages      []*messageReport `json:"messages,omitempty"`
+}
+
+// messageReport breaks down how long it took to generate a message (or enum) schema:
+type messageReport struct {
+	Name         string  `json:"name"`
+	ResolutionMS float64 `json:"resolution_ms"`
+	RecursionMS  float64 `json:"recursion_ms"`
+	MarshalingMS float64 `json:"marshaling_ms"`
+}
+
+// reportFile starts reporting on a proto file, returning a func which records the total time taken:
+func (c *Converter) reportFile(protoFileName string, cached bool) func() {
+	if c.report == nil {
+		return func() {}
+	}
+
+	started := time.Now()
+	fileReport := &fileReport{ProtoFileName: protoFileName, Cached: cached}
+	c.report.Files = append(c.report.Files, fileReport)
+	c.report.currentFile = fileReport
+
+	return func() {
+		fileReport.DurationMS = milliseconds(time.Since(started))
+		c.report.currentFile = nil
+		c.report.currentMessage = nil
+	}
+}
+
+// reportMessage starts reporting on a message (or enum) from the current proto file:
+func (c *Converter) reportMessage(name string) {
+	if c.report == nil || c.report.currentFile == nil {
+		return
+	}
+	c.report.currentMessage = &messageReport{Name: name}
+	c.report.currentFile.Messages = append(c.report.currentFile.Messages, c.report.currentMessage)
+}
+
+// reportTiming adds the time since a phase started to the current message's report:
+func (c *Converter) reportTiming(phase int, started time.Time) {
+	if c.report == nil || c.report.currentMessage == nil {
+		return
+	}
+
+	elapsed := milliseconds(time.Since(started))
+	switch phase {
+	case phaseResolution:
+		c.report.currentMessage.ResolutionMS += elapsed
+	case phaseRecursion:
+		c.report.currentMessage.RecursionMS += elapsed
+	case phaseMarshaling:
+		c.report.currentMessage.MarshalingMS += elapsed
+	}
+}
+
+// marshalReport renders the report as JSON:
+func (c *Converter) marshalReport() ([]byte, error) {
+	return json.MarshalIndent(c.report, "", "    ")
+}
+
+func milliseconds(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Millisecond)
+}
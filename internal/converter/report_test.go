@@ -0,0 +1,53 @@
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestGenerationReport(t *testing.T) {
+
+	// An enum (in a file of its own):
+	statusFileDesc := testProtoFile("acme/status.proto", "acme")
+	statusFileDesc.EnumType = []*descriptor.EnumDescriptorProto{
+		{Name: proto.String("Status"), Value: []*descriptor.EnumValueDescriptorProto{{Name: proto.String("UNKNOWN"), Number: proto.Int32(0)}}},
+	}
+
+	response, err := convertTestRequest(testRequest(
+		"generate_report",
+		testProtoFile("acme/order.proto", "acme",
+			testMessage("Order", testField("customer", 1, descriptor.FieldDescriptorProto_TYPE_MESSAGE, ".acme.Customer")),
+			testMessage("Customer"),
+		),
+		statusFileDesc,
+	))
+	require.NoError(t, err)
+
+	// The report comes last:
+	require.Len(t, response.File, 4)
+	assert.Equal(t, "report.json", response.File[3].GetName())
+
+	// Every file (and the messages / enums in it) gets reported on:
+	report := &generationReport{}
+	require.NoError(t, json.Unmarshal([]byte(response.File[3].GetContent()), report))
+	require.Len(t, report.Files, 2)
+	assert.Equal(t, "acme/order.proto", report.Files[0].ProtoFileName)
+	assert.False(t, report.Files[0].Cached)
+	require.Len(t, report.Files[0].Messages, 2)
+	assert.Equal(t, "acme.Order", report.Files[0].Messages[0].Name)
+	assert.Equal(t, "acme.Customer", report.Files[0].Messages[1].Name)
+	assert.Equal(t, "acme/status.proto", report.Files[1].ProtoFileName)
+	require.Len(t, report.Files[1].Messages, 1)
+	assert.Equal(t, "acme.Status", report.Files[1].Messages[0].Name)
+
+	// Timings are there (even if they're tiny):
+	assert.Contains(t, response.File[3].GetContent(), `"resolution_ms"`)
+	assert.Contains(t, response.File[3].GetContent(), `"recursion_ms"`)
+	assert.Contains(t, response.File[3].GetContent(), `"marshaling_ms"`)
+	assert.True(t, report.Files[0].DurationMS > 0)
+}
@@ -0,0 +1,149 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/alecthomas/jsonschema"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+// fileRootMessage returns the name of the message to use as the only schema for a file (the "root" file option takes precedence over the "root" parameter):
+func (c *Converter) fileRootMessage(file *descriptor.FileDescriptorProto) (string, error) {
+
+	// The file option has to name a message within the file:
+	if opt := proto.GetExtension(file.GetOptions(), protoc_gen_jsonschema.E_FileOptions); opt != nil {
+		if fileOptions, ok := opt.(*protoc_gen_jsonschema.FileOptions); ok && fileOptions.GetRoot() != "" {
+			if !fileHasMessage(file, fileOptions.GetRoot()) {
+				return "", fmt.Errorf("no such root message in %s: %s", file.GetName(), fileOptions.GetRoot())
+			}
+			return fileOptions.GetRoot(), nil
+		}
+	}
+
+	// The parameter only applies to files which contain the message:
+	if c.rootMessage != "" && fileHasMessage(file, c.rootMessage) {
+		return c.rootMessage, nil
+	}
+
+	return "", nil
+}
+
+// fileHasMessage tells us whether a file declares a top-level message with the given name:
+func fileHasMessage(file *descriptor.FileDescriptorProto, msgName string) bool {
+	for _, msgDesc := range file.GetMessageType() {
+		if msgDesc.GetName() == msgName {
+			return true
+		}
+	}
+	return false
+}
+
+// addFileDefinitions converts the other messages in a file, and adds them to the root message's definitions:
+func (c *Converter) addFileDefinitions(pkg *ProtoPackage, file *descriptor.FileDescriptorProto, rootDesc *descriptor.DescriptorProto, rootJSONSchema *jsonschema.Schema) error {
+	messageDefinitions := make(map[string]*jsonschema.Type)
+	for _, msgDesc := range file.GetMessageType() {
+		if msgDesc == rootDesc {
+			continue
+		}
+
+		// "Ignored" messages are left out here too:
+		if opt := proto.GetExtension(msgDesc.GetOptions(), protoc_gen_jsonschema.E_MessageOptions); opt != nil {
+			if messageOptions, ok := opt.(*protoc_gen_jsonschema.MessageOptions); ok && messageOptions.GetIgnore() {
+				continue
+			}
+		}
+
+		// So are alpha (or restricted) messages:
+		if c.excludedMessage(msgDesc) {
+			continue
+		}
+
+		messageJSONSchema, err := c.convertMessageType(pkg, msgDesc)
+		if err != nil {
+			return err
+		}
+
+		// The root message's own definitions take precedence:
+		for name, definition := range messageJSONSchema.Definitions {
+			if name == msgDesc.GetName() {
+				messageDefinitions[name] = definition
+				continue
+			}
+			if _, ok := rootJSONSchema.Definitions[name]; !ok {
+				rootJSONSchema.Definitions[name] = definition
+			}
+		}
+	}
+
+	// Messages which are already referenced (by their fully-qualified names) don't need adding again, unless the other
+	// messages' definitions refer back to them by their short names (eg maps of self-referencing messages):
+	refs := make(map[string]bool)
+	collectRefs(rootJSONSchema.Type, refs)
+	for _, definition := range rootJSONSchema.Definitions {
+		collectRefs(definition, refs)
+	}
+	for name, definition := range messageDefinitions {
+		if _, ok := rootJSONSchema.Definitions[c.naming().DefinitionName(qualifiedName(file.GetPackage(), name))]; ok && !refs[c.definitionRef(name)] {
+			continue
+		}
+		if _, ok := rootJSONSchema.Definitions[name]; !ok {
+			rootJSONSchema.Definitions[name] = definition
+			collectRefs(definition, refs)
+		}
+	}
+
+	return nil
+}
+
+// collectRefs records every reference made anywhere within a schema (including those in extra keywords, such as the
+// typed additionalProperties of maps):
+func collectRefs(value interface{}, refs map[string]bool) {
+	switch value := value.(type) {
+	case *jsonschema.Type:
+		if value == nil {
+			return
+		}
+		if value.Ref != "" {
+			refs[value.Ref] = true
+		}
+		if value.Properties != nil {
+			for _, propertyName := range value.Properties.Keys() {
+				property, _ := value.Properties.Get(propertyName)
+				collectRefs(property, refs)
+			}
+		}
+		for _, nestedJSONSchemaType := range []*jsonschema.Type{value.Items, value.AdditionalItems, value.Not, value.Media} {
+			collectRefs(nestedJSONSchemaType, refs)
+		}
+		for _, nestedJSONSchemaTypes := range [][]*jsonschema.Type{value.AllOf, value.AnyOf, value.OneOf} {
+			collectRefs(nestedJSONSchemaTypes, refs)
+		}
+		for _, nestedJSONSchemaTypes := range []map[string]*jsonschema.Type{value.PatternProperties, value.Dependencies, value.Definitions} {
+			collectRefs(nestedJSONSchemaTypes, refs)
+		}
+		for _, extra := range value.Extras {
+			collectRefs(extra, refs)
+		}
+	case additionalProperties:
+		collectRefs(value.schema, refs)
+	case []*jsonschema.Type:
+		for _, item := range value {
+			collectRefs(item, refs)
+		}
+	case map[string]*jsonschema.Type:
+		for _, item := range value {
+			collectRefs(item, refs)
+		}
+	case []interface{}:
+		for _, item := range value {
+			collectRefs(item, refs)
+		}
+	case map[string]interface{}:
+		for _, item := range value {
+			collectRefs(item, refs)
+		}
+	}
+}
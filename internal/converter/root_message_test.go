@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectRefs(t *testing.T) {
+
+	// References are found wherever they are (including the typed additionalProperties of maps, which are extras until
+	// they are rendered):
+	properties := orderedmap.New()
+	properties.Set("customer", &jsonschema.Type{Ref: "#/definitions/Customer"})
+	properties.Set("children", &jsonschema.Type{Type: "object", Extras: map[string]interface{}{additionalPropertiesKeyword: additionalProperties{schema: &jsonschema.Type{Ref: "#/definitions/Node"}}}})
+	properties.Set("lines", &jsonschema.Type{Type: "array", Items: &jsonschema.Type{OneOf: []*jsonschema.Type{{Ref: "#/definitions/Line"}, {Type: "null"}}}})
+	refs := make(map[string]bool)
+	collectRefs(&jsonschema.Type{Properties: properties, Definitions: jsonschema.Definitions{"Line": {Ref: "#/definitions/acme.Line"}}}, refs)
+	assert.Equal(t, map[string]bool{
+		"#/definitions/Customer":  true,
+		"#/definitions/Node":      true,
+		"#/definitions/Line":      true,
+		"#/definitions/acme.Line": true,
+	}, refs)
+}
@@ -0,0 +1,69 @@
+package converter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+)
+
+// templateVariablePattern matches the variables in ID and title templates (eg "{package}"):
+var templateVariablePattern = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+
+// parseTemplateVariable parses a "name=value" template variable (from a "template_var=env=prod" parameter):
+func parseTemplateVariable(value string) (string, string, error) {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || !templateVariablePattern.MatchString("{"+parts[0]+"}") {
+		return "", "", fmt.Errorf("template variables look like name=value: %s", value)
+	}
+	return parts[0], parts[1], nil
+}
+
+// applySchemaTemplates gives a top-level schema an ID and title from our templates (if we have any), which can refer to
+// {package}, {message}, {version} (from the package name, if it has one), {file} (the schema filename), {title} (the
+// generated title, defaulting to the name) and any variables passed as parameters:
+func (c *Converter) applySchemaTemplates(pkgName, name, jsonSchemaFileName string, jsonSchemaType *jsonschema.Type, definitions jsonschema.Definitions) {
+	if c.idTemplate == "" && c.titleTemplate == "" {
+		return
+	}
+
+	// The title lives on the root definition (unless the schema doesn't have one):
+	titledJSONSchemaType := jsonSchemaType
+	if definition, ok := definitions[strings.TrimPrefix(jsonSchemaType.Ref, c.refPrefix)]; ok {
+		titledJSONSchemaType = definition
+	}
+
+	variables := map[string]string{
+		"file":    jsonSchemaFileName,
+		"message": name,
+		"package": pkgName,
+		"title":   titledJSONSchemaType.Title,
+	}
+	if variables["title"] == "" {
+		variables["title"] = name
+	}
+	if version, ok := parsePackageVersion(pkgName); ok {
+		variables["version"] = version.version
+	}
+	for variableName, value := range c.templateVariables {
+		variables[variableName] = value
+	}
+	expand := func(template string) string {
+		return templateVariablePattern.ReplaceAllStringFunc(template, func(variable string) string {
+			if value, ok := variables[strings.Trim(variable, "{}")]; ok {
+				return value
+			}
+			c.logger.WithField("template", template).WithField("variable", variable).Warn("Unknown template variable")
+			return variable
+		})
+	}
+
+	if c.titleTemplate != "" {
+		titledJSONSchemaType.Title = expand(c.titleTemplate)
+	}
+
+	if c.idTemplate != "" {
+		setSchemaID(jsonSchemaType, expand(c.idTemplate))
+	}
+}
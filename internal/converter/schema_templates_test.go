@@ -0,0 +1,20 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTemplateVariable(t *testing.T) {
+	name, value, err := parseTemplateVariable("env=prod=eu")
+	require.NoError(t, err)
+	assert.Equal(t, "env", name)
+	assert.Equal(t, "prod=eu", value)
+
+	for _, invalid := range []string{"env", "=prod", "my-env=prod"} {
+		_, _, err := parseTemplateVariable(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,392 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/iancoleman/orderedmap"
+)
+
+const (
+	versionDraft07        = "http://json-schema.org/draft-07/schema#"
+	version201909         = "https://json-schema.org/draft/2019-09/schema"
+	version202012         = "https://json-schema.org/draft/2020-12/schema"
+	schemaVersionOpenAPI3 = "openapi3"
+	defsRefPrefix         = "#/$defs/"
+	openAPIRefPrefix      = "#/components/schemas/"
+)
+
+// schemaVersions maps the targets of the "schema_version" parameter to their metaschemas:
+var schemaVersions = map[string]string{
+	"draft-04":            versionDraft04,
+	"draft-06":            versionDraft06,
+	"draft-07":            versionDraft07,
+	"2019-09":             version201909,
+	"2020-12":             version202012,
+	schemaVersionOpenAPI3: "",
+}
+
+// subschemaKeywords are the keywords whose values are schemas (or arrays of schemas):
+var subschemaKeywords = []string{"additionalItems", "additionalProperties", "allOf", "anyOf", "contains", "else", "if", "items", "not", "oneOf", "prefixItems", "propertyNames", "then"}
+
+// subschemaMapKeywords are the keywords whose values are maps of schemas:
+var subschemaMapKeywords = []string{"$defs", "definitions", "dependencies", "dependentSchemas", "patternProperties", "properties"}
+
+// exclusiveBoundKeywords pairs the exclusive bounds with their inclusive counterparts:
+var exclusiveBoundKeywords = [][2]string{{"exclusiveMinimum", "minimum"}, {"exclusiveMaximum", "maximum"}}
+
+// openAPIUnsupportedKeywords are JSON-Schema keywords which OpenAPI 3.0 schema objects don't support:
+var openAPIUnsupportedKeywords = []string{"$comment", "$id", "$schema", "additionalItems", "contains", "dependencies", "else", "id", "if", "minContains", "propertyNames", "then"}
+
+// convertSchemaVersion rewrites a generated schema for the draft (or OpenAPI flavour) we've been asked to target,
+// adjusting the keywords which differ between them (and keeping everything in the order we generated it):
+func (c *Converter) convertSchemaVersion(jsonSchemaFileName string, jsonSchemaJSON []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(jsonSchemaJSON))
+	decoder.UseNumber()
+	decoded, err := decodeOrderedJSON(decoder)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := decoded.(*orderedmap.OrderedMap)
+	if !ok {
+		return jsonSchemaJSON, nil
+	}
+
+	walkSchema(root, func(schema *orderedmap.OrderedMap) {
+		convertSchemaKeywords(c.targetSchemaVersion, schema)
+	})
+
+	// Slugged definitions can be addressed by plain-name anchors too (which only exist from 2019-09 onwards):
+	if c.Flags.DefinitionSlugs {
+		anchorDefinitions(root)
+	}
+
+	var converted interface{} = root
+	if c.targetSchemaVersion == schemaVersionOpenAPI3 {
+		converted = openAPIComponents(strings.TrimSuffix(path.Base(jsonSchemaFileName), path.Ext(jsonSchemaFileName)), root)
+	} else {
+		root.Set("$schema", schemaVersions[c.targetSchemaVersion])
+	}
+
+	return json.MarshalIndent(converted, "", "    ")
+}
+
+// convertSchemaKeywords adjusts the keywords of a single schema for a target:
+func convertSchemaKeywords(target string, schema *orderedmap.OrderedMap) {
+	switch target {
+	case "draft-04":
+		exclusiveBoundsAsFlags(schema)
+		constAsEnum(schema)
+		renameKeyword(schema, "$id", "id")
+
+	case "draft-06", "draft-07":
+		exclusiveBoundsAsNumbers(schema)
+		renameKeyword(schema, "id", "$id")
+
+	case "2019-09", "2020-12":
+		exclusiveBoundsAsNumbers(schema)
+		renameKeyword(schema, "id", "$id")
+		renameKeyword(schema, "definitions", "$defs")
+		replaceRefPrefix(schema, defsRefPrefix)
+		splitDependencies(schema)
+		if _, tuple := getKeyword(schema, "items"); tuple && target == "2020-12" {
+			renameKeyword(schema, "items", "prefixItems")
+			renameKeyword(schema, "additionalItems", "items")
+		}
+
+	case schemaVersionOpenAPI3:
+		openAPINullable(schema) // First, as it can merge another schema into this one
+		exclusiveBoundsAsFlags(schema)
+		constAsEnum(schema)
+		replaceRefPrefix(schema, openAPIRefPrefix)
+		openAPIMapValues(schema)
+		if examples, ok := schema.Get("examples"); ok {
+			if examples, ok := examples.([]interface{}); ok && len(examples) > 0 {
+				schema.Set("example", examples[0])
+			}
+			schema.Delete("examples")
+		}
+		for _, keyword := range openAPIUnsupportedKeywords {
+			schema.Delete(keyword)
+		}
+	}
+}
+
+// exclusiveBoundsAsFlags turns numeric exclusive bounds (draft-06 onwards) into flags on minimum / maximum (draft-04):
+func exclusiveBoundsAsFlags(schema *orderedmap.OrderedMap) {
+	for _, keywords := range exclusiveBoundKeywords {
+		exclusiveKeyword, keyword := keywords[0], keywords[1]
+		if bound, ok := schema.Get(exclusiveKeyword); ok {
+			if bound, ok := bound.(json.Number); ok {
+				schema.Set(exclusiveKeyword, true)
+				schema.Set(keyword, bound)
+			}
+		}
+	}
+}
+
+// exclusiveBoundsAsNumbers turns exclusive flags on minimum / maximum (draft-04) into numeric bounds (draft-06 onwards):
+func exclusiveBoundsAsNumbers(schema *orderedmap.OrderedMap) {
+	for _, keywords := range exclusiveBoundKeywords {
+		exclusiveKeyword, keyword := keywords[0], keywords[1]
+		exclusive, ok := schema.Get(exclusiveKeyword)
+		if _, isFlag := exclusive.(bool); !ok || !isFlag {
+			continue
+		}
+		bound, hasBound := schema.Get(keyword)
+		if exclusive == true && hasBound {
+			schema.Set(exclusiveKeyword, bound)
+			schema.Delete(keyword)
+			continue
+		}
+		schema.Delete(exclusiveKeyword)
+	}
+}
+
+// constAsEnum turns "const" (draft-06 onwards) into a single-value enum:
+func constAsEnum(schema *orderedmap.OrderedMap) {
+	if value, ok := schema.Get("const"); ok {
+		schema.Set("const", []interface{}{value})
+		renameKeyword(schema, "const", "enum")
+	}
+}
+
+// replaceRefPrefix points references to definitions at a different location (eg "#/$defs/"):
+func replaceRefPrefix(schema *orderedmap.OrderedMap, refPrefix string) {
+	if ref, ok := schema.Get("$ref"); ok {
+		if ref, ok := ref.(string); ok {
+			schema.Set("$ref", strings.Replace(ref, defaultRefPrefix, refPrefix, 1))
+		}
+	}
+}
+
+// splitDependencies splits "dependencies" into "dependentRequired" and "dependentSchemas" (2019-09 onwards):
+func splitDependencies(schema *orderedmap.OrderedMap) {
+	dependencies, ok := schema.Get("dependencies")
+	if !ok {
+		return
+	}
+	dependentRequired := orderedmap.New()
+	dependentSchemas := orderedmap.New()
+	if dependencies, ok := dependencies.(*orderedmap.OrderedMap); ok {
+		for _, name := range dependencies.Keys() {
+			dependency, _ := dependencies.Get(name)
+			if _, ok := dependency.([]interface{}); ok {
+				dependentRequired.Set(name, dependency)
+			} else {
+				dependentSchemas.Set(name, dependency)
+			}
+		}
+	}
+	schema.Delete("dependencies")
+	if len(dependentRequired.Keys()) > 0 {
+		schema.Set("dependentRequired", dependentRequired)
+	}
+	if len(dependentSchemas.Keys()) > 0 {
+		schema.Set("dependentSchemas", dependentSchemas)
+	}
+}
+
+// openAPINullable replaces null alternatives (and null types) with OpenAPI's "nullable":
+func openAPINullable(schema *orderedmap.OrderedMap) {
+	if types, ok := schema.Get("type"); ok {
+		if types, ok := types.([]interface{}); ok {
+			var nonNullTypes []interface{}
+			for _, jsonType := range types {
+				if jsonType != "null" {
+					nonNullTypes = append(nonNullTypes, jsonType)
+				}
+			}
+			if len(nonNullTypes) == 1 {
+				schema.Set("type", nonNullTypes[0])
+			}
+			if len(nonNullTypes) < len(types) {
+				schema.Set("nullable", true)
+			}
+		}
+	}
+
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		alternatives, ok := getKeyword(schema, keyword)
+		if !ok {
+			continue
+		}
+		var nonNullAlternatives []interface{}
+		for _, alternative := range alternatives {
+			if alternative, ok := alternative.(*orderedmap.OrderedMap); ok && len(alternative.Keys()) == 1 {
+				if jsonType, _ := alternative.Get("type"); jsonType == "null" {
+					continue
+				}
+			}
+			nonNullAlternatives = append(nonNullAlternatives, alternative)
+		}
+		if len(nonNullAlternatives) == len(alternatives) {
+			continue
+		}
+		schema.Set("nullable", true)
+
+		// A single remaining alternative can take the place of the oneOf (unless it would clash with the schema):
+		if len(nonNullAlternatives) == 1 {
+			if remaining, ok := nonNullAlternatives[0].(*orderedmap.OrderedMap); ok && !sharesKeywords(schema, remaining) {
+				schema.Delete(keyword)
+				for _, remainingKeyword := range remaining.Keys() {
+					value, _ := remaining.Get(remainingKeyword)
+					schema.Set(remainingKeyword, value)
+				}
+				continue
+			}
+		}
+		schema.Set(keyword, nonNullAlternatives)
+	}
+}
+
+// openAPIMapValues describes map values with additionalProperties (OpenAPI 3.0 has no patternProperties):
+func openAPIMapValues(schema *orderedmap.OrderedMap) {
+	patternProperties, ok := schema.Get("patternProperties")
+	if !ok {
+		return
+	}
+	schema.Delete("patternProperties")
+	if patternProperties, ok := patternProperties.(*orderedmap.OrderedMap); ok && len(patternProperties.Keys()) > 0 {
+		if additionalProperties, _ := schema.Get("additionalProperties"); additionalProperties == false {
+			valueSchema, _ := patternProperties.Get(patternProperties.Keys()[0])
+			schema.Set("additionalProperties", valueSchema)
+		}
+	}
+}
+
+// openAPIComponents puts a schema's definitions (and the schema itself, unless it is just a reference) under
+// components.schemas, which is where OpenAPI 3.0 documents keep reusable schemas:
+func openAPIComponents(name string, root *orderedmap.OrderedMap) *orderedmap.OrderedMap {
+	schemas := orderedmap.New()
+	if definitions, ok := root.Get("definitions"); ok {
+		root.Delete("definitions")
+		if definitions, ok := definitions.(*orderedmap.OrderedMap); ok {
+			for _, definitionName := range definitions.Keys() {
+				definition, _ := definitions.Get(definitionName)
+				schemas.Set(definitionName, definition)
+			}
+		}
+	}
+	root.Delete("$ref")
+	if len(root.Keys()) > 0 {
+		schemas.Set(name, root)
+	}
+
+	components := orderedmap.New()
+	components.Set("schemas", schemas)
+	document := orderedmap.New()
+	document.Set("components", components)
+	return document
+}
+
+// walkSchema calls a function for a schema, then for every schema nested within it (but not for values, eg of enums):
+func walkSchema(schema *orderedmap.OrderedMap, convert func(*orderedmap.OrderedMap)) {
+	convert(schema)
+
+	for _, keyword := range subschemaKeywords {
+		value, _ := schema.Get(keyword)
+		switch value := value.(type) {
+		case *orderedmap.OrderedMap:
+			walkSchema(value, convert)
+		case []interface{}:
+			for _, item := range value {
+				if item, ok := item.(*orderedmap.OrderedMap); ok {
+					walkSchema(item, convert)
+				}
+			}
+		}
+	}
+	for _, keyword := range subschemaMapKeywords {
+		if subschemas, ok := schema.Get(keyword); ok {
+			if subschemas, ok := subschemas.(*orderedmap.OrderedMap); ok {
+				for _, name := range subschemas.Keys() {
+					if subschema, _ := subschemas.Get(name); subschema != nil {
+						if subschema, ok := subschema.(*orderedmap.OrderedMap); ok {
+							walkSchema(subschema, convert)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// getKeyword returns the value of a keyword which holds an array:
+func getKeyword(schema *orderedmap.OrderedMap, keyword string) ([]interface{}, bool) {
+	value, _ := schema.Get(keyword)
+	values, ok := value.([]interface{})
+	return values, ok
+}
+
+// sharesKeywords tells us whether two schemas have any keywords in common:
+func sharesKeywords(schema, other *orderedmap.OrderedMap) bool {
+	for _, keyword := range other.Keys() {
+		if _, ok := schema.Get(keyword); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// renameKeyword renames a keyword in place (keeping the order of the schema's keywords):
+func renameKeyword(schema *orderedmap.OrderedMap, from, to string) {
+	if _, ok := schema.Get(from); !ok {
+		return
+	}
+	keys := append([]string(nil), schema.Keys()...) // Keys() is the map's own slice, which deleting changes
+	values := make([]interface{}, len(keys))
+	for index, key := range keys {
+		values[index], _ = schema.Get(key)
+		schema.Delete(key)
+	}
+	for index, key := range keys {
+		if key == from {
+			key = to
+		}
+		schema.Set(key, values[index])
+	}
+}
+
+// decodeOrderedJSON decodes JSON into ordered maps (keeping numbers exactly as they were):
+func decodeOrderedJSON(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		object := orderedmap.New()
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrderedJSON(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object.Set(key.(string), value)
+		}
+		_, err := decoder.Token() // The closing brace
+		return object, err
+
+	case json.Delim('['):
+		array := []interface{}{}
+		for decoder.More() {
+			value, err := decodeOrderedJSON(decoder)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err := decoder.Token() // The closing bracket
+		return array, err
+
+	default:
+		return token, nil
+	}
+}
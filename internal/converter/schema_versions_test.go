@@ -0,0 +1,93 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/chrusty/protoc-gen-jsonschema/internal/converter/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertSchemaVersion(t *testing.T) {
+
+	// A schema using draft-06 keywords (and a property which happens to be called "definitions"):
+	const jsonSchema = `{
+		"$schema": "http://json-schema.org/draft-06/schema#",
+		"$ref": "#/definitions/Order",
+		"definitions": {
+			"Order": {
+				"properties": {
+					"definitions": {"type": "string", "const": "x"},
+					"quantity": {"oneOf": [{"type": "null"}, {"type": "integer", "exclusiveMinimum": 0}]},
+					"customer": {"$ref": "#/definitions/Customer"},
+					"tags": {"type": "object", "patternProperties": {"^[0-9]+$": {"type": "string"}}, "additionalProperties": false}
+				},
+				"examples": [{"quantity": 12345678901234567890}]
+			},
+			"Customer": {"type": "object"}
+		}
+	}`
+	convert := func(target string) map[string]interface{} {
+		converter := New(newTestLogger())
+		converter.targetSchemaVersion = target
+		converted, err := converter.convertSchemaVersion("Order.json", []byte(jsonSchema))
+		require.NoError(t, err)
+		var schema map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(converted))
+		decoder.UseNumber()
+		require.NoError(t, decoder.Decode(&schema))
+		return schema
+	}
+	property := func(definitions map[string]interface{}, name string) map[string]interface{} {
+		return definitions["Order"].(map[string]interface{})["properties"].(map[string]interface{})[name].(map[string]interface{})
+	}
+
+	// Draft-04 has boolean exclusive bounds, and no const:
+	schema := convert("draft-04")
+	assert.Equal(t, versionDraft04, schema["$schema"])
+	definitions := schema["definitions"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"x"}, property(definitions, "definitions")["enum"])
+	quantity := property(definitions, "quantity")["oneOf"].([]interface{})[1].(map[string]interface{})
+	assert.Equal(t, true, quantity["exclusiveMinimum"])
+	assert.Equal(t, json.Number("0"), quantity["minimum"])
+
+	// 2020-12 keeps definitions under $defs (but leaves properties alone), and doesn't touch values:
+	schema = convert("2020-12")
+	assert.Equal(t, version202012, schema["$schema"])
+	assert.Equal(t, "#/$defs/Order", schema["$ref"])
+	assert.Nil(t, schema["definitions"])
+	definitions = schema["$defs"].(map[string]interface{})
+	assert.Equal(t, "#/$defs/Customer", property(definitions, "customer")["$ref"])
+	assert.Equal(t, "x", property(definitions, "definitions")["const"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"quantity": json.Number("12345678901234567890")}}, definitions["Order"].(map[string]interface{})["examples"])
+
+	// OpenAPI 3 keeps its schemas under components, with "nullable" instead of null types:
+	schema = convert(schemaVersionOpenAPI3)
+	assert.Nil(t, schema["$schema"])
+	definitions = schema["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	assert.Len(t, definitions, 2)
+	assert.Equal(t, map[string]interface{}{"type": "integer", "nullable": true, "exclusiveMinimum": true, "minimum": json.Number("0")}, property(definitions, "quantity"))
+	assert.Equal(t, "#/components/schemas/Customer", property(definitions, "customer")["$ref"])
+	assert.Equal(t, map[string]interface{}{"type": "object", "additionalProperties": map[string]interface{}{"type": "string"}}, property(definitions, "tags"))
+	assert.Equal(t, map[string]interface{}{"quantity": json.Number("12345678901234567890")}, definitions["Order"].(map[string]interface{})["example"])
+}
+
+func TestSchemaVersionValidation(t *testing.T) {
+
+	// Draft-06 bounds converted for draft-04 should still validate the same documents:
+	converter := New(newTestLogger())
+	converter.targetSchemaVersion = "draft-04"
+	converted, err := converter.convertSchemaVersion("ValidationOptions.json", []byte(testdata.ValidationOptions))
+	require.NoError(t, err)
+
+	valid, err := validateSchema(string(converted), testdata.ValidationOptionsPass)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	for _, document := range []string{`{"ratioWithExclusiveRangeConstraints": 1}`, `{"answerWithConstConstraint": 41}`, `{"offsetOutsideRangeConstraints": 5}`} {
+		valid, err := validateSchema(string(converted), document)
+		require.NoError(t, err)
+		assert.False(t, valid, document)
+	}
+}
@@ -0,0 +1,117 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+)
+
+const defaultSharedSchemaFileName = "common.json"
+
+// isSharedMessage tells us whether a message (by its fully-qualified name) has been hoisted into the shared schema:
+func (c *Converter) isSharedMessage(fullName string) bool {
+	return contains(c.sharedMessages, strings.TrimPrefix(fullName, "."))
+}
+
+// definitionRef returns a $ref to a definition (shared messages live in the shared schema file rather than alongside their users):
+func (c *Converter) definitionRef(refName string) string {
+	if c.isSharedMessage(refName) {
+		return fmt.Sprintf("%s%s%s", c.sharedSchemaFile(), c.refPrefix, refName)
+	}
+	return fmt.Sprintf("%s%s", c.refPrefix, refName)
+}
+
+// sharedSchemaFile returns the name of the file which shared messages are generated into:
+func (c *Converter) sharedSchemaFile() string {
+	if c.sharedSchemaFileName != "" {
+		return sanitizeFilePath(c.sharedSchemaFileName)
+	}
+	return defaultSharedSchemaFileName
+}
+
+// relativeSharedRefs makes the references a schema has to the shared schema file relative to the directory the schema
+// is generated into (definitionRef can't, because the schema's name isn't decided until it has been converted):
+func (c *Converter) relativeSharedRefs(jsonSchemaFileName string, jsonSchemaJSON []byte) ([]byte, error) {
+	schemaDir := path.Dir(jsonSchemaFileName)
+	if len(c.sharedMessages) == 0 || schemaDir == "." {
+		return jsonSchemaJSON, nil
+	}
+	sharedSchemaFile, err := filepath.Rel(filepath.FromSlash(schemaDir), filepath.FromSlash(c.sharedSchemaFile()))
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonSchemaJSON))
+	decoder.UseNumber()
+	decoded, err := decodeOrderedJSON(decoder)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := decoded.(*orderedmap.OrderedMap)
+	if !ok {
+		return jsonSchemaJSON, nil
+	}
+
+	walkSchema(root, func(schema *orderedmap.OrderedMap) {
+		if ref, ok := schema.Get("$ref"); ok {
+			if ref, ok := ref.(string); ok && strings.HasPrefix(ref, c.sharedSchemaFile()+"#") {
+				schema.Set("$ref", filepath.ToSlash(sharedSchemaFile)+strings.TrimPrefix(ref, c.sharedSchemaFile()))
+			}
+		}
+	})
+
+	return json.MarshalIndent(root, "", "    ")
+}
+
+// sharedSchema converts the shared messages into a single schema of definitions (keyed by their fully-qualified names):
+func (c *Converter) sharedSchema() (*jsonschema.Schema, error) {
+
+	// Within the shared schema everything is converted normally:
+	sharedMessages := c.sharedMessages
+	c.sharedMessages = nil
+	defer func() { c.sharedMessages = sharedMessages }()
+
+	definitions := jsonschema.Definitions{}
+	for _, sharedMessage := range sharedMessages {
+		msgDesc, pkgName, ok := c.lookupType(c.rootPkg, "."+sharedMessage)
+		if !ok {
+			return nil, fmt.Errorf("no such shared message: %s", sharedMessage)
+		}
+		pkg, ok := c.relativelyLookupPackage(c.rootPkg, strings.TrimPrefix(pkgName, "."))
+		if !ok {
+			return nil, fmt.Errorf("no such package found: %s", pkgName)
+		}
+
+		messageJSONSchema, err := c.convertMessageType(pkg, msgDesc)
+		if err != nil {
+			return nil, err
+		}
+
+		// The message is defined under its own (short) name, so alias that with its fully-qualified name:
+		if _, ok := definitions[msgDesc.GetName()]; ok {
+			return nil, fmt.Errorf("shared messages must have unique names: %s", sharedMessage)
+		}
+		for name, definition := range messageJSONSchema.Definitions {
+			definitions[name] = definition
+		}
+		if sharedMessage != msgDesc.GetName() {
+			definitions[sharedMessage] = &jsonschema.Type{Ref: fmt.Sprintf("%s%s", c.refPrefix, msgDesc.GetName())}
+		}
+
+		// Shared messages which don't allow additional properties may be Any payloads elsewhere (which need "@type"):
+		if definition := definitions[msgDesc.GetName()]; disallowsAdditionalProperties(definition) {
+			definitions[sharedMessage+typedPayloadSuffix] = typedPayloadDefinition(definition)
+		}
+	}
+
+	return &jsonschema.Schema{
+		Type:        &jsonschema.Type{Version: c.schemaVersion},
+		Definitions: definitions,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedMessagesReusingConverter(t *testing.T) {
+	request := sampleRequest(t, "shared_messages=acme.RequestHeader", "SharedMessages.proto")
+	protoConverter := New(newTestLogger())
+	response, err := protoConverter.convert(request)
+	require.NoError(t, err)
+	require.Len(t, response.File, 3)
+
+	// Converters can be used again (without the parameters of previous requests piling up):
+	secondResponse, err := protoConverter.convert(request)
+	require.NoError(t, err)
+	assert.Equal(t, response.String(), secondResponse.String())
+	unsharedResponse, err := protoConverter.convert(sampleRequest(t, "", "SharedMessages.proto"))
+	require.NoError(t, err)
+	assert.Len(t, unsharedResponse.File, 2)
+}
@@ -0,0 +1,100 @@
+package converter
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	defaultChecksumsFileName = "SHA256SUMS"
+	signatureSuffix          = ".sig"
+)
+
+// checksumsFile lists the SHA-256 digests of the generated files (in name order), in the format of sha256sum (so that
+// "sha256sum -c SHA256SUMS" verifies them):
+func checksumsFile(files []*plugin.CodeGeneratorResponse_File) *plugin.CodeGeneratorResponse_File {
+	var lines []string
+	for _, file := range files {
+		lines = append(lines, fmt.Sprintf("%x  %s\n", sha256.Sum256([]byte(file.GetContent())), file.GetName()))
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		return lines[i][sha256.Size*2+2:] < lines[j][sha256.Size*2+2:]
+	})
+
+	return &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(defaultChecksumsFileName),
+		Content: proto.String(strings.Join(lines, "")),
+	}
+}
+
+// loadSigningKey reads an (unencrypted) PEM-encoded private key to sign checksums with. ECDSA P-256 keys make signatures
+// which "cosign verify-blob --key" (and openssl) can verify, Ed25519 keys are supported too:
+func loadSigningKey(fileName string) (crypto.Signer, error) {
+	keyPEM, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip anything ahead of the key (eg the "EC PARAMETERS" which openssl writes first):
+	var key interface{}
+	for block, rest := pem.Decode(keyPEM); key == nil; block, rest = pem.Decode(rest) {
+		if block == nil {
+			return nil, fmt.Errorf("no PEM-encoded private key found (encrypted keys aren't supported)")
+		}
+		switch block.Type {
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		case "PRIVATE KEY":
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch key := key.(type) {
+	case *ecdsa.PrivateKey:
+		if key.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("ECDSA keys have to use the P-256 curve")
+		}
+		return key, nil
+	case ed25519.PrivateKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T (use ECDSA P-256 or Ed25519)", key)
+	}
+}
+
+// signatureFile makes a detached (base64-encoded) signature of a generated file:
+func signatureFile(file *plugin.CodeGeneratorResponse_File, key crypto.Signer) (*plugin.CodeGeneratorResponse_File, error) {
+	var signature []byte
+	var err error
+	if _, ok := key.(ed25519.PrivateKey); ok {
+		signature, err = key.Sign(rand.Reader, []byte(file.GetContent()), crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256([]byte(file.GetContent()))
+		signature, err = key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(file.GetName() + signatureSuffix),
+		Content: proto.String(base64.StdEncoding.EncodeToString(signature)),
+	}, nil
+}
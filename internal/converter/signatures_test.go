@@ -0,0 +1,97 @@
+package converter
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestChecksums(t *testing.T) {
+	checksums := checksumsFile([]*plugin.CodeGeneratorResponse_File{
+		{Name: proto.String("b/Order.json"), Content: proto.String("{}")},
+		{Name: proto.String("a.json"), Content: proto.String("")},
+	})
+
+	// Listed in name order (as sha256sum would write them):
+	assert.Equal(t, defaultChecksumsFileName, checksums.GetName())
+	assert.Equal(t, fmt.Sprintf("%x  a.json\n%x  b/Order.json\n", sha256.Sum256(nil), sha256.Sum256([]byte("{}"))), checksums.GetContent())
+}
+
+func TestSignatures(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "protoc-gen-jsonschema")
+	require.NoError(t, err)
+	defer os.RemoveAll(directory)
+
+	fileDescriptorSet := mustReadProtoFiles(t, sampleProtoDirectory, "PayloadMessage.proto")
+	convert := func(parameters string) (map[string]*plugin.CodeGeneratorResponse_File, error) {
+		response, err := convertTestRequest(fileRequest("PayloadMessage.proto", parameters, fileDescriptorSet.GetFile()...))
+		files := make(map[string]*plugin.CodeGeneratorResponse_File)
+		for _, file := range response.GetFile() {
+			files[file.GetName()] = file
+		}
+		return files, err
+	}
+	writeKey := func(name, blockType string, der []byte) string {
+		keyFileName := filepath.Join(directory, name)
+		require.NoError(t, ioutil.WriteFile(keyFileName, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+		return keyFileName
+	}
+
+	// Checksums cover everything generated:
+	files, err := convert("generate_checksums,generate_index")
+	require.NoError(t, err)
+	require.NotNil(t, files[defaultChecksumsFileName])
+	assert.Equal(t, checksumsFile([]*plugin.CodeGeneratorResponse_File{files["PayloadMessage.json"], files[defaultIndexFileName]}).GetContent(), files[defaultChecksumsFileName].GetContent())
+	assert.NotContains(t, files, defaultChecksumsFileName+signatureSuffix)
+
+	// ECDSA signatures (as cosign makes them) are over the SHA-256 digest of the checksums:
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecdsaDER, err := x509.MarshalECPrivateKey(ecdsaKey)
+	require.NoError(t, err)
+	files, err = convert("signing_key=" + writeKey("ecdsa.pem", "EC PRIVATE KEY", ecdsaDER))
+	require.NoError(t, err)
+	require.NotNil(t, files[defaultChecksumsFileName+signatureSuffix])
+	signature, err := base64.StdEncoding.DecodeString(files[defaultChecksumsFileName+signatureSuffix].GetContent())
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(files[defaultChecksumsFileName].GetContent()))
+	var ecdsaSignature struct{ R, S *big.Int }
+	_, err = asn1.Unmarshal(signature, &ecdsaSignature)
+	require.NoError(t, err)
+	assert.True(t, ecdsa.Verify(&ecdsaKey.PublicKey, digest[:], ecdsaSignature.R, ecdsaSignature.S))
+
+	// Ed25519 signatures are over the checksums themselves:
+	ed25519PublicKey, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ed25519DER, err := x509.MarshalPKCS8PrivateKey(ed25519Key)
+	require.NoError(t, err)
+	files, err = convert("signing_key=" + writeKey("ed25519.pem", "PRIVATE KEY", ed25519DER))
+	require.NoError(t, err)
+	signature, err = base64.StdEncoding.DecodeString(files[defaultChecksumsFileName+signatureSuffix].GetContent())
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(ed25519PublicKey, []byte(files[defaultChecksumsFileName].GetContent()), signature))
+
+	// Keys which can't be used fail the generation (rather than leaving the schemas unsigned):
+	_, err = convert("signing_key=" + writeKey("encrypted.pem", "ENCRYPTED PRIVATE KEY", []byte("secret")))
+	assert.Error(t, err)
+	_, err = convert("signing_key=" + filepath.Join(directory, "missing.pem"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/alecthomas/jsonschema"
+)
+
+const (
+	envSourceRevision     = "JSONSCHEMA_SOURCE_REVISION"
+	sourceRevisionKeyword = "x-generated-from"
+)
+
+// stampSourceRevision records the source revision (eg the output of "git describe") which a schema was generated from,
+// taken from the "source_revision" parameter or the JSONSCHEMA_SOURCE_REVISION environment variable:
+func (c *Converter) stampSourceRevision(jsonSchema interface{}) {
+	if c.sourceRevision == "" {
+		return
+	}
+
+	var jsonSchemaType *jsonschema.Type
+	switch schema := jsonSchema.(type) {
+	case *jsonschema.Schema:
+		jsonSchemaType = schema.Type
+	case *jsonschema.Type:
+		jsonSchemaType = schema
+	default:
+		return
+	}
+
+	// $comment only arrived in draft-07, so earlier drafts just get the x- keyword:
+	extras := map[string]interface{}{sourceRevisionKeyword: c.sourceRevision}
+	switch c.targetSchemaVersion {
+	case "draft-07", "2019-09", "2020-12":
+		extras["$comment"] = fmt.Sprintf("Generated from %s", c.sourceRevision)
+	}
+	setExtras(jsonSchemaType, extras)
+}
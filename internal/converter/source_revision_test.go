@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceRevisionFromEnvironment(t *testing.T) {
+
+	// Nothing gets stamped by default:
+	os.Unsetenv(envSourceRevision)
+	response, err := convertTestRequest(sampleRequest(t, "", "SourceRevision.proto"))
+	require.NoError(t, err)
+	require.Len(t, response.File, 1)
+	assert.NotContains(t, response.File[0].GetContent(), sourceRevisionKeyword)
+
+	// The revision can be given in the environment (as well as with the parameter, see the SourceRevision sample):
+	os.Setenv(envSourceRevision, "abc1234")
+	defer os.Unsetenv(envSourceRevision)
+	response, err = convertTestRequest(sampleRequest(t, "", "SourceRevision.proto"))
+	require.NoError(t, err)
+	require.Len(t, response.File, 1)
+	assert.Contains(t, response.File[0].GetContent(), `"x-generated-from": "abc1234"`)
+}
@@ -3,23 +3,28 @@ package converter
 import (
 	"strings"
 
+	"github.com/alecthomas/jsonschema"
 	"github.com/fatih/camelcase"
 	"github.com/iancoleman/strcase"
 	"google.golang.org/protobuf/proto"
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
 )
 
+const protoCommentKeyword = "x-proto-comment"
+
 // Protobuf tag values for relevant message fields. Full list here:
 //
 //	https://github.com/protocolbuffers/protobuf/blob/master/src/google/protobuf/descriptor.proto
 const (
 	tag_FileDescriptor_messageType int32 = 4
 	tag_FileDescriptor_enumType    int32 = 5
+	tag_FileDescriptor_service     int32 = 6
 	tag_Descriptor_field           int32 = 2
 	tag_Descriptor_nestedType      int32 = 3
 	tag_Descriptor_enumType        int32 = 4
 	tag_Descriptor_oneofDecl       int32 = 8
 	tag_EnumDescriptor_value       int32 = 2
+	tag_ServiceDescriptor_method   int32 = 2
 )
 
 type sourceCodeInfo struct {
@@ -42,6 +47,14 @@ func (s sourceCodeInfo) GetEnumValue(e *descriptor.EnumValueDescriptorProto) *de
 	return s.lookup[e]
 }
 
+func (s sourceCodeInfo) GetService(sv *descriptor.ServiceDescriptorProto) *descriptor.SourceCodeInfo_Location {
+	return s.lookup[sv]
+}
+
+func (s sourceCodeInfo) GetMethod(m *descriptor.MethodDescriptorProto) *descriptor.SourceCodeInfo_Location {
+	return s.lookup[m]
+}
+
 func newSourceCodeInfo(fs []*descriptor.FileDescriptorProto) *sourceCodeInfo {
 	// For each source location in the provided files
 	// - resolve the (annoyingly) encoded path to its message/field/service/enum/etc definition
@@ -82,6 +95,9 @@ func getDefinitionAtPath(file *descriptor.FileDescriptorProto, path []int32) pro
 			case tag_FileDescriptor_enumType:
 				step++
 				pos = p.EnumType[path[step]]
+			case tag_FileDescriptor_service:
+				step++
+				pos = p.Service[path[step]]
 			default:
 				return nil // ignore all other types
 			}
@@ -113,6 +129,15 @@ func getDefinitionAtPath(file *descriptor.FileDescriptorProto, path []int32) pro
 				return nil // ignore all other types
 			}
 
+		case *descriptor.ServiceDescriptorProto:
+			switch path[step] {
+			case tag_ServiceDescriptor_method:
+				step++
+				pos = p.Method[path[step]]
+			default:
+				return nil // ignore all other types
+			}
+
 		default:
 			return nil // ignore all other types
 		}
@@ -162,9 +187,26 @@ func (c *Converter) formatTitleAndDescription(name *string, sl *descriptor.Sourc
 		return title, ""
 	}
 
+	// Comments in several languages can be narrowed down to one:
+	description = c.localizeDescription(description)
+
 	return
 }
 
+// setDescription puts a description (made from proto comments) onto a schema, or under "x-proto-comment" instead
+// if consumers reserve "description" for themselves. Comments in several languages also get an "x-descriptions" map:
+func (c *Converter) setDescription(jsonSchemaType *jsonschema.Type, description string) {
+	if untagged, byLanguage := splitLanguages(description); len(byLanguage) > 0 {
+		setExtras(jsonSchemaType, map[string]interface{}{localizedDescriptionsKeyword: byLanguage})
+		description = untagged
+	}
+	if c.Flags.CommentsAsExtension && description != "" {
+		setExtras(jsonSchemaType, map[string]interface{}{protoCommentKeyword: description})
+		return
+	}
+	jsonSchemaType.Description = description
+}
+
 // Go doesn't have syntax for addressing a string literal, so this is the next best thing.
 func strPtr(s string) *string {
 	return &s
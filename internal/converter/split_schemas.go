@@ -0,0 +1,87 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// propertyGroup is a set of properties which get factored out of a large message into their own definition:
+type propertyGroup struct {
+	name       string
+	properties []string
+}
+
+// splitLargeMessage factors the properties of messages with more than splitThreshold properties out into groups
+// (one for each oneof, then chunks of the remaining fields), which are added to the definitions and composed back together with "allOf":
+func (c *Converter) splitLargeMessage(msgDesc *descriptor.DescriptorProto, name string, jsonSchemaType *jsonschema.Type, definitions jsonschema.Definitions) {
+	if c.splitThreshold <= 0 || jsonSchemaType.Properties == nil || len(jsonSchemaType.Properties.Keys()) <= c.splitThreshold {
+		return
+	}
+
+	// Composed schemas can't forbid additional properties (without "unevaluatedProperties", which draft-04 doesn't have):
+	if disallowsAdditionalProperties(jsonSchemaType) {
+		c.logger.WithField("message_name", name).Warn("Not splitting a large message which disallows additional properties")
+		return
+	}
+
+	// Group the fields of each (real) oneof together, then chunk up whatever is left:
+	oneofGroups := make(map[int32]*propertyGroup)
+	var groups []*propertyGroup
+	var remainingProperties []string
+	for _, fieldDesc := range msgDesc.GetField() {
+		for _, propertyName := range c.fieldNames(fieldDesc) {
+			if _, ok := jsonSchemaType.Properties.Get(propertyName); !ok {
+				continue
+			}
+			if fieldDesc.OneofIndex == nil || fieldDesc.GetProto3Optional() {
+				remainingProperties = append(remainingProperties, propertyName)
+				continue
+			}
+			group, ok := oneofGroups[fieldDesc.GetOneofIndex()]
+			if !ok {
+				group = &propertyGroup{name: fmt.Sprintf("%s.%s", name, msgDesc.GetOneofDecl()[fieldDesc.GetOneofIndex()].GetName())}
+				oneofGroups[fieldDesc.GetOneofIndex()] = group
+				groups = append(groups, group)
+			}
+			group.properties = append(group.properties, propertyName)
+		}
+	}
+	for part := 1; len(remainingProperties) > 0; part++ {
+		chunkSize := c.splitThreshold
+		if chunkSize > len(remainingProperties) {
+			chunkSize = len(remainingProperties)
+		}
+		groups = append(groups, &propertyGroup{name: fmt.Sprintf("%s.part%d", name, part), properties: remainingProperties[:chunkSize]})
+		remainingProperties = remainingProperties[chunkSize:]
+	}
+
+	// Move each group of properties (and their requirements) into its own definition:
+	for _, group := range groups {
+		groupJSONSchemaType := &jsonschema.Type{Properties: orderedmap.New()}
+		for _, propertyName := range group.properties {
+			property, _ := jsonSchemaType.Properties.Get(propertyName)
+			groupJSONSchemaType.Properties.Set(propertyName, property)
+			jsonSchemaType.Properties.Delete(propertyName)
+			if contains(jsonSchemaType.Required, propertyName) {
+				groupJSONSchemaType.Required = append(groupJSONSchemaType.Required, propertyName)
+			}
+		}
+		definitions[group.name] = groupJSONSchemaType
+		jsonSchemaType.AllOf = append(jsonSchemaType.AllOf, &jsonschema.Type{Ref: fmt.Sprintf("%s%s", c.refPrefix, group.name)})
+	}
+
+	// Only keep the requirements (and properties) which didn't get moved:
+	var required []string
+	for _, propertyName := range jsonSchemaType.Required {
+		if _, ok := jsonSchemaType.Properties.Get(propertyName); ok {
+			required = append(required, propertyName)
+		}
+	}
+	jsonSchemaType.Required = required
+	if len(jsonSchemaType.Properties.Keys()) == 0 {
+		jsonSchemaType.Properties = nil
+	}
+}
@@ -0,0 +1,51 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	protoc_gen_jsonschema "github.com/chrusty/protoc-gen-jsonschema"
+)
+
+const (
+	stabilityAlpha   = "alpha"
+	stabilityBeta    = "beta"
+	stabilityKeyword = "x-stability"
+	stabilityStable  = "stable"
+)
+
+// fieldStability returns the stability level a field has been annotated with:
+func (c *Converter) fieldStability(fieldDesc *descriptor.FieldDescriptorProto) string {
+	if opt := proto.GetExtension(fieldDesc.GetOptions(), protoc_gen_jsonschema.E_FieldOptions); opt != nil {
+		if fieldOptions, ok := opt.(*protoc_gen_jsonschema.FieldOptions); ok {
+			return c.validStability(fieldDesc.GetName(), fieldOptions.GetStability())
+		}
+	}
+	return ""
+}
+
+// messageStability returns the stability level a message has been annotated with:
+func (c *Converter) messageStability(msgDesc *descriptor.DescriptorProto) string {
+	if opt := proto.GetExtension(msgDesc.GetOptions(), protoc_gen_jsonschema.E_MessageOptions); opt != nil {
+		if messageOptions, ok := opt.(*protoc_gen_jsonschema.MessageOptions); ok {
+			return c.validStability(msgDesc.GetName(), messageOptions.GetStability())
+		}
+	}
+	return ""
+}
+
+// validStability warns about (and ignores) unrecognised stability levels:
+func (c *Converter) validStability(name, stability string) string {
+	switch stability {
+	case "", stabilityAlpha, stabilityBeta, stabilityStable:
+		return stability
+	default:
+		c.logger.WithField("name", name).WithField("stability", stability).Warn("Ignoring unrecognised stability level (expected alpha, beta or stable)")
+		return ""
+	}
+}
+
+// excludedAsAlpha tells us whether an element with the given stability should be left out of generated schemas:
+func (c *Converter) excludedAsAlpha(stability string) bool {
+	return c.Flags.ExcludeAlpha && stability == stabilityAlpha
+}
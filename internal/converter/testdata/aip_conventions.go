@@ -0,0 +1,95 @@
+package testdata
+
+const ListBooksRequest = `{
+    "$ref": "#/definitions/ListBooksRequest",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "ListBooksRequest": {
+            "additionalProperties": true,
+            "properties": {
+                "page_size": {
+                    "description": "The maximum number of results to return (the service may return fewer than this, and chooses a default when unset).",
+                    "minimum": 0,
+                    "type": "integer"
+                },
+                "page_token": {
+                    "description": "A page token received from a previous list call (provide this to retrieve the subsequent page).",
+                    "type": "string"
+                },
+                "read_mask": {
+                    "description": "A comma-separated list of fully qualified field names (eg \"title,author.name\").",
+                    "format": "google-fieldmask",
+                    "type": "string"
+                }
+            },
+            "title": "List Books Request",
+            "type": "object"
+        }
+    }
+}
+`
+
+const ListBooksResponse = `{
+    "$ref": "#/definitions/ListBooksResponse",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "ListBooksResponse": {
+            "additionalProperties": true,
+            "properties": {
+                "next_page_token": {
+                    "description": "A token which can be sent to retrieve the next page (empty if there are no more pages).",
+                    "type": "string"
+                }
+            },
+            "title": "List Books Response",
+            "type": "object"
+        }
+    }
+}
+`
+
+const ListBooksRequestWithoutConventions = `{
+    "$ref": "#/definitions/ListBooksRequest",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "ListBooksRequest": {
+            "additionalProperties": true,
+            "properties": {
+                "page_size": {
+                    "type": "integer"
+                },
+                "page_token": {
+                    "type": "string"
+                },
+                "read_mask": {
+                    "type": "string"
+                }
+            },
+            "title": "List Books Request",
+            "type": "object"
+        }
+    }
+}
+`
+
+const ListBooksResponseWithoutConventions = `{
+    "$ref": "#/definitions/ListBooksResponse",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "ListBooksResponse": {
+            "additionalProperties": true,
+            "properties": {
+                "next_page_token": {
+                    "type": "string"
+                }
+            },
+            "title": "List Books Response",
+            "type": "object"
+        }
+    }
+}
+`
+
+const ListBooksRequestFail = `{"page_size": -1}`
+
+const ListBooksRequestPass = `{"page_size": 20, "page_token": "abc", "read_mask": "title,author"}`
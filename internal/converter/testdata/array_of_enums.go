@@ -1,10 +1,11 @@
 package testdata
 
 const ArrayOfEnums = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/ArrayOfEnums",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "ArrayOfEnums": {
+            "additionalProperties": true,
             "properties": {
                 "description": {
                     "type": "string"
@@ -20,18 +21,26 @@ const ArrayOfEnums = `{
                             2,
                             "BUZZ",
                             3
+                        ],
+                        "oneOf": [
+                            {
+                                "type": "string"
+                            },
+                            {
+                                "type": "integer"
+                            }
                         ]
                     },
-                    "type": "array",
-                    "title": "Inline"
+                    "title": "Inline",
+                    "type": "array"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Array Of Enums"
+            "title": "Array Of Enums",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const ArrayOfEnumsFail = `{
     "description": "something",
@@ -1,10 +1,11 @@
 package testdata
 
 const ArrayOfMessages = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/ArrayOfMessages",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "ArrayOfMessages": {
+            "additionalProperties": true,
             "properties": {
                 "description": {
                     "type": "string"
@@ -16,26 +17,26 @@ const ArrayOfMessages = `{
                     "type": "array"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Array Of Messages"
+            "title": "Array Of Messages",
+            "type": "object"
         },
         "samples.PayloadMessage": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 },
                 "topology": {
                     "enum": [
@@ -63,12 +64,12 @@ const ArrayOfMessages = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Payload Message"
+            "title": "Payload Message",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const ArrayOfMessagesFail = `{
     "description": "something",
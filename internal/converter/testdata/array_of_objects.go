@@ -1,10 +1,19 @@
 package testdata
 
 const ArrayOfObjects = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/ArrayOfObjects",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "ArrayOfObjects": {
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ],
             "properties": {
                 "description": {
                     "oneOf": [
@@ -30,6 +39,9 @@ const ArrayOfObjects = `{
                     ]
                 }
             },
+            "title": "Array Of Objects"
+        },
+        "samples.ArrayOfObjects.RepeatedPayload": {
             "additionalProperties": true,
             "oneOf": [
                 {
@@ -39,37 +51,34 @@ const ArrayOfObjects = `{
                     "type": "object"
                 }
             ],
-            "title": "Array Of Objects"
-        },
-        "samples.ArrayOfObjects.RepeatedPayload": {
             "properties": {
-                "name": {
+                "complete": {
                     "oneOf": [
                         {
                             "type": "null"
                         },
                         {
-                            "type": "string"
+                            "type": "boolean"
                         }
                     ]
                 },
-                "timestamp": {
+                "id": {
                     "oneOf": [
                         {
                             "type": "null"
                         },
                         {
-                            "type": "string"
+                            "type": "integer"
                         }
                     ]
                 },
-                "id": {
+                "name": {
                     "oneOf": [
                         {
                             "type": "null"
                         },
                         {
-                            "type": "integer"
+                            "type": "string"
                         }
                     ]
                 },
@@ -83,13 +92,13 @@ const ArrayOfObjects = `{
                         }
                     ]
                 },
-                "complete": {
+                "timestamp": {
                     "oneOf": [
                         {
                             "type": "null"
                         },
                         {
-                            "type": "boolean"
+                            "type": "string"
                         }
                     ]
                 },
@@ -122,19 +131,11 @@ const ArrayOfObjects = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "oneOf": [
-                {
-                    "type": "null"
-                },
-                {
-                    "type": "object"
-                }
-            ],
             "title": "Repeated Payload"
         }
     }
-}`
+}
+`
 
 const ArrayOfObjectsFail = `{
     "description": "something",
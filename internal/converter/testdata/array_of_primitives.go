@@ -1,11 +1,34 @@
 package testdata
 
 const ArrayOfPrimitives = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/ArrayOfPrimitives",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "ArrayOfPrimitives": {
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ],
             "properties": {
+                "big_number": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^-?[0-9]+$",
+                            "type": "string"
+                        },
+                        {
+                            "type": "null"
+                        }
+                    ]
+                },
                 "description": {
                     "oneOf": [
                         {
@@ -16,14 +39,14 @@ const ArrayOfPrimitives = `{
                         }
                     ]
                 },
-                "luckyNumbers": {
+                "keyWords": {
                     "items": {
                         "oneOf": [
                             {
                                 "type": "null"
                             },
                             {
-                                "type": "integer"
+                                "type": "string"
                             }
                         ]
                     },
@@ -40,6 +63,10 @@ const ArrayOfPrimitives = `{
                     "items": {
                         "oneOf": [
                             {
+                                "type": "integer"
+                            },
+                            {
+                                "pattern": "^-?[0-9]+$",
                                 "type": "string"
                             },
                             {
@@ -56,14 +83,14 @@ const ArrayOfPrimitives = `{
                         }
                     ]
                 },
-                "keyWords": {
+                "luckyNumbers": {
                     "items": {
                         "oneOf": [
                             {
                                 "type": "null"
                             },
                             {
-                                "type": "string"
+                                "type": "integer"
                             }
                         ]
                     },
@@ -75,42 +102,61 @@ const ArrayOfPrimitives = `{
                             "type": "array"
                         }
                     ]
-                },
-                "big_number": {
-                    "oneOf": [
-                        {
-                            "type": "string"
-                        },
-                        {
-                            "type": "null"
-                        }
-                    ]
                 }
             },
-            "additionalProperties": true,
-            "oneOf": [
-                {
-                    "type": "null"
-                },
-                {
-                    "type": "object"
-                }
-            ],
             "title": "Array Of Primitives"
         }
     }
-}`
+}
+`
 
 const ArrayOfPrimitivesFail = `{"luckyNumbers": ["false"]}`
 
 const ArrayOfPrimitivesPass = `{"luckyNumbers": [1,2,3]}`
 
 const ArrayOfPrimitivesDouble = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/ArrayOfPrimitives",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "ArrayOfPrimitives": {
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ],
             "properties": {
+                "bigNumber": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^-?[0-9]+$",
+                            "type": "string"
+                        },
+                        {
+                            "type": "null"
+                        }
+                    ]
+                },
+                "big_number": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^-?[0-9]+$",
+                            "type": "string"
+                        },
+                        {
+                            "type": "null"
+                        }
+                    ]
+                },
                 "description": {
                     "oneOf": [
                         {
@@ -121,14 +167,14 @@ const ArrayOfPrimitivesDouble = `{
                         }
                     ]
                 },
-                "luckyNumbers": {
+                "keyWords": {
                     "items": {
                         "oneOf": [
                             {
                                 "type": "null"
                             },
                             {
-                                "type": "integer"
+                                "type": "string"
                             }
                         ]
                     },
@@ -145,6 +191,10 @@ const ArrayOfPrimitivesDouble = `{
                     "items": {
                         "oneOf": [
                             {
+                                "type": "integer"
+                            },
+                            {
+                                "pattern": "^-?[0-9]+$",
                                 "type": "string"
                             },
                             {
@@ -161,14 +211,14 @@ const ArrayOfPrimitivesDouble = `{
                         }
                     ]
                 },
-                "keyWords": {
+                "luckyNumbers": {
                     "items": {
                         "oneOf": [
                             {
                                 "type": "null"
                             },
                             {
-                                "type": "string"
+                                "type": "integer"
                             }
                         ]
                     },
@@ -180,41 +230,13 @@ const ArrayOfPrimitivesDouble = `{
                             "type": "array"
                         }
                     ]
-                },
-                "big_number": {
-                    "oneOf": [
-                        {
-                            "type": "string"
-                        },
-                        {
-                            "type": "null"
-                        }
-                    ]
-                },
-                "bigNumber": {
-                    "oneOf": [
-                        {
-                            "type": "string"
-                        },
-                        {
-                            "type": "null"
-                        }
-                    ]
                 }
             },
-            "additionalProperties": true,
-            "oneOf": [
-                {
-                    "type": "null"
-                },
-                {
-                    "type": "object"
-                }
-            ],
             "title": "Array Of Primitives"
         }
     }
-}`
+}
+`
 
 const ArrayOfPrimitivesDoubleFail = `{"bigNumber": false}`
 
@@ -1,10 +1,19 @@
 package testdata
 
 const BigIntAsString = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/BigIntAsString",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "BigIntAsString": {
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ],
             "properties": {
                 "big_number": {
                     "oneOf": [
@@ -17,20 +26,64 @@ const BigIntAsString = `{
                     ]
                 }
             },
-            "additionalProperties": true,
-            "oneOf": [
-                {
-                    "type": "null"
-                },
-                {
-                    "type": "object"
-                }
-            ],
             "title": "Big Int As String"
         }
     }
-}`
+}
+`
 
 const BigIntAsStringFail = `{"big_number": "1827634182736443333"}`
 
 const BigIntAsStringPass = `{"big_number": 1827634182736443333}`
+
+const BigIntAsStringFastify = `{
+    "$ref": "#/definitions/BigIntAsString",
+    "$schema": "http://json-schema.org/draft-07/schema#",
+    "definitions": {
+        "BigIntAsString": {
+            "additionalProperties": true,
+            "properties": {
+                "bigNumber": {
+                    "pattern": "^-?[0-9]+$",
+                    "type": [
+                        "integer",
+                        "string",
+                        "null"
+                    ]
+                }
+            },
+            "title": "Big Int As String",
+            "type": [
+                "null",
+                "object"
+            ]
+        }
+    }
+}
+`
+
+const BigIntAsStringTypeArrays = `{
+    "$ref": "#/definitions/BigIntAsString",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "BigIntAsString": {
+            "additionalProperties": true,
+            "properties": {
+                "big_number": {
+                    "pattern": "^-?[0-9]+$",
+                    "type": [
+                        "integer",
+                        "string",
+                        "null"
+                    ]
+                }
+            },
+            "title": "Big Int As String",
+            "type": [
+                "null",
+                "object"
+            ]
+        }
+    }
+}
+`
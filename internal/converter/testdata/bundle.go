@@ -0,0 +1,91 @@
+package testdata
+
+const BundleFileInvoice = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Invoice": {
+            "additionalProperties": true,
+            "properties": {
+                "number": {
+                    "type": "string"
+                }
+            },
+            "title": "Invoice",
+            "type": "object"
+        }
+    }
+}
+`
+
+const BundleFileOrder = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Customer": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Customer",
+            "type": "object"
+        },
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const BundlePackage = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Customer": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Customer",
+            "type": "object"
+        },
+        "Invoice": {
+            "additionalProperties": true,
+            "properties": {
+                "number": {
+                    "type": "string"
+                }
+            },
+            "title": "Invoice",
+            "type": "object"
+        },
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const BundlePackageIndex = `{
+    "acme.Customer": "acme.json",
+    "acme.Invoice": "acme.json",
+    "acme.Order": "acme.json"
+}
+`
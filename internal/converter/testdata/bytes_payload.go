@@ -1,25 +1,29 @@
 package testdata
 
 const BytesPayload = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/BytesPayload",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "BytesPayload": {
+            "additionalProperties": true,
             "properties": {
                 "description": {
                     "type": "string"
                 },
                 "payload": {
-                    "type": "string",
+                    "contentEncoding": "base64",
                     "format": "binary",
-                    "binaryEncoding": "base64"
+                    "pattern": "^[A-Za-z0-9+/_-]*={0,2}$",
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Bytes Payload"
+            "title": "Bytes Payload",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const BytesPayloadFail = `{"payload": 12345}`
+
+const BytesPayloadPass = `{"payload": "aGVsbG8gd29ybGQ="}`
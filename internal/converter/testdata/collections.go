@@ -0,0 +1,230 @@
+package testdata
+
+const CollectionsPlaceOrderRequest = `{
+    "$ref": "#/definitions/PlaceOrderRequest",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "PlaceOrderRequest": {
+            "additionalProperties": true,
+            "properties": {
+                "customer": {
+                    "type": "string"
+                },
+                "items": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                }
+            },
+            "title": "Place Order Request",
+            "type": "object"
+        }
+    }
+}
+`
+
+const CollectionsOrder = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const Collections = `{
+    "info": {
+        "name": "samples",
+        "schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+    },
+    "item": [
+        {
+            "description": "Manages orders placed by customers.",
+            "item": [
+                {
+                    "name": "PlaceOrder",
+                    "request": {
+                        "body": {
+                            "mode": "raw",
+                            "options": {
+                                "raw": {
+                                    "language": "json"
+                                }
+                            },
+                            "raw": "{\n    \"customer\": \"\",\n    \"items\": [\n        \"\"\n    ]\n}"
+                        },
+                        "description": "Places an order. The order is checked before it is accepted.",
+                        "header": [
+                            {
+                                "key": "Content-Type",
+                                "value": "application/json"
+                            }
+                        ],
+                        "method": "POST",
+                        "url": {
+                            "host": [
+                                "{{baseUrl}}"
+                            ],
+                            "path": [
+                                "samples.OrderService",
+                                "PlaceOrder"
+                            ],
+                            "raw": "{{baseUrl}}/samples.OrderService/PlaceOrder"
+                        }
+                    }
+                }
+            ],
+            "name": "OrderService"
+        }
+    ],
+    "variable": [
+        {
+            "key": "baseUrl",
+            "value": "http://localhost"
+        }
+    ]
+}
+`
+
+const CollectionsHTTP = `{
+    "__export_format": 4,
+    "__export_source": "protoc-gen-jsonschema",
+    "_type": "export",
+    "resources": [
+        {
+            "_id": "wrk_samples",
+            "_type": "workspace",
+            "name": "samples"
+        },
+        {
+            "_id": "env_samples",
+            "_type": "environment",
+            "data": {
+                "baseUrl": "http://localhost",
+                "customer": "",
+                "parent": ""
+            },
+            "name": "Base Environment",
+            "parentId": "wrk_samples"
+        },
+        {
+            "_id": "fld_samples.OrderService",
+            "_type": "request_group",
+            "description": "Manages orders placed by customers.",
+            "name": "OrderService",
+            "parentId": "wrk_samples"
+        },
+        {
+            "_id": "req_samples.OrderService.PlaceOrder_0",
+            "_type": "request",
+            "body": {
+                "mimeType": "application/json",
+                "text": "{\n    \"customer\": \"\",\n    \"items\": [\n        \"\"\n    ]\n}"
+            },
+            "description": "Places an order. The order is checked before it is accepted.",
+            "headers": [
+                {
+                    "name": "Content-Type",
+                    "value": "application/json"
+                }
+            ],
+            "method": "POST",
+            "name": "PlaceOrder",
+            "parentId": "fld_samples.OrderService",
+            "url": "{{ _.baseUrl }}/v1/{{ _.customer }}/orders"
+        },
+        {
+            "_id": "req_samples.OrderService.PlaceOrder_1",
+            "_type": "request",
+            "description": "Places an order. The order is checked before it is accepted.",
+            "method": "GET",
+            "name": "PlaceOrder",
+            "parentId": "fld_samples.OrderService",
+            "url": "{{ _.baseUrl }}/v1/{{ _.parent }}/orders"
+        }
+    ]
+}
+`
+
+const CollectionsNoPackageOrder = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const CollectionsNoPackage = `{
+    "info": {
+        "name": "",
+        "schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+    },
+    "item": [
+        {
+            "item": [
+                {
+                    "name": "PlaceOrder",
+                    "request": {
+                        "body": {
+                            "mode": "raw",
+                            "options": {
+                                "raw": {
+                                    "language": "json"
+                                }
+                            },
+                            "raw": "{\n    \"id\": \"\"\n}"
+                        },
+                        "header": [
+                            {
+                                "key": "Content-Type",
+                                "value": "application/json"
+                            }
+                        ],
+                        "method": "POST",
+                        "url": {
+                            "host": [
+                                "{{baseUrl}}"
+                            ],
+                            "path": [
+                                "OrderService",
+                                "PlaceOrder"
+                            ],
+                            "raw": "{{baseUrl}}/OrderService/PlaceOrder"
+                        }
+                    }
+                }
+            ],
+            "name": "OrderService"
+        }
+    ],
+    "variable": [
+        {
+            "key": "baseUrl",
+            "value": "http://localhost"
+        }
+    ]
+}
+`
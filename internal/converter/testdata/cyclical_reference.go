@@ -1,35 +1,36 @@
 package testdata
 
 const CyclicalReferenceMessageM = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/M",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "M": {
+            "additionalProperties": true,
             "properties": {
                 "foo": {
                     "$ref": "#/definitions/samples.Foo",
                     "additionalProperties": true
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "M"
+            "title": "M",
+            "type": "object"
         },
         "samples.Bar": {
+            "additionalProperties": true,
             "properties": {
-                "id": {
-                    "type": "integer"
-                },
                 "baz": {
                     "$ref": "#/definitions/samples.Baz",
                     "additionalProperties": true
+                },
+                "id": {
+                    "type": "integer"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Bar"
+            "title": "Bar",
+            "type": "object"
         },
         "samples.Baz": {
+            "additionalProperties": true,
             "properties": {
                 "enabled": {
                     "type": "boolean"
@@ -39,64 +40,65 @@ const CyclicalReferenceMessageM = `{
                     "additionalProperties": true
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Baz"
+            "title": "Baz",
+            "type": "object"
         },
         "samples.Foo": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
                 "bar": {
                     "items": {
                         "$ref": "#/definitions/samples.Bar"
                     },
                     "type": "array"
+                },
+                "name": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Foo"
+            "title": "Foo",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const CyclicalReferenceMessageFoo = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/Foo",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "Foo": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
                 "bar": {
                     "items": {
                         "$ref": "#/definitions/samples.Bar"
                     },
                     "type": "array"
+                },
+                "name": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Foo"
+            "title": "Foo",
+            "type": "object"
         },
         "samples.Bar": {
+            "additionalProperties": true,
             "properties": {
-                "id": {
-                    "type": "integer"
-                },
                 "baz": {
                     "$ref": "#/definitions/samples.Baz",
                     "additionalProperties": true
+                },
+                "id": {
+                    "type": "integer"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Bar"
+            "title": "Bar",
+            "type": "object"
         },
         "samples.Baz": {
+            "additionalProperties": true,
             "properties": {
                 "enabled": {
                     "type": "boolean"
@@ -106,32 +108,33 @@ const CyclicalReferenceMessageFoo = `{
                     "additionalProperties": true
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Baz"
+            "title": "Baz",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const CyclicalReferenceMessageBar = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/Bar",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "Bar": {
+            "additionalProperties": true,
             "properties": {
-                "id": {
-                    "type": "integer"
-                },
                 "baz": {
                     "$ref": "#/definitions/samples.Baz",
                     "additionalProperties": true
+                },
+                "id": {
+                    "type": "integer"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Bar"
+            "title": "Bar",
+            "type": "object"
         },
         "samples.Baz": {
+            "additionalProperties": true,
             "properties": {
                 "enabled": {
                     "type": "boolean"
@@ -141,34 +144,35 @@ const CyclicalReferenceMessageBar = `{
                     "additionalProperties": true
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Baz"
+            "title": "Baz",
+            "type": "object"
         },
         "samples.Foo": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
                 "bar": {
                     "items": {
                         "$ref": "#/definitions/Bar"
                     },
                     "type": "array"
+                },
+                "name": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Foo"
+            "title": "Foo",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const CyclicalReferenceMessageBaz = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/Baz",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "Baz": {
+            "additionalProperties": true,
             "properties": {
                 "enabled": {
                     "type": "boolean"
@@ -178,39 +182,39 @@ const CyclicalReferenceMessageBaz = `{
                     "additionalProperties": true
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Baz"
+            "title": "Baz",
+            "type": "object"
         },
         "samples.Bar": {
+            "additionalProperties": true,
             "properties": {
-                "id": {
-                    "type": "integer"
-                },
                 "baz": {
                     "$ref": "#/definitions/Baz",
                     "additionalProperties": true
+                },
+                "id": {
+                    "type": "integer"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Bar"
+            "title": "Bar",
+            "type": "object"
         },
         "samples.Foo": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
                 "bar": {
                     "items": {
                         "$ref": "#/definitions/samples.Bar"
                     },
                     "type": "array"
+                },
+                "name": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Foo"
+            "title": "Foo",
+            "type": "object"
         }
     }
-}`
+}
+`
@@ -0,0 +1,75 @@
+package testdata
+
+const DeprecatedCurrentOrder = `{
+    "$ref": "#/definitions/CurrentOrder",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "CurrentOrder": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Current Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const DeprecatedLegacyOrder = `{
+    "$ref": "#/definitions/LegacyOrder",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "LegacyOrder": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Legacy Order",
+            "type": "object"
+        }
+    },
+    "x-deprecated": true
+}
+`
+
+const DeprecatedFile = `{
+    "$ref": "#/definitions/CurrentOrder",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "CurrentOrder": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Current Order",
+            "type": "object"
+        }
+    },
+    "x-deprecated": true
+}
+`
+
+const DeprecatedSkipped = `{
+    "$ref": "#/definitions/CurrentOrder",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "CurrentOrder": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Current Order",
+            "type": "object"
+        }
+    }
+}
+`
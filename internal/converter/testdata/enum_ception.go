@@ -1,27 +1,17 @@
 package testdata
 
 const EnumCeption = `{
-    "$schema": "http://json-schema.org/draft-06/schema#",
     "$ref": "#/definitions/Enumception",
+    "$schema": "http://json-schema.org/draft-06/schema#",
     "definitions": {
         "Enumception": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "rating": {
-                    "type": "number"
-                },
                 "complete": {
                     "type": "boolean"
                 },
                 "failureMode": {
+                    "description": "FailureModes enum",
                     "enum": [
                         "RECURSION_ERROR",
                         0,
@@ -36,20 +26,13 @@ const EnumCeption = `{
                             "type": "integer"
                         }
                     ],
-                    "title": "Failure Modes",
-                    "description": "FailureModes enum"
+                    "title": "Failure Modes"
                 },
-                "payload": {
-                    "$ref": "#/definitions/samples.PayloadMessage",
-                    "additionalProperties": true
-                },
-                "payloads": {
-                    "items": {
-                        "$ref": "#/definitions/samples.PayloadMessage"
-                    },
-                    "type": "array"
+                "id": {
+                    "type": "integer"
                 },
                 "importedEnum": {
+                    "description": "This is an enum",
                     "enum": [
                         "VALUE_0",
                         0,
@@ -62,62 +45,80 @@ const EnumCeption = `{
                     ],
                     "oneOf": [
                         {
-                            "description": "Zero",
-                            "const": "VALUE_0"
+                            "const": "VALUE_0",
+                            "description": "Zero"
                         },
                         {
-                            "description": "Zero",
-                            "const": 0
+                            "const": 0,
+                            "description": "Zero"
                         },
                         {
-                            "description": "One",
-                            "const": "VALUE_1"
+                            "const": "VALUE_1",
+                            "description": "One"
                         },
                         {
-                            "description": "One",
-                            "const": 1
+                            "const": 1,
+                            "description": "One"
                         },
                         {
-                            "description": "Two",
-                            "const": "VALUE_2"
+                            "const": "VALUE_2",
+                            "description": "Two"
                         },
                         {
-                            "description": "Two",
-                            "const": 2
+                            "const": 2,
+                            "description": "Two"
                         },
                         {
-                            "description": "Three",
-                            "const": "VALUE_3"
+                            "const": "VALUE_3",
+                            "description": "Three"
                         },
                         {
-                            "description": "Three",
-                            "const": 3
+                            "const": 3,
+                            "description": "Three"
                         }
                     ],
-                    "title": "Imported Enum",
-                    "description": "This is an enum"
-                }
-            },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Enumception"
-        },
-        "samples.PayloadMessage": {
-            "properties": {
+                    "title": "Imported Enum"
+                },
                 "name": {
                     "type": "string"
                 },
+                "payload": {
+                    "$ref": "#/definitions/samples.PayloadMessage",
+                    "additionalProperties": true
+                },
+                "payloads": {
+                    "items": {
+                        "$ref": "#/definitions/samples.PayloadMessage"
+                    },
+                    "type": "array"
+                },
+                "rating": {
+                    "type": "number"
+                },
                 "timestamp": {
                     "type": "string"
+                }
+            },
+            "title": "Enumception",
+            "type": "object"
+        },
+        "samples.PayloadMessage": {
+            "additionalProperties": true,
+            "properties": {
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 },
                 "topology": {
                     "enum": [
@@ -145,12 +146,12 @@ const EnumCeption = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Payload Message"
+            "title": "Payload Message",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const EnumCeptionFail = `{"payloads": [ {"topology": "MAP"} ]}`
 
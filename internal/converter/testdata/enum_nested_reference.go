@@ -1,10 +1,11 @@
 package testdata
 
 const EnumNestedReference = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/Msg",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "Msg": {
+            "additionalProperties": true,
             "properties": {
                 "nestedEnumField": {
                     "enum": [
@@ -32,12 +33,12 @@ const EnumNestedReference = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Msg"
+            "title": "Msg",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const EnumNestedReferenceFail = `{"nestedEnumField": 8}`
 
@@ -1,10 +1,11 @@
 package testdata
 
 const EnumWithMessage = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/WithFooBarBaz",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "WithFooBarBaz": {
+            "additionalProperties": true,
             "properties": {
                 "enumField": {
                     "enum": [
@@ -26,12 +27,12 @@ const EnumWithMessage = `{
                     "title": "Foo Bar Baz"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "With Foo Bar Baz"
+            "title": "With Foo Bar Baz",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const EnumWithMessageFail = `{"enumField": 4}`
 
@@ -0,0 +1,70 @@
+package testdata
+
+const EnumsAsDefinitions = `{
+    "$ref": "#/definitions/EnumsAsDefinitions",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "EnumsAsDefinitions": {
+            "additionalProperties": true,
+            "properties": {
+                "child": {
+                    "$ref": "#/definitions/samples.EnumsAsDefinitions.Child",
+                    "additionalProperties": true
+                },
+                "history": {
+                    "items": {
+                        "$ref": "#/definitions/samples.EnumsAsDefinitions.Status"
+                    },
+                    "type": "array"
+                },
+                "previous_status": {
+                    "$ref": "#/definitions/samples.EnumsAsDefinitions.Status"
+                },
+                "status": {
+                    "$ref": "#/definitions/samples.EnumsAsDefinitions.Status"
+                },
+                "statuses_by_region": {
+                    "additionalProperties": {
+                        "$ref": "#/definitions/samples.EnumsAsDefinitions.Status"
+                    },
+                    "type": "object"
+                }
+            },
+            "title": "Enums As Definitions",
+            "type": "object"
+        },
+        "samples.EnumsAsDefinitions.Child": {
+            "additionalProperties": true,
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/samples.EnumsAsDefinitions.Status"
+                }
+            },
+            "title": "Child",
+            "type": "object"
+        },
+        "samples.EnumsAsDefinitions.Status": {
+            "description": "Status of something",
+            "enum": [
+                "UNKNOWN",
+                0,
+                "ACTIVE",
+                1
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ],
+            "title": "Status"
+        }
+    }
+}
+`
+
+const EnumsAsDefinitionsFail = `{"history": ["ACTIVE", "ASLEEP"]}`
+
+const EnumsAsDefinitionsPass = `{"status": "ACTIVE", "previous_status": 0, "history": ["UNKNOWN", 1], "statuses_by_region": {"eu": "ACTIVE"}, "child": {"status": "UNKNOWN"}}`
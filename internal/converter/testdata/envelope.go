@@ -0,0 +1,45 @@
+package testdata
+
+const Envelope = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "additionalProperties": true,
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        },
+        "acme.Envelope.Metadata": {
+            "additionalProperties": true,
+            "properties": {
+                "source": {
+                    "type": "string"
+                }
+            },
+            "title": "Metadata",
+            "type": "object"
+        }
+    },
+    "description": "An envelope, with some metadata and a placeholder for the payload.",
+    "properties": {
+        "metadata": {
+            "$ref": "#/definitions/acme.Envelope.Metadata",
+            "additionalProperties": true
+        },
+        "payload": {
+            "$ref": "#/definitions/Order"
+        }
+    },
+    "title": "Envelope",
+    "type": "object"
+}
+`
+
+const EnvelopeFail = `{"metadata": {"source": "web"}, "payload": {"id": 123}}`
+
+const EnvelopePass = `{"metadata": {"source": "web"}, "payload": {"id": "123"}}`
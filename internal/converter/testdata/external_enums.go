@@ -0,0 +1,170 @@
+package testdata
+
+const ExternalEnums = `{
+    "$ref": "#/definitions/ExternalEnums",
+    "$schema": "http://json-schema.org/draft-06/schema#",
+    "definitions": {
+        "ExternalEnums": {
+            "additionalProperties": true,
+            "properties": {
+                "value": {
+                    "description": "This is an enum",
+                    "enum": [
+                        "VALUE_0",
+                        0,
+                        "VALUE_1",
+                        1,
+                        "VALUE_2",
+                        2,
+                        "VALUE_3",
+                        3
+                    ],
+                    "oneOf": [
+                        {
+                            "const": "VALUE_0",
+                            "description": "Zero"
+                        },
+                        {
+                            "const": 0,
+                            "description": "Zero"
+                        },
+                        {
+                            "const": "VALUE_1",
+                            "description": "One"
+                        },
+                        {
+                            "const": 1,
+                            "description": "One"
+                        },
+                        {
+                            "const": "VALUE_2",
+                            "description": "Two"
+                        },
+                        {
+                            "const": 2,
+                            "description": "Two"
+                        },
+                        {
+                            "const": "VALUE_3",
+                            "description": "Three"
+                        },
+                        {
+                            "const": 3,
+                            "description": "Three"
+                        }
+                    ],
+                    "title": "Imported Enum"
+                },
+                "values": {
+                    "description": "This is an enum",
+                    "items": {
+                        "enum": [
+                            "VALUE_0",
+                            0,
+                            "VALUE_1",
+                            1,
+                            "VALUE_2",
+                            2,
+                            "VALUE_3",
+                            3
+                        ],
+                        "oneOf": [
+                            {
+                                "const": "VALUE_0",
+                                "description": "Zero"
+                            },
+                            {
+                                "const": 0,
+                                "description": "Zero"
+                            },
+                            {
+                                "const": "VALUE_1",
+                                "description": "One"
+                            },
+                            {
+                                "const": 1,
+                                "description": "One"
+                            },
+                            {
+                                "const": "VALUE_2",
+                                "description": "Two"
+                            },
+                            {
+                                "const": 2,
+                                "description": "Two"
+                            },
+                            {
+                                "const": "VALUE_3",
+                                "description": "Three"
+                            },
+                            {
+                                "const": 3,
+                                "description": "Three"
+                            }
+                        ]
+                    },
+                    "title": "Imported Enum",
+                    "type": "array"
+                },
+                "values_by_name": {
+                    "additionalProperties": {
+                        "description": "This is an enum",
+                        "enum": [
+                            "VALUE_0",
+                            0,
+                            "VALUE_1",
+                            1,
+                            "VALUE_2",
+                            2,
+                            "VALUE_3",
+                            3
+                        ],
+                        "oneOf": [
+                            {
+                                "const": "VALUE_0",
+                                "description": "Zero"
+                            },
+                            {
+                                "const": 0,
+                                "description": "Zero"
+                            },
+                            {
+                                "const": "VALUE_1",
+                                "description": "One"
+                            },
+                            {
+                                "const": 1,
+                                "description": "One"
+                            },
+                            {
+                                "const": "VALUE_2",
+                                "description": "Two"
+                            },
+                            {
+                                "const": 2,
+                                "description": "Two"
+                            },
+                            {
+                                "const": "VALUE_3",
+                                "description": "Three"
+                            },
+                            {
+                                "const": 3,
+                                "description": "Three"
+                            }
+                        ],
+                        "title": "Imported Enum"
+                    },
+                    "type": "object"
+                }
+            },
+            "title": "External Enums",
+            "type": "object"
+        }
+    }
+}
+`
+
+const ExternalEnumsFail = `{"values": ["VALUE_1", "VALUE_9"], "values_by_name": {"first": 7}}`
+
+const ExternalEnumsPass = `{"value": 0, "values": ["VALUE_1", 2], "values_by_name": {"first": "VALUE_3"}}`
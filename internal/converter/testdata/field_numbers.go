@@ -0,0 +1,80 @@
+package testdata
+
+const FieldNumbersCombined = `{
+    "$ref": "#/definitions/Account",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Account": {
+            "additionalProperties": true,
+            "properties": {
+                "experiment_data": {
+                    "$ref": "#/definitions/samples.ExperimentData",
+                    "additionalProperties": true
+                }
+            },
+            "title": "Account",
+            "type": "object"
+        },
+        "samples.ExperimentData": {
+            "additionalProperties": true,
+            "title": "Experiment Data",
+            "type": "object"
+        }
+    }
+}
+`
+
+const FieldNumbersCombinedExperimentData = `{
+    "$ref": "#/definitions/ExperimentData",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "ExperimentData": {
+            "additionalProperties": true,
+            "title": "Experiment Data",
+            "type": "object"
+        }
+    }
+}
+`
+
+const FieldNumbersExcluded = `{
+    "$ref": "#/definitions/Account",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Account": {
+            "additionalProperties": true,
+            "properties": {
+                "labels": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Account",
+            "type": "object"
+        }
+    }
+}
+`
+
+const FieldNumbersExcludedExperimentData = `{
+    "$ref": "#/definitions/ExperimentData",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "ExperimentData": {
+            "additionalProperties": true,
+            "properties": {
+                "cohort": {
+                    "type": "string"
+                }
+            },
+            "title": "Experiment Data",
+            "type": "object"
+        }
+    }
+}
+`
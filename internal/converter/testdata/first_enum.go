@@ -21,7 +21,8 @@ const FirstEnum = `{
         }
     ],
     "title": "First Enum"
-}`
+}
+`
 
 const FirstEnumFail = `5`
 
@@ -1,33 +1,34 @@
 package testdata
 
 const FirstMessage = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/FirstMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "FirstMessage": {
+            "additionalProperties": true,
             "properties": {
-                "name1": {
-                    "type": "string"
-                },
-                "timestamp1": {
-                    "type": "string"
+                "complete1": {
+                    "type": "boolean"
                 },
                 "id1": {
                     "type": "integer"
                 },
+                "name1": {
+                    "type": "string"
+                },
                 "rating1": {
                     "type": "number"
                 },
-                "complete1": {
-                    "type": "boolean"
+                "timestamp1": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "First Message"
+            "title": "First Message",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const FirstMessageFail = `{"complete1": "hello"}`
 
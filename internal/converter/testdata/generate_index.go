@@ -0,0 +1,6 @@
+package testdata
+
+const GenerateIndex = `{
+    "samples.PayloadMessage": "PayloadMessage.json"
+}
+`
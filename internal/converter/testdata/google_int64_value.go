@@ -1,22 +1,29 @@
 package testdata
 
 const GoogleInt64Value = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/GoogleInt64Value",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "GoogleInt64Value": {
+            "additionalProperties": true,
             "properties": {
                 "big_number": {
-                    "additionalProperties": true,
-                    "type": "string"
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Google Int 64 Value"
+            "title": "Google Int 64 Value",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const GoogleInt64ValueFail = `{"big_number": 12345}`
 
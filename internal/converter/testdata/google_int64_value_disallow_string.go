@@ -1,22 +1,29 @@
 package testdata
 
 const GoogleInt64ValueDisallowString = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/GoogleInt64ValueDisallowString",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "GoogleInt64ValueDisallowString": {
+            "additionalProperties": true,
             "properties": {
                 "big_number": {
-                    "additionalProperties": true,
-                    "type": "integer"
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Google Int 64 Value Disallow String"
+            "title": "Google Int 64 Value Disallow String",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const GoogleInt64ValueDisallowStringFail = `{"big_number": "12345"}`
 
@@ -1,10 +1,19 @@
 package testdata
 
 const GoogleInt64ValueDisallowStringAllowNull = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/GoogleInt64ValueDisallowStringAllowNull",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "GoogleInt64ValueDisallowStringAllowNull": {
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ],
             "properties": {
                 "big_number": {
                     "oneOf": [
@@ -14,24 +23,14 @@ const GoogleInt64ValueDisallowStringAllowNull = `{
                         {
                             "type": "integer"
                         }
-                    ],
-                    "title": "Int 64 Value",
-                    "description": "Wrapper message for ` + "`int64`" + `. The JSON representation for ` + "`Int64Value`" + ` is JSON string."
+                    ]
                 }
             },
-            "additionalProperties": true,
-            "oneOf": [
-                {
-                    "type": "null"
-                },
-                {
-                    "type": "object"
-                }
-            ],
             "title": "Google Int 64 Value Disallow String Allow Null"
         }
     }
-}`
+}
+`
 
 const GoogleInt64ValueDisallowStringAllowNullFail = `{"big_number": "12345"}`
 
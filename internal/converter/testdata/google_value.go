@@ -1,10 +1,11 @@
 package testdata
 
 const GoogleValue = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/GoogleValue",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "GoogleValue": {
+            "additionalProperties": true,
             "properties": {
                 "arg": {
                     "oneOf": [
@@ -14,6 +15,9 @@ const GoogleValue = `{
                         {
                             "type": "boolean"
                         },
+                        {
+                            "type": "null"
+                        },
                         {
                             "type": "number"
                         },
@@ -23,18 +27,16 @@ const GoogleValue = `{
                         {
                             "type": "string"
                         }
-                    ],
-                    "title": "Value",
-                    "description": "` + "`Value`" + ` represents a dynamically typed value which can be either null, a number, a string, a boolean, a recursive struct value, or a list of values. A producer of value is expected to set one of these variants. Absence of any variant indicates an error. The JSON representation for ` + "`Value`" + ` is JSON value."
+                    ]
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Google Value"
+            "title": "Google Value",
+            "type": "object"
         }
     }
-}`
+}
+`
 
-const GoogleValueFail = `{"arg": null}`
+const GoogleValueFail = `[{"arg": 12345}]`
 
 const GoogleValuePass = `{"arg": 12345}`
@@ -0,0 +1,111 @@
+package testdata
+
+const HyperSchemaPlaceOrderRequest = `{
+    "$ref": "#/definitions/PlaceOrderRequest",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "PlaceOrderRequest": {
+            "additionalProperties": true,
+            "properties": {
+                "customer": {
+                    "type": "string"
+                },
+                "items": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                }
+            },
+            "title": "Place Order Request",
+            "type": "object"
+        }
+    }
+}
+`
+
+const HyperSchemaOrder = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const HyperSchema = `{
+    "$schema": "http://json-schema.org/draft-04/hyper-schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        },
+        "PlaceOrderRequest": {
+            "additionalProperties": true,
+            "properties": {
+                "customer": {
+                    "type": "string"
+                },
+                "items": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                }
+            },
+            "title": "Place Order Request",
+            "type": "object"
+        }
+    },
+    "links": [
+        {
+            "description": "Places an order. The order is checked before it is accepted.",
+            "href": "/v1/orders",
+            "method": "POST",
+            "rel": "PlaceOrder",
+            "schema": {
+                "$ref": "#/definitions/PlaceOrderRequest"
+            },
+            "targetSchema": {
+                "$ref": "#/definitions/Order"
+            },
+            "title": "OrderService.PlaceOrder"
+        },
+        {
+            "description": "Places an order. The order is checked before it is accepted.",
+            "href": "/v1/{+parent}/orders/{customer}",
+            "method": "GET",
+            "rel": "PlaceOrder",
+            "schema": {
+                "$ref": "#/definitions/PlaceOrderRequest"
+            },
+            "targetSchema": {
+                "$ref": "#/definitions/Order/properties/id"
+            },
+            "title": "OrderService.PlaceOrder"
+        }
+    ],
+    "title": "samples"
+}
+`
@@ -0,0 +1,69 @@
+package testdata
+
+const InferredFormats = `{
+    "$ref": "#/definitions/InferredFormats",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "InferredFormats": {
+            "additionalProperties": true,
+            "properties": {
+                "audit_ip": {
+                    "items": {
+                        "anyOf": [
+                            {
+                                "format": "ipv4"
+                            },
+                            {
+                                "format": "ipv6"
+                            }
+                        ],
+                        "type": "string",
+                        "x-inferred": true
+                    },
+                    "type": "array"
+                },
+                "callback_url": {
+                    "pattern": "^https://",
+                    "type": "string"
+                },
+                "client_ip": {
+                    "anyOf": [
+                        {
+                            "format": "ipv4"
+                        },
+                        {
+                            "format": "ipv6"
+                        }
+                    ],
+                    "type": "string",
+                    "x-inferred": true
+                },
+                "contact_email": {
+                    "format": "email",
+                    "type": "string",
+                    "x-inferred": true
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "homepage_url": {
+                    "format": "uri",
+                    "type": "string",
+                    "x-inferred": true
+                },
+                "request_uuid": {
+                    "format": "uuid",
+                    "type": "string",
+                    "x-inferred": true
+                }
+            },
+            "title": "Inferred Formats",
+            "type": "object"
+        }
+    }
+}
+`
+
+const InferredFormatsFail = `{"contact_email": "not an email address", "client_ip": "localhost"}`
+
+const InferredFormatsPass = `{"contact_email": "someone@example.com", "request_uuid": "b3a6c3a2-5e3c-4b7e-9f2e-3a6f3c9d8e21", "homepage_url": "https://example.com", "client_ip": "::1", "callback_url": "https://example.com/hook", "audit_ip": ["10.0.0.1", "::1"]}`
@@ -1,42 +1,51 @@
 package testdata
 
 const JSONFields = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/JSONFields",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "JSONFields": {
-            "required": [
-                "otherNumb"
-            ],
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
-                "timestamp": {
+                "identifier": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "identifier": {
+                "otherNumb": {
                     "type": "integer"
                 },
+                "snakeNumb": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^-?[0-9]+$",
+                            "type": "string"
+                        }
+                    ]
+                },
                 "someThing": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
-                },
-                "snakeNumb": {
+                "timestamp": {
                     "type": "string"
-                },
-                "otherNumb": {
-                    "type": "integer"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "JSON Fields"
+            "required": [
+                "otherNumb"
+            ],
+            "title": "JSON Fields",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const JSONFieldsFail = `{"someThing": "onetwothree", "other_numb": 123}`
 
@@ -0,0 +1,1326 @@
+package testdata
+
+// The schemas which older releases generated, where they differ from the current ones (the legacy preset has to
+// reproduce them exactly):
+
+const LegacyArrayOfEnums = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/ArrayOfEnums",
+    "definitions": {
+        "ArrayOfEnums": {
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "stuff": {
+                    "items": {
+                        "enum": [
+                            "FOO",
+                            0,
+                            "BAR",
+                            1,
+                            "FIZZ",
+                            2,
+                            "BUZZ",
+                            3
+                        ]
+                    },
+                    "type": "array",
+                    "title": "Inline"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Array Of Enums"
+        }
+    }
+}`
+
+const LegacyArrayOfPrimitives = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/ArrayOfPrimitives",
+    "definitions": {
+        "ArrayOfPrimitives": {
+            "properties": {
+                "description": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "luckyNumbers": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "integer"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "array"
+                        }
+                    ]
+                },
+                "luckyBigNumbers": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "string"
+                            },
+                            {
+                                "type": "null"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "array"
+                        }
+                    ]
+                },
+                "keyWords": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "array"
+                        }
+                    ]
+                },
+                "big_number": {
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "null"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ],
+            "title": "Array Of Primitives"
+        }
+    }
+}`
+
+const LegacyArrayOfPrimitivesDouble = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/ArrayOfPrimitives",
+    "definitions": {
+        "ArrayOfPrimitives": {
+            "properties": {
+                "description": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "luckyNumbers": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "integer"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "array"
+                        }
+                    ]
+                },
+                "luckyBigNumbers": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "string"
+                            },
+                            {
+                                "type": "null"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "array"
+                        }
+                    ]
+                },
+                "keyWords": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "array"
+                        }
+                    ]
+                },
+                "big_number": {
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "null"
+                        }
+                    ]
+                },
+                "bigNumber": {
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "null"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ],
+            "title": "Array Of Primitives"
+        }
+    }
+}`
+
+const LegacyBytesPayload = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/BytesPayload",
+    "definitions": {
+        "BytesPayload": {
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string",
+                    "format": "binary",
+                    "binaryEncoding": "base64"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Bytes Payload"
+        }
+    }
+}`
+
+const LegacyGoogleInt64Value = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/GoogleInt64Value",
+    "definitions": {
+        "GoogleInt64Value": {
+            "properties": {
+                "big_number": {
+                    "additionalProperties": true,
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Google Int 64 Value"
+        }
+    }
+}`
+
+const LegacyGoogleInt64ValueDisallowString = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/GoogleInt64ValueDisallowString",
+    "definitions": {
+        "GoogleInt64ValueDisallowString": {
+            "properties": {
+                "big_number": {
+                    "additionalProperties": true,
+                    "type": "integer"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Google Int 64 Value Disallow String"
+        }
+    }
+}`
+
+const LegacyGoogleValue = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/GoogleValue",
+    "definitions": {
+        "GoogleValue": {
+            "properties": {
+                "arg": {
+                    "oneOf": [
+                        {
+                            "type": "array"
+                        },
+                        {
+                            "type": "boolean"
+                        },
+                        {
+                            "type": "number"
+                        },
+                        {
+                            "type": "object"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Google Value"
+        }
+    }
+}`
+
+const LegacyJSONFields = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/JSONFields",
+    "definitions": {
+        "JSONFields": {
+            "required": [
+                "otherNumb"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "identifier": {
+                    "type": "integer"
+                },
+                "someThing": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "snakeNumb": {
+                    "type": "string"
+                },
+                "otherNumb": {
+                    "type": "integer"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "JSON Fields"
+        }
+    }
+}`
+
+const LegacyMessageKind12 = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/MessageKind12",
+    "definitions": {
+        "MessageKind12": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "f": {
+                    "$ref": "#/definitions/samples.MessageKind11",
+                    "additionalProperties": true
+                },
+                "kind5": {
+                    "$ref": "#/definitions/samples.MessageKind5",
+                    "additionalProperties": true
+                },
+                "kind6": {
+                    "$ref": "#/definitions/samples.MessageKind6",
+                    "additionalProperties": true
+                },
+                "kind7": {
+                    "$ref": "#/definitions/samples.MessageKind7",
+                    "additionalProperties": true
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Message Kind 12"
+        },
+        "samples.MessageKind1": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Message Kind 1"
+        },
+        "samples.MessageKind11": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "ones": {
+                    "items": {
+                        "$ref": "#/definitions/samples.MessageKind1"
+                    },
+                    "type": "array"
+                },
+                "kind2": {
+                    "$ref": "#/definitions/samples.MessageKind2",
+                    "additionalProperties": true
+                },
+                "kind3": {
+                    "$ref": "#/definitions/samples.MessageKind3",
+                    "additionalProperties": true
+                },
+                "kind4": {
+                    "$ref": "#/definitions/samples.MessageKind4",
+                    "additionalProperties": true
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Message Kind 11"
+        },
+        "samples.MessageKind2": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "isa": {
+                    "type": "boolean"
+                },
+                "hasa": {
+                    "type": "boolean"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Message Kind 2"
+        },
+        "samples.MessageKind3": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "someProp": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Message Kind 3"
+        },
+        "samples.MessageKind4": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "special": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Message Kind 4"
+        },
+        "samples.MessageKind5": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "foo": {
+                    "type": "number"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Message Kind 5"
+        },
+        "samples.MessageKind6": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "bar": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Message Kind 6"
+        },
+        "samples.MessageKind7": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "baz": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Message Kind 7"
+        }
+    }
+}`
+
+const LegacyWellKnown = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/WellKnown",
+    "definitions": {
+        "WellKnown": {
+            "properties": {
+                "string_value": {
+                    "additionalProperties": true,
+                    "type": "string"
+                },
+                "map_of_integers": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "integer"
+                    },
+                    "type": "object"
+                },
+                "map_of_scalar_integers": {
+                    "additionalProperties": {
+                        "type": "integer"
+                    },
+                    "type": "object"
+                },
+                "list_of_integers": {
+                    "items": {
+                        "type": "integer"
+                    },
+                    "type": "array"
+                },
+                "duration": {
+                    "pattern": "^([0-9]+\\.?[0-9]*|\\.[0-9]+)s$",
+                    "type": "string",
+                    "description": "This is a duration:",
+                    "format": "regex"
+                },
+                "struct": {
+                    "additionalProperties": true,
+                    "type": "object"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Well Known"
+        }
+    }
+}`
+
+const LegacyWellKnownTypes = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/WellKnownTypes",
+    "definitions": {
+        "WellKnownTypes": {
+            "properties": {
+                "any": {
+                    "properties": {
+                        "type_url": {
+                            "type": "string"
+                        },
+                        "value": {
+                            "type": "string",
+                            "format": "binary",
+                            "binaryEncoding": "base64"
+                        }
+                    },
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "bool_value": {
+                    "additionalProperties": true,
+                    "type": "boolean"
+                },
+                "bytes_value": {
+                    "additionalProperties": true,
+                    "type": "string"
+                },
+                "double_value": {
+                    "additionalProperties": true,
+                    "type": "number"
+                },
+                "duration": {
+                    "pattern": "^([0-9]+\\.?[0-9]*|\\.[0-9]+)s$",
+                    "type": "string",
+                    "format": "regex"
+                },
+                "empty": {
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "field_mask": {
+                    "properties": {
+                        "paths": {
+                            "items": {
+                                "type": "string"
+                            },
+                            "type": "array"
+                        }
+                    },
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "float_value": {
+                    "additionalProperties": true,
+                    "type": "number"
+                },
+                "int32_value": {
+                    "additionalProperties": true,
+                    "type": "integer"
+                },
+                "int64_value": {
+                    "additionalProperties": true,
+                    "type": "string"
+                },
+                "list_value": {
+                    "properties": {
+                        "values": {
+                            "items": {
+                                "oneOf": [
+                                    {
+                                        "type": "array"
+                                    },
+                                    {
+                                        "type": "boolean"
+                                    },
+                                    {
+                                        "type": "number"
+                                    },
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "type": "string"
+                                    }
+                                ]
+                            },
+                            "type": "array"
+                        }
+                    },
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "null_value": {
+                    "enum": [
+                        "NULL_VALUE",
+                        0
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                },
+                "string_value": {
+                    "additionalProperties": true,
+                    "type": "string"
+                },
+                "struct": {
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "timestamp": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "uint32_value": {
+                    "additionalProperties": true,
+                    "type": "integer"
+                },
+                "uint64_value": {
+                    "additionalProperties": true,
+                    "type": "string"
+                },
+                "value": {
+                    "oneOf": [
+                        {
+                            "type": "array"
+                        },
+                        {
+                            "type": "boolean"
+                        },
+                        {
+                            "type": "number"
+                        },
+                        {
+                            "type": "object"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "repeated_any": {
+                    "items": {
+                        "properties": {
+                            "type_url": {
+                                "type": "string"
+                            },
+                            "value": {
+                                "type": "string",
+                                "format": "binary",
+                                "binaryEncoding": "base64"
+                            }
+                        },
+                        "additionalProperties": true,
+                        "type": "object"
+                    },
+                    "type": "array"
+                },
+                "repeated_bool_value": {
+                    "items": {
+                        "type": "boolean"
+                    },
+                    "type": "array"
+                },
+                "repeated_bytes_value": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "repeated_double_value": {
+                    "items": {
+                        "type": "number"
+                    },
+                    "type": "array"
+                },
+                "repeated_duration": {
+                    "pattern": "^([0-9]+\\.?[0-9]*|\\.[0-9]+)s$",
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array",
+                    "format": "regex"
+                },
+                "repeated_empty": {
+                    "items": {
+                        "additionalProperties": true,
+                        "type": "object"
+                    },
+                    "type": "array"
+                },
+                "repeated_field_mask": {
+                    "items": {
+                        "properties": {
+                            "paths": {
+                                "items": {
+                                    "type": "string"
+                                },
+                                "type": "array"
+                            }
+                        },
+                        "additionalProperties": true,
+                        "type": "object"
+                    },
+                    "type": "array"
+                },
+                "repeated_float_value": {
+                    "items": {
+                        "type": "number"
+                    },
+                    "type": "array"
+                },
+                "repeated_int32_value": {
+                    "items": {
+                        "type": "integer"
+                    },
+                    "type": "array"
+                },
+                "repeated_int64_value": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "repeated_list_value": {
+                    "items": {
+                        "properties": {
+                            "values": {
+                                "items": {
+                                    "oneOf": [
+                                        {
+                                            "type": "array"
+                                        },
+                                        {
+                                            "type": "boolean"
+                                        },
+                                        {
+                                            "type": "number"
+                                        },
+                                        {
+                                            "type": "object"
+                                        },
+                                        {
+                                            "type": "string"
+                                        }
+                                    ]
+                                },
+                                "type": "array"
+                            }
+                        },
+                        "additionalProperties": true,
+                        "type": "object"
+                    },
+                    "type": "array"
+                },
+                "repeated_null_value": {
+                    "items": {
+                        "enum": [
+                            "NULL_VALUE",
+                            0
+                        ]
+                    },
+                    "type": "array"
+                },
+                "repeated_string_value": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "repeated_struct": {
+                    "items": {
+                        "type": "object"
+                    },
+                    "type": "array"
+                },
+                "repeated_timestamp": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array",
+                    "format": "date-time"
+                },
+                "repeated_uint32_value": {
+                    "items": {
+                        "type": "integer"
+                    },
+                    "type": "array"
+                },
+                "repeated_uint64_value": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "repeated_value": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "array"
+                            },
+                            {
+                                "type": "boolean"
+                            },
+                            {
+                                "type": "number"
+                            },
+                            {
+                                "type": "object"
+                            },
+                            {
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "type": "array"
+                },
+                "map_of_any": {
+                    "additionalProperties": {
+                        "properties": {
+                            "type_url": {
+                                "type": "string"
+                            },
+                            "value": {
+                                "type": "string",
+                                "format": "binary",
+                                "binaryEncoding": "base64"
+                            }
+                        },
+                        "additionalProperties": true,
+                        "type": "object"
+                    },
+                    "type": "object"
+                },
+                "map_of_bool_value": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "boolean"
+                    },
+                    "type": "object"
+                },
+                "map_of_bytes_value": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_double_value": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "number"
+                    },
+                    "type": "object"
+                },
+                "map_of_duration": {
+                    "additionalProperties": {
+                        "pattern": "^([0-9]+\\.?[0-9]*|\\.[0-9]+)s$",
+                        "type": "string",
+                        "format": "regex"
+                    },
+                    "type": "object"
+                },
+                "map_of_empty": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "object"
+                    },
+                    "type": "object"
+                },
+                "map_of_field_mask": {
+                    "additionalProperties": {
+                        "properties": {
+                            "paths": {
+                                "items": {
+                                    "type": "string"
+                                },
+                                "type": "array"
+                            }
+                        },
+                        "additionalProperties": true,
+                        "type": "object"
+                    },
+                    "type": "object"
+                },
+                "map_of_float_value": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "number"
+                    },
+                    "type": "object"
+                },
+                "map_of_int32_value": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "integer"
+                    },
+                    "type": "object"
+                },
+                "map_of_int64_value": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_list_value": {
+                    "additionalProperties": {
+                        "properties": {
+                            "values": {
+                                "items": {
+                                    "oneOf": [
+                                        {
+                                            "type": "array"
+                                        },
+                                        {
+                                            "type": "boolean"
+                                        },
+                                        {
+                                            "type": "number"
+                                        },
+                                        {
+                                            "type": "object"
+                                        },
+                                        {
+                                            "type": "string"
+                                        }
+                                    ]
+                                },
+                                "type": "array"
+                            }
+                        },
+                        "additionalProperties": true,
+                        "type": "object"
+                    },
+                    "type": "object"
+                },
+                "map_of_null_value": {
+                    "additionalProperties": {
+                        "enum": [
+                            "NULL_VALUE",
+                            0
+                        ],
+                        "oneOf": [
+                            {
+                                "type": "string"
+                            },
+                            {
+                                "type": "integer"
+                            }
+                        ]
+                    },
+                    "type": "object"
+                },
+                "map_of_string_value": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_struct": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "object"
+                    },
+                    "type": "object"
+                },
+                "map_of_timestamp": {
+                    "additionalProperties": {
+                        "type": "string",
+                        "format": "date-time"
+                    },
+                    "type": "object"
+                },
+                "map_of_uint32_value": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "integer"
+                    },
+                    "type": "object"
+                },
+                "map_of_uint64_value": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_value": {
+                    "additionalProperties": {
+                        "oneOf": [
+                            {
+                                "type": "array"
+                            },
+                            {
+                                "type": "boolean"
+                            },
+                            {
+                                "type": "number"
+                            },
+                            {
+                                "type": "object"
+                            },
+                            {
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "type": "object"
+                },
+                "oneof_any": {
+                    "properties": {
+                        "type_url": {
+                            "type": "string"
+                        },
+                        "value": {
+                            "type": "string",
+                            "format": "binary",
+                            "binaryEncoding": "base64"
+                        }
+                    },
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "oneof_bool_value": {
+                    "additionalProperties": true,
+                    "type": "boolean"
+                },
+                "oneof_bytes_value": {
+                    "additionalProperties": true,
+                    "type": "string"
+                },
+                "oneof_double_value": {
+                    "additionalProperties": true,
+                    "type": "number"
+                },
+                "oneof_duration": {
+                    "pattern": "^([0-9]+\\.?[0-9]*|\\.[0-9]+)s$",
+                    "type": "string",
+                    "format": "regex"
+                },
+                "oneof_empty": {
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "oneof_field_mask": {
+                    "properties": {
+                        "paths": {
+                            "items": {
+                                "type": "string"
+                            },
+                            "type": "array"
+                        }
+                    },
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "oneof_float_value": {
+                    "additionalProperties": true,
+                    "type": "number"
+                },
+                "oneof_int32_value": {
+                    "additionalProperties": true,
+                    "type": "integer"
+                },
+                "oneof_int64_value": {
+                    "additionalProperties": true,
+                    "type": "string"
+                },
+                "oneof_list_value": {
+                    "properties": {
+                        "values": {
+                            "items": {
+                                "oneOf": [
+                                    {
+                                        "type": "array"
+                                    },
+                                    {
+                                        "type": "boolean"
+                                    },
+                                    {
+                                        "type": "number"
+                                    },
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "type": "string"
+                                    }
+                                ]
+                            },
+                            "type": "array"
+                        }
+                    },
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "oneof_null_value": {
+                    "enum": [
+                        "NULL_VALUE",
+                        0
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                },
+                "oneof_string_value": {
+                    "additionalProperties": true,
+                    "type": "string"
+                },
+                "oneof_struct": {
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "oneof_timestamp": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "oneof_uint32_value": {
+                    "additionalProperties": true,
+                    "type": "integer"
+                },
+                "oneof_uint64_value": {
+                    "additionalProperties": true,
+                    "type": "string"
+                },
+                "oneof_value": {
+                    "oneOf": [
+                        {
+                            "type": "array"
+                        },
+                        {
+                            "type": "boolean"
+                        },
+                        {
+                            "type": "number"
+                        },
+                        {
+                            "type": "object"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "title": "Well Known Types",
+            "description": "Every well-known type, in every position a field can use it:"
+        }
+    }
+}`
@@ -0,0 +1,147 @@
+package testdata
+
+const ListSchemasBook = `{
+    "$ref": "#/definitions/Book",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Book": {
+            "additionalProperties": true,
+            "properties": {
+                "author_name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            },
+            "title": "Book",
+            "type": "object"
+        }
+    }
+}
+`
+
+const ListSchemasBookList = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Book": {
+            "additionalProperties": true,
+            "properties": {
+                "author_name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            },
+            "title": "Book",
+            "type": "object"
+        }
+    },
+    "items": {
+        "$ref": "#/definitions/Book"
+    },
+    "title": "BookList",
+    "type": "array"
+}
+`
+
+const ListSchemasPaginatedBook = `{
+    "$ref": "#/definitions/Book",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Book": {
+            "additionalProperties": true,
+            "properties": {
+                "authorName": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            },
+            "title": "Book",
+            "type": "object"
+        }
+    }
+}
+`
+
+const ListSchemasPaginatedBookList = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "additionalProperties": true,
+    "definitions": {
+        "Book": {
+            "additionalProperties": true,
+            "properties": {
+                "authorName": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            },
+            "title": "Book",
+            "type": "object"
+        }
+    },
+    "properties": {
+        "items": {
+            "items": {
+                "$ref": "#/definitions/Book"
+            },
+            "type": "array"
+        },
+        "nextPageToken": {
+            "description": "A token which can be sent to retrieve the next page (empty if there are no more pages).",
+            "type": "string"
+        }
+    },
+    "title": "BookList",
+    "type": "object"
+}
+`
+
+const ListSchemasVersionedBook = `{
+    "$ref": "#/definitions/Book",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Book": {
+            "additionalProperties": true,
+            "properties": {
+                "title": {
+                    "type": "string"
+                }
+            },
+            "title": "Book (v1)",
+            "type": "object"
+        }
+    },
+    "x-api-channel": "stable",
+    "x-api-version": "v1"
+}
+`
+
+const ListSchemasVersionedBookList = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Book": {
+            "additionalProperties": true,
+            "properties": {
+                "title": {
+                    "type": "string"
+                }
+            },
+            "title": "Book",
+            "type": "object"
+        }
+    },
+    "items": {
+        "$ref": "#/definitions/Book"
+    },
+    "title": "BookList (v1)",
+    "type": "array",
+    "x-api-channel": "stable",
+    "x-api-version": "v1"
+}
+`
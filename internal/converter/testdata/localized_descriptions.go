@@ -0,0 +1,80 @@
+package testdata
+
+const LocalizedDescriptions = `{
+    "$ref": "#/definitions/LocalizedDescriptions",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "LocalizedDescriptions": {
+            "additionalProperties": true,
+            "description": "An order",
+            "properties": {
+                "note": {
+                    "description": "Not translated",
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string",
+                    "x-descriptions": {
+                        "de": "Bestellnummer",
+                        "en": "Order reference"
+                    }
+                },
+                "status": {
+                    "enum": [
+                        "PENDING",
+                        0
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ],
+                    "title": "Status"
+                }
+            },
+            "title": "Localized Descriptions",
+            "type": "object",
+            "x-descriptions": {
+                "de": "Eine von einem Kunden aufgegebene Bestellung",
+                "en": "An order placed by a customer"
+            }
+        }
+    }
+}
+`
+
+const LocalizedDescriptionsGerman = `{
+    "$ref": "#/definitions/LocalizedDescriptions",
+    "$schema": "http://json-schema.org/draft-06/schema#",
+    "definitions": {
+        "LocalizedDescriptions": {
+            "additionalProperties": true,
+            "description": "Eine von einem Kunden aufgegebene Bestellung",
+            "properties": {
+                "note": {
+                    "description": "Not translated",
+                    "type": "string"
+                },
+                "reference": {
+                    "description": "Bestellnummer",
+                    "type": "string"
+                },
+                "status": {
+                    "oneOf": [
+                        {
+                            "const": 0,
+                            "description": "PENDING: Ausstehend"
+                        }
+                    ],
+                    "title": "Status"
+                }
+            },
+            "title": "Localized Descriptions",
+            "type": "object"
+        }
+    }
+}
+`
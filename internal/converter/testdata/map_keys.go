@@ -0,0 +1,75 @@
+package testdata
+
+const MapKeys = `{
+    "$ref": "#/definitions/MapKeys",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "MapKeys": {
+            "additionalProperties": true,
+            "properties": {
+                "map_of_bool_keys": {
+                    "additionalProperties": false,
+                    "patternProperties": {
+                        "^(true|false)$": {
+                            "type": "string"
+                        }
+                    },
+                    "type": "object"
+                },
+                "map_of_int32_keys": {
+                    "additionalProperties": false,
+                    "patternProperties": {
+                        "^-?[0-9]+$": {
+                            "type": "string"
+                        }
+                    },
+                    "type": "object"
+                },
+                "map_of_string_keys": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_uint64_keys": {
+                    "additionalProperties": false,
+                    "patternProperties": {
+                        "^[0-9]+$": {
+                            "type": "string"
+                        }
+                    },
+                    "type": "object"
+                }
+            },
+            "title": "Map Keys",
+            "type": "object"
+        }
+    }
+}
+`
+
+const MapKeysFail = `{
+	"map_of_int32_keys": {
+		"one": "1"
+	},
+	"map_of_uint64_keys": {
+		"-2": "minus two"
+	}
+}`
+
+const MapKeysPass = `{
+	"map_of_int32_keys": {
+		"-1": "minus one",
+		"1": "one"
+	},
+	"map_of_uint64_keys": {
+		"18446744073709551615": "max"
+	},
+	"map_of_bool_keys": {
+		"true": "yes",
+		"false": "no"
+	},
+	"map_of_string_keys": {
+		"one": "1"
+	}
+}`
@@ -1,17 +1,12 @@
 package testdata
 
 const Maps = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/Maps",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "Maps": {
+            "additionalProperties": true,
             "properties": {
-                "map_of_strings": {
-                    "additionalProperties": {
-                        "type": "string"
-                    },
-                    "type": "object"
-                },
                 "map_of_ints": {
                     "additionalProperties": {
                         "type": "integer"
@@ -24,28 +19,34 @@ const Maps = `{
                         "additionalProperties": true
                     },
                     "type": "object"
+                },
+                "map_of_strings": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Maps"
+            "title": "Maps",
+            "type": "object"
         },
         "samples.PayloadMessage": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 },
                 "topology": {
                     "enum": [
@@ -73,12 +74,12 @@ const Maps = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Payload Message"
+            "title": "Payload Message",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const MapsFail = `{
 	"map_of_strings": {
@@ -0,0 +1,324 @@
+package testdata
+
+const MapsAndWrappers = `{
+    "$ref": "#/definitions/MapsAndWrappers",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "MapsAndWrappers": {
+            "additionalProperties": true,
+            "allOf": [
+                {
+                    "oneOf": [
+                        {
+                            "required": [
+                                "labels"
+                            ]
+                        },
+                        {
+                            "required": [
+                                "name"
+                            ]
+                        }
+                    ]
+                },
+                {
+                    "oneOf": [
+                        {
+                            "required": [
+                                "email"
+                            ]
+                        },
+                        {
+                            "required": [
+                                "phone"
+                            ]
+                        }
+                    ]
+                }
+            ],
+            "properties": {
+                "aliases": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "counts": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "features": {
+                    "additionalProperties": {
+                        "type": "boolean"
+                    },
+                    "type": "object"
+                },
+                "labelled": {
+                    "additionalProperties": {
+                        "$ref": "#/definitions/samples.MapsAndWrappers.Labels",
+                        "additionalProperties": true
+                    },
+                    "type": "object"
+                },
+                "labels": {
+                    "$ref": "#/definitions/samples.MapsAndWrappers.Labels",
+                    "additionalProperties": true
+                },
+                "name": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            },
+            "title": "Maps And Wrappers",
+            "type": "object"
+        },
+        "samples.MapsAndWrappers.Labels": {
+            "additionalProperties": true,
+            "properties": {
+                "values": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                }
+            },
+            "title": "Labels",
+            "type": "object"
+        }
+    }
+}
+`
+
+const MapsAndWrappersAllowNull = `{
+    "$ref": "#/definitions/MapsAndWrappers",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "MapsAndWrappers": {
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "allOf": [
+                        {
+                            "oneOf": [
+                                {
+                                    "required": [
+                                        "labels"
+                                    ]
+                                },
+                                {
+                                    "required": [
+                                        "name"
+                                    ]
+                                }
+                            ]
+                        },
+                        {
+                            "oneOf": [
+                                {
+                                    "required": [
+                                        "email"
+                                    ]
+                                },
+                                {
+                                    "required": [
+                                        "phone"
+                                    ]
+                                }
+                            ]
+                        }
+                    ],
+                    "type": "object"
+                }
+            ],
+            "properties": {
+                "aliases": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "array"
+                        }
+                    ]
+                },
+                "counts": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "array"
+                        }
+                    ]
+                },
+                "email": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "features": {
+                    "additionalProperties": {
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "boolean"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "object"
+                        }
+                    ]
+                },
+                "labelled": {
+                    "additionalProperties": {
+                        "$ref": "#/definitions/samples.MapsAndWrappers.Labels",
+                        "additionalProperties": true,
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {}
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "object"
+                        }
+                    ]
+                },
+                "labels": {
+                    "$ref": "#/definitions/samples.MapsAndWrappers.Labels",
+                    "additionalProperties": true,
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {}
+                    ]
+                },
+                "name": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "note": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "phone": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                }
+            },
+            "title": "Maps And Wrappers"
+        },
+        "samples.MapsAndWrappers.Labels": {
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ],
+            "properties": {
+                "values": {
+                    "additionalProperties": {
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "object"
+                        }
+                    ]
+                }
+            },
+            "title": "Labels"
+        }
+    }
+}
+`
+
+const MapsAndWrappersPass = `{"name": "x", "email": "a@b.c", "aliases": ["a"], "counts": ["1"], "features": {"beta": true}, "labelled": {"x": {"values": {"a": "b"}}}}`
+
+const MapsAndWrappersFail = `{"name": "x", "labels": {"values": {"a": "b"}}, "email": "a@b.c"}`
+
+const MapsAndWrappersMissingOneofFail = `{"labels": {"values": {"a": "b"}}}`
+
+const MapsAndWrappersBadMapValueFail = `{"name": "x", "phone": "123", "features": {"beta": "yes"}}`
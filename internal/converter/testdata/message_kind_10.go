@@ -1,30 +1,31 @@
 package testdata
 
 const MessageKind10 = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/MessageKind10",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "MessageKind10": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 10"
+            "title": "Message Kind 10",
+            "type": "object"
         }
     }
-}`
+}
+`
@@ -1,20 +1,12 @@
 package testdata
 
 const MessageKind11 = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/MessageKind11",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "MessageKind11": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "ones": {
-                    "items": {
-                        "$ref": "#/definitions/samples.MessageKind1"
-                    },
-                    "type": "array"
-                },
                 "kind2": {
                     "$ref": "#/definitions/samples.MessageKind2",
                     "additionalProperties": true
@@ -26,111 +18,120 @@ const MessageKind11 = `{
                 "kind4": {
                     "$ref": "#/definitions/samples.MessageKind4",
                     "additionalProperties": true
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ones": {
+                    "items": {
+                        "$ref": "#/definitions/samples.MessageKind1"
+                    },
+                    "type": "array"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 11"
+            "title": "Message Kind 11",
+            "type": "object"
         },
         "samples.MessageKind1": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 1"
+            "title": "Message Kind 1",
+            "type": "object"
         },
         "samples.MessageKind2": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
-                "timestamp": {
-                    "type": "string"
+                "hasa": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "rating": {
-                    "type": "number"
-                },
-                "complete": {
-                    "type": "boolean"
-                },
                 "isa": {
                     "type": "boolean"
                 },
-                "hasa": {
-                    "type": "boolean"
-                }
-            },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 2"
-        },
-        "samples.MessageKind3": {
-            "properties": {
                 "name": {
                     "type": "string"
                 },
+                "rating": {
+                    "type": "number"
+                },
                 "timestamp": {
                     "type": "string"
+                }
+            },
+            "title": "Message Kind 2",
+            "type": "object"
+        },
+        "samples.MessageKind3": {
+            "additionalProperties": true,
+            "properties": {
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
-                },
                 "someProp": {
                     "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 3"
+            "title": "Message Kind 3",
+            "type": "object"
         },
         "samples.MessageKind4": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
-                },
                 "special": {
                     "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 4"
+            "title": "Message Kind 4",
+            "type": "object"
         }
     }
-}`
+}
+`
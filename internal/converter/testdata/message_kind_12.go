@@ -1,14 +1,12 @@
 package testdata
 
 const MessageKind12 = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/MessageKind12",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "MessageKind12": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
                 "f": {
                     "$ref": "#/definitions/samples.MessageKind11",
                     "additionalProperties": true
@@ -24,45 +22,39 @@ const MessageKind12 = `{
                 "kind7": {
                     "$ref": "#/definitions/samples.MessageKind7",
                     "additionalProperties": true
+                },
+                "name": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 12"
+            "title": "Message Kind 12",
+            "type": "object"
         },
         "samples.MessageKind1": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 1"
+            "title": "Message Kind 1",
+            "type": "object"
         },
         "samples.MessageKind11": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "ones": {
-                    "items": {
-                        "$ref": "#/definitions/samples.MessageKind1"
-                    },
-                    "type": "array"
-                },
                 "kind2": {
                     "$ref": "#/definitions/samples.MessageKind2",
                     "additionalProperties": true
@@ -74,164 +66,181 @@ const MessageKind12 = `{
                 "kind4": {
                     "$ref": "#/definitions/samples.MessageKind4",
                     "additionalProperties": true
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ones": {
+                    "items": {
+                        "$ref": "#/definitions/samples.MessageKind1"
+                    },
+                    "type": "array"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 11"
+            "title": "Message Kind 11",
+            "type": "object"
         },
         "samples.MessageKind2": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
-                "timestamp": {
-                    "type": "string"
+                "hasa": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "rating": {
-                    "type": "number"
-                },
-                "complete": {
-                    "type": "boolean"
-                },
                 "isa": {
                     "type": "boolean"
                 },
-                "hasa": {
-                    "type": "boolean"
-                }
-            },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 2"
-        },
-        "samples.MessageKind3": {
-            "properties": {
                 "name": {
                     "type": "string"
                 },
+                "rating": {
+                    "type": "number"
+                },
                 "timestamp": {
                     "type": "string"
+                }
+            },
+            "title": "Message Kind 2",
+            "type": "object"
+        },
+        "samples.MessageKind3": {
+            "additionalProperties": true,
+            "properties": {
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
-                },
                 "someProp": {
                     "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 3"
+            "title": "Message Kind 3",
+            "type": "object"
         },
         "samples.MessageKind4": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
-                },
                 "special": {
                     "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 4"
+            "title": "Message Kind 4",
+            "type": "object"
         },
         "samples.MessageKind5": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
-                "timestamp": {
-                    "type": "string"
+                "foo": {
+                    "type": "number"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
-                },
-                "foo": {
-                    "type": "number"
+                "timestamp": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 5"
+            "title": "Message Kind 5",
+            "type": "object"
         },
         "samples.MessageKind6": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
+                "bar": {
                     "type": "string"
                 },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
-                },
-                "bar": {
+                "timestamp": {
                     "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 6"
+            "title": "Message Kind 6",
+            "type": "object"
         },
         "samples.MessageKind7": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
+                "baz": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^-?[0-9]+$",
+                            "type": "string"
+                        }
+                    ]
                 },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
-                },
-                "baz": {
+                "timestamp": {
                     "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Message Kind 7"
+            "title": "Message Kind 7",
+            "type": "object"
         }
     }
-}`
+}
+`
@@ -1,25 +1,49 @@
 package testdata
 
 const MessageWithComments = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/MessageWithComments",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "MessageWithComments": {
+            "additionalProperties": true,
+            "description": "This is a leading detached comment (which becomes the title)  This is a message level comment and talks about what this message is and why you should care about it!",
             "properties": {
-                "name1": {
-                    "type": "string",
-                    "description": "This field is supposed to represent blahblahblah"
-                },
                 "excludedComment": {
                     "type": "string"
+                },
+                "name1": {
+                    "description": "This field is supposed to represent blahblahblah",
+                    "type": "string"
                 }
             },
+            "title": "This is a leading detached comment (which becomes the title)",
+            "type": "object"
+        }
+    }
+}
+`
+
+const MessageWithCommentsAsExtension = `{
+    "$ref": "#/definitions/MessageWithComments",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "MessageWithComments": {
             "additionalProperties": true,
-            "type": "object",
+            "properties": {
+                "excludedComment": {
+                    "type": "string"
+                },
+                "name1": {
+                    "type": "string",
+                    "x-proto-comment": "This field is supposed to represent blahblahblah"
+                }
+            },
             "title": "This is a leading detached comment (which becomes the title)",
-            "description": "This is a leading detached comment (which becomes the title)  This is a message level comment and talks about what this message is and why you should care about it!"
+            "type": "object",
+            "x-proto-comment": "This is a leading detached comment (which becomes the title)  This is a message level comment and talks about what this message is and why you should care about it!"
         }
     }
-}`
+}
+`
 
 const MessageWithCommentsFail = `{"name1": 12345}`
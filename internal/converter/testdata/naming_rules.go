@@ -0,0 +1,33 @@
+package testdata
+
+const NamingRules = `{
+    "$ref": "#/definitions/NamingRules",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "NamingRules": {
+            "additionalProperties": true,
+            "properties": {
+                "billing_email": {
+                    "format": "email",
+                    "type": "string",
+                    "x-pii": true
+                },
+                "discount_cents": {
+                    "type": "string"
+                },
+                "total_cents": {
+                    "description": "Amount in cents",
+                    "minimum": 0,
+                    "type": "integer"
+                }
+            },
+            "title": "Naming Rules",
+            "type": "object"
+        }
+    }
+}
+`
+
+const NamingRulesFail = `{"total_cents": -1}`
+
+const NamingRulesPass = `{"billing_email": "someone@example.com", "total_cents": 1250, "discount_cents": "-100"}`
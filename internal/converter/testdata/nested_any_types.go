@@ -0,0 +1,132 @@
+package testdata
+
+const NestedAnyTypes = `{
+    "$ref": "#/definitions/NestedAnyTypes",
+    "$schema": "http://json-schema.org/draft-06/schema#",
+    "definitions": {
+        "NestedAnyTypes": {
+            "additionalProperties": true,
+            "description": "Any payloads refer to their definitions, so a payload can hold itself:",
+            "properties": {
+                "child": {
+                    "oneOf": [
+                        {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/NestedAnyTypes"
+                                },
+                                {
+                                    "properties": {
+                                        "@type": {
+                                            "const": "type.googleapis.com/samples.NestedAnyTypes",
+                                            "type": "string"
+                                        }
+                                    },
+                                    "required": [
+                                        "@type"
+                                    ]
+                                }
+                            ]
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Nested Any Types",
+            "type": "object"
+        }
+    }
+}
+`
+
+const NestedAnyTypesFail = `{
+	"child": {"@type": "type.googleapis.com/samples.NestedAnyTypes", "child": {"@type": "type.googleapis.com/samples.NestedAnyTypes", "name": 5}}
+}`
+
+const NestedAnyTypesPass = `{
+	"name": "grandparent",
+	"child": {"@type": "type.googleapis.com/samples.NestedAnyTypes", "name": "parent", "child": {"@type": "type.googleapis.com/samples.NestedAnyTypes", "name": "child"}}
+}`
+
+const NestedAnyTypesStrict = `{
+    "$ref": "#/definitions/NestedAnyTypes",
+    "$schema": "http://json-schema.org/draft-06/schema#",
+    "definitions": {
+        "NestedAnyTypes": {
+            "additionalProperties": false,
+            "description": "Any payloads refer to their definitions, so a payload can hold itself:",
+            "properties": {
+                "child": {
+                    "oneOf": [
+                        {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/NestedAnyTypes@type"
+                                },
+                                {
+                                    "properties": {
+                                        "@type": {
+                                            "const": "type.googleapis.com/samples.NestedAnyTypes",
+                                            "type": "string"
+                                        }
+                                    },
+                                    "required": [
+                                        "@type"
+                                    ]
+                                }
+                            ]
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Nested Any Types",
+            "type": "object"
+        },
+        "NestedAnyTypes@type": {
+            "additionalProperties": false,
+            "description": "Any payloads refer to their definitions, so a payload can hold itself:",
+            "properties": {
+                "@type": {
+                    "type": "string"
+                },
+                "child": {
+                    "oneOf": [
+                        {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/NestedAnyTypes@type"
+                                },
+                                {
+                                    "properties": {
+                                        "@type": {
+                                            "const": "type.googleapis.com/samples.NestedAnyTypes",
+                                            "type": "string"
+                                        }
+                                    },
+                                    "required": [
+                                        "@type"
+                                    ]
+                                }
+                            ]
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Nested Any Types",
+            "type": "object"
+        }
+    }
+}
+`
+
+const NestedAnyTypesStrictFail = `{
+	"child": {"@type": "type.googleapis.com/samples.NestedAnyTypes", "child": {"@type": "type.googleapis.com/samples.NestedAnyTypes", "colour": "brown"}}
+}`
@@ -1,39 +1,40 @@
 package testdata
 
 const NestedMessage = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/NestedMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "NestedMessage": {
+            "additionalProperties": true,
             "properties": {
+                "description": {
+                    "type": "string"
+                },
                 "payload": {
                     "$ref": "#/definitions/samples.PayloadMessage",
                     "additionalProperties": true
-                },
-                "description": {
-                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Nested Message"
+            "title": "Nested Message",
+            "type": "object"
         },
         "samples.PayloadMessage": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 },
                 "topology": {
                     "enum": [
@@ -61,12 +62,12 @@ const NestedMessage = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Payload Message"
+            "title": "Payload Message",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const NestedMessageFail = `{
 	"payload": {
@@ -79,3 +80,213 @@ const NestedMessagePass = `{
 		"topology": "FLAT"
 	}
 }`
+
+const NestedMessageKebabNaming = `{
+    "$ref": "#/definitions/NestedMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "NestedMessage": {
+            "additionalProperties": true,
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "payload": {
+                    "$ref": "#/definitions/samples_PayloadMessage",
+                    "additionalProperties": true
+                }
+            },
+            "title": "Nested Message",
+            "type": "object"
+        },
+        "samples_PayloadMessage": {
+            "additionalProperties": true,
+            "properties": {
+                "complete": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "topology": {
+                    "enum": [
+                        "FLAT",
+                        0,
+                        "NESTED_OBJECT",
+                        1,
+                        "NESTED_MESSAGE",
+                        2,
+                        "ARRAY_OF_TYPE",
+                        3,
+                        "ARRAY_OF_OBJECT",
+                        4,
+                        "ARRAY_OF_MESSAGE",
+                        5
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ],
+                    "title": "Topology"
+                }
+            },
+            "title": "Payload Message",
+            "type": "object"
+        }
+    },
+    "id": "https://schemas.acme.com/nestedmessage.schema.json"
+}
+`
+
+const NestedMessageSlugs = `{
+    "$ref": "#/definitions/NestedMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "NestedMessage": {
+            "additionalProperties": true,
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "payload": {
+                    "$ref": "#/definitions/type.acme.com_2Fsamples.PayloadMessage_7Ev1",
+                    "additionalProperties": true
+                }
+            },
+            "title": "Nested Message",
+            "type": "object"
+        },
+        "type.acme.com_2Fsamples.PayloadMessage_7Ev1": {
+            "additionalProperties": true,
+            "properties": {
+                "complete": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "topology": {
+                    "enum": [
+                        "FLAT",
+                        0,
+                        "NESTED_OBJECT",
+                        1,
+                        "NESTED_MESSAGE",
+                        2,
+                        "ARRAY_OF_TYPE",
+                        3,
+                        "ARRAY_OF_OBJECT",
+                        4,
+                        "ARRAY_OF_MESSAGE",
+                        5
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ],
+                    "title": "Topology"
+                }
+            },
+            "title": "Payload Message",
+            "type": "object"
+        }
+    }
+}
+`
+
+const NestedMessageSlugsAnchors = `{
+    "$defs": {
+        "NestedMessage": {
+            "$anchor": "NestedMessage",
+            "additionalProperties": true,
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "payload": {
+                    "$ref": "#/$defs/type.acme.com_2Fsamples.PayloadMessage_7Ev1",
+                    "additionalProperties": true
+                }
+            },
+            "title": "Nested Message",
+            "type": "object"
+        },
+        "type.acme.com_2Fsamples.PayloadMessage_7Ev1": {
+            "$anchor": "type.acme.com_2Fsamples.PayloadMessage_7Ev1",
+            "additionalProperties": true,
+            "properties": {
+                "complete": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "topology": {
+                    "enum": [
+                        "FLAT",
+                        0,
+                        "NESTED_OBJECT",
+                        1,
+                        "NESTED_MESSAGE",
+                        2,
+                        "ARRAY_OF_TYPE",
+                        3,
+                        "ARRAY_OF_OBJECT",
+                        4,
+                        "ARRAY_OF_MESSAGE",
+                        5
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ],
+                    "title": "Topology"
+                }
+            },
+            "title": "Payload Message",
+            "type": "object"
+        }
+    },
+    "$ref": "#/$defs/NestedMessage",
+    "$schema": "https://json-schema.org/draft/2020-12/schema"
+}
+`
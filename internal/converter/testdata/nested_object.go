@@ -1,39 +1,40 @@
 package testdata
 
 const NestedObject = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/NestedObject",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "NestedObject": {
+            "additionalProperties": true,
             "properties": {
+                "description": {
+                    "type": "string"
+                },
                 "payload": {
                     "$ref": "#/definitions/samples.NestedObject.NestedPayload",
                     "additionalProperties": true
-                },
-                "description": {
-                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Nested Object"
+            "title": "Nested Object",
+            "type": "object"
         },
         "samples.NestedObject.NestedPayload": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 },
                 "topology": {
                     "enum": [
@@ -61,12 +62,12 @@ const NestedObject = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Nested Payload"
+            "title": "Nested Payload",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const NestedObjectFail = `{"payload": false}`
 
@@ -0,0 +1,165 @@
+package testdata
+
+const NestingDepthL1 = `{
+    "$ref": "#/definitions/L1",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "L1": {
+            "additionalProperties": true,
+            "properties": {
+                "next": {
+                    "$ref": "#/definitions/samples.L2",
+                    "additionalProperties": true
+                }
+            },
+            "title": "L 1",
+            "type": "object"
+        },
+        "samples.L2": {
+            "additionalProperties": true,
+            "properties": {
+                "next": {
+                    "$ref": "#/definitions/samples.L3",
+                    "additionalProperties": true
+                }
+            },
+            "title": "L 2",
+            "type": "object"
+        },
+        "samples.L3": {
+            "x-truncated": true
+        }
+    }
+}
+`
+
+const NestingDepthL2 = `{
+    "$ref": "#/definitions/L2",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "L2": {
+            "additionalProperties": true,
+            "properties": {
+                "next": {
+                    "$ref": "#/definitions/samples.L3",
+                    "additionalProperties": true
+                }
+            },
+            "title": "L 2",
+            "type": "object"
+        },
+        "samples.L3": {
+            "additionalProperties": true,
+            "properties": {
+                "next": {
+                    "$ref": "#/definitions/samples.L4",
+                    "additionalProperties": true
+                }
+            },
+            "title": "L 3",
+            "type": "object"
+        },
+        "samples.L4": {
+            "x-truncated": true
+        }
+    }
+}
+`
+
+const NestingDepthL3 = `{
+    "$ref": "#/definitions/L3",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "L3": {
+            "additionalProperties": true,
+            "properties": {
+                "next": {
+                    "$ref": "#/definitions/samples.L4",
+                    "additionalProperties": true
+                }
+            },
+            "title": "L 3",
+            "type": "object"
+        },
+        "samples.L4": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "L 4",
+            "type": "object"
+        }
+    }
+}
+`
+
+const NestingDepthL4 = `{
+    "$ref": "#/definitions/L4",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "L4": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "L 4",
+            "type": "object"
+        }
+    }
+}
+`
+
+const NestingDepthUnlimited = `{
+    "$ref": "#/definitions/L1",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "L1": {
+            "additionalProperties": true,
+            "properties": {
+                "next": {
+                    "$ref": "#/definitions/samples.L2",
+                    "additionalProperties": true
+                }
+            },
+            "title": "L 1",
+            "type": "object"
+        },
+        "samples.L2": {
+            "additionalProperties": true,
+            "properties": {
+                "next": {
+                    "$ref": "#/definitions/samples.L3",
+                    "additionalProperties": true
+                }
+            },
+            "title": "L 2",
+            "type": "object"
+        },
+        "samples.L3": {
+            "additionalProperties": true,
+            "properties": {
+                "next": {
+                    "$ref": "#/definitions/samples.L4",
+                    "additionalProperties": true
+                }
+            },
+            "title": "L 3",
+            "type": "object"
+        },
+        "samples.L4": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "L 4",
+            "type": "object"
+        }
+    }
+}
+`
@@ -0,0 +1,231 @@
+package testdata
+
+const NoPackage = `{
+    "$ref": "#/definitions/NoPackage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "NoPackage": {
+            "additionalProperties": true,
+            "properties": {
+                "complete": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "$ref": "#/definitions/Owner",
+                    "additionalProperties": true
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "status": {
+                    "enum": [
+                        "STATUS_UNSPECIFIED",
+                        0,
+                        "STATUS_ACTIVE",
+                        1
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ],
+                    "title": "Status"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            },
+            "title": "No Package",
+            "type": "object"
+        },
+        "Owner": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Owner",
+            "type": "object"
+        }
+    }
+}
+`
+
+const NoPackageOwner = `{
+    "$ref": "#/definitions/Owner",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Owner": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Owner",
+            "type": "object"
+        }
+    }
+}
+`
+
+const NoPackageFail = `{"owner": {"name": 12}, "status": "STATUS_RETIRED"}`
+
+const NoPackagePass = `{"name": "Tiger", "owner": {"name": "Ollie"}, "status": "STATUS_ACTIVE"}`
+
+const NoPackageBundled = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "NoPackage": {
+            "additionalProperties": true,
+            "properties": {
+                "complete": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "$ref": "#/definitions/Owner",
+                    "additionalProperties": true
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "status": {
+                    "enum": [
+                        "STATUS_UNSPECIFIED",
+                        0,
+                        "STATUS_ACTIVE",
+                        1
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ],
+                    "title": "Status"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            },
+            "title": "No Package",
+            "type": "object"
+        },
+        "Owner": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Owner",
+            "type": "object"
+        }
+    }
+}
+`
+
+const NoPackageTypeURLs = `{
+    "$ref": "#/definitions/NoPackage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "NoPackage": {
+            "additionalProperties": true,
+            "properties": {
+                "complete": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "$ref": "#/definitions/Owner",
+                    "additionalProperties": true
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "status": {
+                    "enum": [
+                        "STATUS_UNSPECIFIED",
+                        0,
+                        "STATUS_ACTIVE",
+                        1
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ],
+                    "title": "Status"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            },
+            "title": "No Package",
+            "type": "object"
+        },
+        "Owner": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Owner",
+            "type": "object"
+        }
+    },
+    "id": "type.googleapis.com/NoPackage"
+}
+`
+
+const NoPackageTypeURLsOwner = `{
+    "$ref": "#/definitions/Owner",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Owner": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Owner",
+            "type": "object"
+        }
+    },
+    "id": "type.googleapis.com/Owner"
+}
+`
+
+const NoPackageTypeURLsIndex = `{
+    "NoPackage": "type.googleapis.com/NoPackage.json",
+    "Owner": "type.googleapis.com/Owner.json"
+}
+`
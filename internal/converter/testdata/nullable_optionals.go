@@ -0,0 +1,53 @@
+package testdata
+
+const NullableOptionals = `{
+    "$ref": "#/definitions/NullableOptionals",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "NullableOptionals": {
+            "additionalProperties": true,
+            "properties": {
+                "age": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nickname": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "title": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                }
+            },
+            "title": "Nullable Optionals",
+            "type": "object"
+        }
+    }
+}
+`
+
+const NullableOptionalsPass = `{"name": "Prince", "nickname": null, "age": null, "title": null}`
+
+const NullableOptionalsFail = `{"name": null}`
@@ -1,25 +1,11 @@
 package testdata
 
 const OneOf = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/OneOf",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "OneOf": {
-            "properties": {
-                "bar": {
-                    "$ref": "#/definitions/samples.OneOf.Bar",
-                    "additionalProperties": true
-                },
-                "baz": {
-                    "$ref": "#/definitions/samples.OneOf.Baz",
-                    "additionalProperties": true
-                },
-                "something": {
-                    "type": "boolean"
-                }
-            },
             "additionalProperties": true,
-            "type": "object",
             "oneOf": [
                 {
                     "required": [
@@ -32,36 +18,51 @@ const OneOf = `{
                     ]
                 }
             ],
-            "title": "One Of"
+            "properties": {
+                "bar": {
+                    "$ref": "#/definitions/samples.OneOf.Bar",
+                    "additionalProperties": true
+                },
+                "baz": {
+                    "$ref": "#/definitions/samples.OneOf.Baz",
+                    "additionalProperties": true
+                },
+                "something": {
+                    "type": "boolean"
+                }
+            },
+            "title": "One Of",
+            "type": "object"
         },
         "samples.OneOf.Bar": {
-            "required": [
-                "foo"
-            ],
+            "additionalProperties": true,
             "properties": {
                 "foo": {
                     "type": "integer"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Bar"
-        },
-        "samples.OneOf.Baz": {
             "required": [
                 "foo"
             ],
+            "title": "Bar",
+            "type": "object"
+        },
+        "samples.OneOf.Baz": {
+            "additionalProperties": true,
             "properties": {
                 "foo": {
                     "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Baz"
+            "required": [
+                "foo"
+            ],
+            "title": "Baz",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const OneOfFail = `{
 	"something": true,
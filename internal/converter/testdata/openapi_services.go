@@ -0,0 +1,156 @@
+package testdata
+
+const OpenAPIServicesPlaceOrderRequest = `{
+    "components": {
+        "schemas": {
+            "PlaceOrderRequest": {
+                "additionalProperties": true,
+                "properties": {
+                    "customer": {
+                        "type": "string"
+                    },
+                    "items": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array"
+                    }
+                },
+                "title": "Place Order Request",
+                "type": "object"
+            }
+        }
+    }
+}
+`
+
+const OpenAPIServicesOrder = `{
+    "components": {
+        "schemas": {
+            "Order": {
+                "additionalProperties": true,
+                "properties": {
+                    "id": {
+                        "type": "string"
+                    },
+                    "quantity": {
+                        "type": "integer"
+                    }
+                },
+                "title": "Order",
+                "type": "object"
+            }
+        }
+    }
+}
+`
+
+const OpenAPIServices = `{
+    "components": {
+        "schemas": {
+            "Order": {
+                "additionalProperties": true,
+                "properties": {
+                    "id": {
+                        "type": "string"
+                    },
+                    "quantity": {
+                        "type": "integer"
+                    }
+                },
+                "title": "Order",
+                "type": "object"
+            },
+            "PlaceOrderRequest": {
+                "additionalProperties": true,
+                "properties": {
+                    "customer": {
+                        "type": "string"
+                    },
+                    "items": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array"
+                    }
+                },
+                "title": "Place Order Request",
+                "type": "object"
+            }
+        },
+        "securitySchemes": {
+            "oauth2": {
+                "flows": {
+                    "authorizationCode": {
+                        "authorizationUrl": "https://accounts.google.com/o/oauth2/auth",
+                        "scopes": {
+                            "https://www.acme.com/auth/orders": "",
+                            "https://www.acme.com/auth/orders.readonly": ""
+                        },
+                        "tokenUrl": "https://oauth2.googleapis.com/token"
+                    }
+                },
+                "type": "oauth2"
+            }
+        }
+    },
+    "info": {
+        "title": "samples",
+        "version": "unversioned"
+    },
+    "openapi": "3.0.3",
+    "paths": {
+        "/samples.OrderService/PlaceOrder": {
+            "post": {
+                "description": "Places an order. The order is checked before it is accepted.",
+                "operationId": "OrderService_PlaceOrder",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/PlaceOrderRequest"
+                            }
+                        }
+                    },
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/Order"
+                                }
+                            }
+                        },
+                        "description": "A successful response."
+                    }
+                },
+                "security": [
+                    {
+                        "oauth2": [
+                            "https://www.acme.com/auth/orders",
+                            "https://www.acme.com/auth/orders.readonly"
+                        ]
+                    }
+                ],
+                "summary": "Places an order.",
+                "tags": [
+                    "OrderService"
+                ]
+            }
+        }
+    },
+    "servers": [
+        {
+            "url": "https://orders.acme.com"
+        }
+    ],
+    "tags": [
+        {
+            "description": "Manages orders placed by customers.",
+            "name": "OrderService"
+        }
+    ]
+}
+`
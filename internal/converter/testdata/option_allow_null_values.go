@@ -1,18 +1,27 @@
 package testdata
 
 const OptionAllowNullValues = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/OptionAllowNullValues",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "OptionAllowNullValues": {
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ],
             "properties": {
-                "name2": {
+                "complete2": {
                     "oneOf": [
                         {
                             "type": "null"
                         },
                         {
-                            "type": "string"
+                            "type": "boolean"
                         }
                     ]
                 },
@@ -26,30 +35,22 @@ const OptionAllowNullValues = `{
                         }
                     ]
                 },
-                "complete2": {
+                "name2": {
                     "oneOf": [
                         {
                             "type": "null"
                         },
                         {
-                            "type": "boolean"
+                            "type": "string"
                         }
                     ]
                 }
             },
-            "additionalProperties": true,
-            "oneOf": [
-                {
-                    "type": "null"
-                },
-                {
-                    "type": "object"
-                }
-            ],
             "title": "Option Allow Null Values"
         }
     }
-}`
+}
+`
 
 const OptionAllowNullValuesFail = `{"name2": 12345}`
 
@@ -0,0 +1,261 @@
+package testdata
+
+const OptionAnyTypes = `{
+    "$ref": "#/definitions/OptionAnyTypes",
+    "$schema": "http://json-schema.org/draft-06/schema#",
+    "definitions": {
+        "OptionAnyTypes": {
+            "additionalProperties": true,
+            "properties": {
+                "anything": {
+                    "additionalProperties": true,
+                    "properties": {
+                        "@type": {
+                            "description": "A URL identifying the type of the payload (eg \"type.googleapis.com/acme.Dog\"), whose fields accompany it.",
+                            "type": "string"
+                        }
+                    },
+                    "type": "object"
+                },
+                "payload": {
+                    "oneOf": [
+                        {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/samples.OptionAnyTypes.Cat"
+                                },
+                                {
+                                    "properties": {
+                                        "@type": {
+                                            "const": "type.googleapis.com/samples.OptionAnyTypes.Cat",
+                                            "type": "string"
+                                        }
+                                    },
+                                    "required": [
+                                        "@type"
+                                    ]
+                                }
+                            ]
+                        },
+                        {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/samples.OptionAnyTypes.Dog"
+                                },
+                                {
+                                    "properties": {
+                                        "@type": {
+                                            "const": "type.googleapis.com/samples.OptionAnyTypes.Dog",
+                                            "type": "string"
+                                        }
+                                    },
+                                    "required": [
+                                        "@type"
+                                    ]
+                                }
+                            ]
+                        }
+                    ]
+                },
+                "pets": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "allOf": [
+                                    {
+                                        "$ref": "#/definitions/samples.OptionAnyTypes.Dog"
+                                    },
+                                    {
+                                        "properties": {
+                                            "@type": {
+                                                "const": "type.googleapis.com/samples.OptionAnyTypes.Dog",
+                                                "type": "string"
+                                            }
+                                        },
+                                        "required": [
+                                            "@type"
+                                        ]
+                                    }
+                                ]
+                            }
+                        ]
+                    },
+                    "type": "array"
+                }
+            },
+            "title": "Option Any Types",
+            "type": "object"
+        },
+        "samples.OptionAnyTypes.Cat": {
+            "additionalProperties": true,
+            "properties": {
+                "indoor": {
+                    "type": "boolean"
+                }
+            },
+            "title": "Cat",
+            "type": "object"
+        },
+        "samples.OptionAnyTypes.Dog": {
+            "additionalProperties": true,
+            "properties": {
+                "breed": {
+                    "type": "string"
+                }
+            },
+            "title": "Dog",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionAnyTypesFail = `{
+	"payload": {"@type": "type.googleapis.com/samples.OptionAnyTypes.Cat", "indoor": "yes"},
+	"pets": [{"breed": "labrador"}]
+}`
+
+const OptionAnyTypesPass = `{
+	"payload": {"@type": "type.googleapis.com/samples.OptionAnyTypes.Dog", "breed": "collie"},
+	"pets": [{"@type": "type.googleapis.com/samples.OptionAnyTypes.Dog", "breed": "labrador"}]
+}`
+
+const OptionAnyTypesStrict = `{
+    "$ref": "#/definitions/OptionAnyTypes",
+    "$schema": "http://json-schema.org/draft-06/schema#",
+    "definitions": {
+        "OptionAnyTypes": {
+            "additionalProperties": false,
+            "properties": {
+                "anything": {
+                    "additionalProperties": false,
+                    "properties": {
+                        "@type": {
+                            "description": "A URL identifying the type of the payload (eg \"type.googleapis.com/acme.Dog\"), whose fields accompany it.",
+                            "type": "string"
+                        }
+                    },
+                    "type": "object"
+                },
+                "payload": {
+                    "oneOf": [
+                        {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/samples.OptionAnyTypes.Cat@type"
+                                },
+                                {
+                                    "properties": {
+                                        "@type": {
+                                            "const": "type.googleapis.com/samples.OptionAnyTypes.Cat",
+                                            "type": "string"
+                                        }
+                                    },
+                                    "required": [
+                                        "@type"
+                                    ]
+                                }
+                            ]
+                        },
+                        {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/samples.OptionAnyTypes.Dog@type"
+                                },
+                                {
+                                    "properties": {
+                                        "@type": {
+                                            "const": "type.googleapis.com/samples.OptionAnyTypes.Dog",
+                                            "type": "string"
+                                        }
+                                    },
+                                    "required": [
+                                        "@type"
+                                    ]
+                                }
+                            ]
+                        }
+                    ]
+                },
+                "pets": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "allOf": [
+                                    {
+                                        "$ref": "#/definitions/samples.OptionAnyTypes.Dog@type"
+                                    },
+                                    {
+                                        "properties": {
+                                            "@type": {
+                                                "const": "type.googleapis.com/samples.OptionAnyTypes.Dog",
+                                                "type": "string"
+                                            }
+                                        },
+                                        "required": [
+                                            "@type"
+                                        ]
+                                    }
+                                ]
+                            }
+                        ]
+                    },
+                    "type": "array"
+                }
+            },
+            "title": "Option Any Types",
+            "type": "object"
+        },
+        "samples.OptionAnyTypes.Cat": {
+            "additionalProperties": false,
+            "properties": {
+                "indoor": {
+                    "type": "boolean"
+                }
+            },
+            "title": "Cat",
+            "type": "object"
+        },
+        "samples.OptionAnyTypes.Cat@type": {
+            "additionalProperties": false,
+            "properties": {
+                "@type": {
+                    "type": "string"
+                },
+                "indoor": {
+                    "type": "boolean"
+                }
+            },
+            "title": "Cat",
+            "type": "object"
+        },
+        "samples.OptionAnyTypes.Dog": {
+            "additionalProperties": false,
+            "properties": {
+                "breed": {
+                    "type": "string"
+                }
+            },
+            "title": "Dog",
+            "type": "object"
+        },
+        "samples.OptionAnyTypes.Dog@type": {
+            "additionalProperties": false,
+            "properties": {
+                "@type": {
+                    "type": "string"
+                },
+                "breed": {
+                    "type": "string"
+                }
+            },
+            "title": "Dog",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionAnyTypesStrictFail = `{
+	"payload": {"@type": "type.googleapis.com/samples.OptionAnyTypes.Dog", "breed": "collie", "colour": "brown"}
+}`
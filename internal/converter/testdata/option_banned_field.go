@@ -0,0 +1,38 @@
+package testdata
+
+const OptionBannedField = `{
+    "$ref": "#/definitions/OptionBannedField",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionBannedField": {
+            "additionalProperties": true,
+            "not": {
+                "anyOf": [
+                    {
+                        "required": [
+                            "legacy_id"
+                        ]
+                    },
+                    {
+                        "required": [
+                            "legacy_count"
+                        ]
+                    }
+                ],
+                "type": "object"
+            },
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Option Banned Field",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionBannedFieldFail = `{"name": "hello", "legacy_count": 3}`
+
+const OptionBannedFieldPass = `{"name": "hello", "other": 3}`
@@ -0,0 +1,56 @@
+package testdata
+
+const OptionContains = `{
+    "$ref": "#/definitions/OptionContains",
+    "$schema": "http://json-schema.org/draft-06/schema#",
+    "definitions": {
+        "OptionContains": {
+            "additionalProperties": true,
+            "properties": {
+                "events": {
+                    "contains": {
+                        "properties": {
+                            "kind": {
+                                "const": "created"
+                            }
+                        },
+                        "required": [
+                            "kind"
+                        ]
+                    },
+                    "items": {
+                        "$ref": "#/definitions/samples.OptionContains.Event"
+                    },
+                    "minContains": 1,
+                    "type": "array"
+                },
+                "tags": {
+                    "contains": {
+                        "const": "primary"
+                    },
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                }
+            },
+            "title": "Option Contains",
+            "type": "object"
+        },
+        "samples.OptionContains.Event": {
+            "additionalProperties": true,
+            "properties": {
+                "kind": {
+                    "type": "string"
+                }
+            },
+            "title": "Event",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionContainsFail = `{"tags": ["secondary"], "events": [{"kind": "updated"}]}`
+
+const OptionContainsPass = `{"tags": ["secondary", "primary"], "events": [{"kind": "updated"}, {"kind": "created"}]}`
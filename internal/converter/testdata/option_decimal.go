@@ -0,0 +1,41 @@
+package testdata
+
+const OptionDecimal = `{
+    "$ref": "#/definitions/OptionDecimal",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionDecimal": {
+            "additionalProperties": true,
+            "properties": {
+                "amount": {
+                    "pattern": "^-?\\d{1,8}(\\.\\d{1,2})?$",
+                    "type": "string",
+                    "x-precision": 10,
+                    "x-scale": 2
+                },
+                "amounts": {
+                    "items": {
+                        "pattern": "^-?\\d{1,3}(\\.\\d{1,2})?$",
+                        "type": "string",
+                        "x-precision": 5,
+                        "x-scale": 2
+                    },
+                    "type": "array"
+                },
+                "units": {
+                    "pattern": "^-?\\d{1,4}$",
+                    "type": "string",
+                    "x-precision": 4,
+                    "x-scale": 0
+                }
+            },
+            "title": "Option Decimal",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionDecimalFail = `{"amount": "12.345", "amounts": ["1.50", "1234.5"]}`
+
+const OptionDecimalPass = `{"amount": "-12345678.90", "units": "42", "amounts": ["1.50", "-123.4"]}`
@@ -1,33 +1,34 @@
 package testdata
 
 const OptionDisallowAdditionalProperties = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/OptionDisallowAdditionalProperties",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "OptionDisallowAdditionalProperties": {
+            "additionalProperties": false,
             "properties": {
-                "name2": {
-                    "type": "string"
-                },
-                "timestamp2": {
-                    "type": "string"
+                "complete2": {
+                    "type": "boolean"
                 },
                 "id2": {
                     "type": "integer"
                 },
+                "name2": {
+                    "type": "string"
+                },
                 "rating2": {
                     "type": "number"
                 },
-                "complete2": {
-                    "type": "boolean"
+                "timestamp2": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": false,
-            "type": "object",
-            "title": "Option Disallow Additional Properties"
+            "title": "Option Disallow Additional Properties",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const OptionDisallowAdditionalPropertiesFail = `{"something": 12345}`
 
@@ -0,0 +1,20 @@
+package testdata
+
+const OptionEnumsAllowLowercase = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "enum": [
+        "RED",
+        "red",
+        "GREEN",
+        "green",
+        "BLUE",
+        "blue"
+    ],
+    "title": "Colour",
+    "type": "string"
+}
+`
+
+const OptionEnumsAllowLowercasePass = `"green"`
+
+const OptionEnumsAllowLowercaseFail = `"Green"`
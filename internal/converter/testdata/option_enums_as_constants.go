@@ -1,12 +1,14 @@
 package testdata
 
 const OptionEnumsAsConstants = `{
-    "$schema": "http://json-schema.org/draft-06/schema#",
     "$ref": "#/definitions/OptionEnumsAsConstants",
+    "$schema": "http://json-schema.org/draft-06/schema#",
     "definitions": {
         "OptionEnumsAsConstants": {
+            "additionalProperties": true,
             "properties": {
                 "importedEnum": {
+                    "description": "This is an enum",
                     "enum": [
                         "VALUE_0",
                         0,
@@ -19,48 +21,47 @@ const OptionEnumsAsConstants = `{
                     ],
                     "oneOf": [
                         {
-                            "description": "Zero",
-                            "const": "VALUE_0"
+                            "const": "VALUE_0",
+                            "description": "Zero"
                         },
                         {
-                            "description": "Zero",
-                            "const": 0
+                            "const": 0,
+                            "description": "Zero"
                         },
                         {
-                            "description": "One",
-                            "const": "VALUE_1"
+                            "const": "VALUE_1",
+                            "description": "One"
                         },
                         {
-                            "description": "One",
-                            "const": 1
+                            "const": 1,
+                            "description": "One"
                         },
                         {
-                            "description": "Two",
-                            "const": "VALUE_2"
+                            "const": "VALUE_2",
+                            "description": "Two"
                         },
                         {
-                            "description": "Two",
-                            "const": 2
+                            "const": 2,
+                            "description": "Two"
                         },
                         {
-                            "description": "Three",
-                            "const": "VALUE_3"
+                            "const": "VALUE_3",
+                            "description": "Three"
                         },
                         {
-                            "description": "Three",
-                            "const": 3
+                            "const": 3,
+                            "description": "Three"
                         }
                     ],
-                    "title": "Imported Enum",
-                    "description": "This is an enum"
+                    "title": "Imported Enum"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Option Enums As Constants"
+            "title": "Option Enums As Constants",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const OptionEnumsAsConstantsFail = `{"importedEnum": "VALUE_4"}`
 
@@ -0,0 +1,25 @@
+package testdata
+
+const OptionEnumsAsIntegersOnly = `{
+    "$schema": "http://json-schema.org/draft-06/schema#",
+    "description": "Severity of an incident",
+    "oneOf": [
+        {
+            "const": 0,
+            "description": "SEVERITY_UNSPECIFIED"
+        },
+        {
+            "const": 1,
+            "description": "LOW: Somebody should look at this eventually"
+        },
+        {
+            "const": 2,
+            "description": "HIGH: Wake somebody up"
+        }
+    ],
+    "title": "Severity"
+}
+`
+
+const OptionEnumsAsIntegersOnlyPass = `2`
+const OptionEnumsAsIntegersOnlyFail = `"HIGH"`
@@ -8,9 +8,10 @@ const OptionEnumsAsStringsOnly = `{
         "GBP",
         "EUR"
     ],
-    "type": "string",
-    "title": "Currency"
-}`
+    "title": "Currency",
+    "type": "string"
+}
+`
 
 const OptionEnumsAsStringsOnlyPass = `"NOT_SPECIFIED"`
 const OptionEnumsAsStringsOnlyFail = `2`
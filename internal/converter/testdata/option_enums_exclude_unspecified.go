@@ -0,0 +1,36 @@
+package testdata
+
+const OptionEnumsExcludeUnspecified = `{
+    "$ref": "#/definitions/Shirt",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Shirt": {
+            "additionalProperties": true,
+            "properties": {
+                "legacy_size": {
+                    "enum": [
+                        "SMALL",
+                        "LARGE"
+                    ],
+                    "title": "Size",
+                    "type": "string"
+                },
+                "size": {
+                    "enum": [
+                        "SIZE_SMALL",
+                        "SIZE_LARGE"
+                    ],
+                    "title": "Size",
+                    "type": "string"
+                }
+            },
+            "title": "Shirt",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionEnumsExcludeUnspecifiedPass = `{"size": "SIZE_SMALL", "legacy_size": "LARGE"}`
+
+const OptionEnumsExcludeUnspecifiedFail = `{"size": "SIZE_UNSPECIFIED"}`
@@ -7,9 +7,10 @@ const OptionEnumsTrimPrefix = `{
         "HTTP",
         "HTTPS"
     ],
-    "type": "string",
-    "title": "Scheme"
-}`
+    "title": "Scheme",
+    "type": "string"
+}
+`
 
 const OptionEnumsTrimPrefixPass = `"HTTP"`
 
@@ -0,0 +1,110 @@
+package testdata
+
+const OptionFieldDocs = `{
+    "$ref": "#/definitions/OptionFieldDocs",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionFieldDocs": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "description": "The customer's name, as it appears on invoices",
+                    "type": "string",
+                    "x-comment-description": "The name of the customer"
+                },
+                "note": {
+                    "description": "Free text",
+                    "type": "string"
+                },
+                "postcode": {
+                    "description": "A UK postcode (eg SW1A 1AA)",
+                    "title": "Postcode",
+                    "type": "string",
+                    "x-comment-description": "Postal code  Where the customer lives",
+                    "x-comment-title": "Postal code"
+                },
+                "reference": {
+                    "description": "An opaque reference",
+                    "title": "Reference",
+                    "type": "string"
+                }
+            },
+            "title": "Option Field Docs",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionFieldDocsFail = `{"name": 1}`
+
+const OptionFieldDocsPass = `{"name": "Alice", "postcode": "SW1A 1AA"}`
+
+const OptionFieldDocsComments = `{
+    "$ref": "#/definitions/OptionFieldDocs",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionFieldDocs": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "description": "The name of the customer",
+                    "type": "string",
+                    "x-option-description": "The customer's name, as it appears on invoices"
+                },
+                "note": {
+                    "description": "Free text",
+                    "type": "string"
+                },
+                "postcode": {
+                    "description": "Postal code  Where the customer lives",
+                    "title": "Postal code",
+                    "type": "string",
+                    "x-option-description": "A UK postcode (eg SW1A 1AA)",
+                    "x-option-title": "Postcode"
+                },
+                "reference": {
+                    "description": "An opaque reference",
+                    "title": "Reference",
+                    "type": "string"
+                }
+            },
+            "title": "Option Field Docs",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionFieldDocsConcatenated = `{
+    "$ref": "#/definitions/OptionFieldDocs",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionFieldDocs": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "description": "The name of the customer  The customer's name, as it appears on invoices",
+                    "type": "string"
+                },
+                "note": {
+                    "description": "Free text",
+                    "type": "string"
+                },
+                "postcode": {
+                    "description": "Postal code  Where the customer lives  A UK postcode (eg SW1A 1AA)",
+                    "title": "Postal code  Postcode",
+                    "type": "string"
+                },
+                "reference": {
+                    "description": "An opaque reference",
+                    "title": "Reference",
+                    "type": "string"
+                }
+            },
+            "title": "Option Field Docs",
+            "type": "object"
+        }
+    }
+}
+`
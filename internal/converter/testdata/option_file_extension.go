@@ -1,30 +1,31 @@
 package testdata
 
 const OptionFileExtension = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/OptionFileExtension",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "OptionFileExtension": {
+            "additionalProperties": true,
             "properties": {
-                "name2": {
-                    "type": "string"
-                },
-                "timestamp2": {
-                    "type": "string"
+                "complete2": {
+                    "type": "boolean"
                 },
                 "id2": {
                     "type": "integer"
                 },
+                "name2": {
+                    "type": "string"
+                },
                 "rating2": {
                     "type": "number"
                 },
-                "complete2": {
-                    "type": "boolean"
+                "timestamp2": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Option File Extension"
+            "title": "Option File Extension",
+            "type": "object"
         }
     }
-}`
+}
+`
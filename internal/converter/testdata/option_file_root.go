@@ -0,0 +1,52 @@
+package testdata
+
+const OptionFileRoot = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "AuditRecord": {
+            "additionalProperties": true,
+            "properties": {
+                "actor": {
+                    "type": "string"
+                }
+            },
+            "title": "Audit Record",
+            "type": "object"
+        },
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "line_items": {
+                    "items": {
+                        "$ref": "#/definitions/samples.LineItem"
+                    },
+                    "type": "array"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        },
+        "samples.LineItem": {
+            "additionalProperties": true,
+            "properties": {
+                "quantity": {
+                    "type": "integer"
+                },
+                "sku": {
+                    "type": "string"
+                }
+            },
+            "title": "Line Item",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionFileRootFail = `{"line_items": [{"quantity": "lots"}]}`
+
+const OptionFileRootPass = `{"id": "o-1", "line_items": [{"sku": "abc", "quantity": 2}]}`
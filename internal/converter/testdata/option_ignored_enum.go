@@ -21,4 +21,5 @@ const UnignoredEnum = `{
         }
     ],
     "title": "Unignored Enum"
-}`
+}
+`
@@ -1,10 +1,11 @@
 package testdata
 
 const OptionIgnoredField = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/OptionIgnoredField",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "OptionIgnoredField": {
+            "additionalProperties": true,
             "properties": {
                 "visible1": {
                     "type": "string"
@@ -13,12 +14,12 @@ const OptionIgnoredField = `{
                     "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Option Ignored Field"
+            "title": "Option Ignored Field",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const OptionIgnoredFieldFail = `{"visible1": 12345}`
 
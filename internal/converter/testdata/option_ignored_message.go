@@ -1,30 +1,31 @@
 package testdata
 
 const UnignoredMessage = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/UnignoredMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "UnignoredMessage": {
+            "additionalProperties": true,
             "properties": {
-                "name2": {
-                    "type": "string"
-                },
-                "timestamp2": {
-                    "type": "string"
+                "complete2": {
+                    "type": "boolean"
                 },
                 "id2": {
                     "type": "integer"
                 },
+                "name2": {
+                    "type": "string"
+                },
                 "rating2": {
                     "type": "number"
                 },
-                "complete2": {
-                    "type": "boolean"
+                "timestamp2": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Unignored Message"
+            "title": "Unignored Message",
+            "type": "object"
         }
     }
-}`
+}
+`
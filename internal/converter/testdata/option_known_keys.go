@@ -0,0 +1,56 @@
+package testdata
+
+const OptionKnownKeys = `{
+    "$ref": "#/definitions/OptionKnownKeys",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionKnownKeys": {
+            "additionalProperties": true,
+            "properties": {
+                "limits": {
+                    "additionalProperties": false,
+                    "properties": {
+                        "retries": {
+                            "maximum": 5,
+                            "type": "integer"
+                        },
+                        "timeout": {
+                            "type": "integer"
+                        }
+                    },
+                    "type": "object"
+                },
+                "options": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "properties": {
+                        "colour": {
+                            "enum": [
+                                "red",
+                                "blue"
+                            ]
+                        },
+                        "label": {
+                            "type": "string"
+                        }
+                    },
+                    "type": "object"
+                }
+            },
+            "title": "Option Known Keys",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionKnownKeysFail = `{
+    "options": {"colour": "green"},
+    "limits": {"retries": 10, "backoff": 1}
+}`
+
+const OptionKnownKeysPass = `{
+    "options": {"colour": "red", "label": "primary", "anything": "else"},
+    "limits": {"retries": 3, "timeout": 30}
+}`
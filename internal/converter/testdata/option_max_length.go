@@ -1,13 +1,11 @@
 package testdata
 
 const OptionMaxLength = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/OptionMaxLength",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "OptionMaxLength": {
-            "required": [
-                "query"
-            ],
+            "additionalProperties": true,
             "properties": {
                 "query": {
                     "maxLength": 10,
@@ -17,12 +15,15 @@ const OptionMaxLength = `{
                     "type": "integer"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Option Max Length"
+            "required": [
+                "query"
+            ],
+            "title": "Option Max Length",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const OptionMaxLengthFail = `{
     "query": "abcdefghijklmnopqrstuvwxyz",
@@ -1,13 +1,11 @@
 package testdata
 
 const OptionMinLength = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/OptionMinLength",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "OptionMinLength": {
-            "required": [
-                "query"
-            ],
+            "additionalProperties": true,
             "properties": {
                 "query": {
                     "minLength": 2,
@@ -17,12 +15,15 @@ const OptionMinLength = `{
                     "type": "integer"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Option Min Length"
+            "required": [
+                "query"
+            ],
+            "title": "Option Min Length",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const OptionMinLengthFail = `{
     "query": "a",
@@ -0,0 +1,47 @@
+package testdata
+
+const OptionMultipleOf = `{
+    "$ref": "#/definitions/OptionMultipleOf",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionMultipleOf": {
+            "additionalProperties": true,
+            "properties": {
+                "price_in_cents": {
+                    "multipleOf": 1,
+                    "type": "number"
+                },
+                "quantities": {
+                    "items": {
+                        "multipleOf": 1,
+                        "type": "number"
+                    },
+                    "type": "array"
+                },
+                "ratio": {
+                    "type": "number"
+                },
+                "weight": {
+                    "multipleOf": 0.5,
+                    "type": "number"
+                }
+            },
+            "title": "Option Multiple Of",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionMultipleOfFail = `{
+	"price_in_cents": 199.5,
+	"weight": 1.25,
+	"quantities": [1, 2.5]
+}`
+
+const OptionMultipleOfPass = `{
+	"price_in_cents": 199,
+	"weight": 1.5,
+	"ratio": 0.333,
+	"quantities": [1, 2]
+}`
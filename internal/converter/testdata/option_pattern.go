@@ -1,13 +1,11 @@
 package testdata
 
 const OptionPattern = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/OptionPattern",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "OptionPattern": {
-            "required": [
-                "query"
-            ],
+            "additionalProperties": true,
             "properties": {
                 "query": {
                     "pattern": "^(\\([0-9]{3}\\))?[0-9]{3}-[0-9]{4}$",
@@ -17,12 +15,15 @@ const OptionPattern = `{
                     "type": "integer"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Option Pattern"
+            "required": [
+                "query"
+            ],
+            "title": "Option Pattern",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const OptionPatternFail = `{
     "query": "a",
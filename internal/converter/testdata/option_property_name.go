@@ -0,0 +1,29 @@
+package testdata
+
+const OptionPropertyName = `{
+    "$ref": "#/definitions/OptionPropertyName",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionPropertyName": {
+            "additionalProperties": true,
+            "properties": {
+                "CustomerRef": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                }
+            },
+            "required": [
+                "CustomerRef"
+            ],
+            "title": "Option Property Name",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionPropertyNameFail = `{"customer_id": "c-1", "order_id": "o-1"}`
+
+const OptionPropertyNamePass = `{"CustomerRef": "c-1", "order_id": "o-1"}`
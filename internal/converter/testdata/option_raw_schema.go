@@ -0,0 +1,31 @@
+package testdata
+
+const OptionRawSchema = `{
+    "$ref": "#/definitions/OptionRawSchema",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionRawSchema": {
+            "additionalProperties": true,
+            "properties": {
+                "amount": {
+                    "minimum": 0,
+                    "type": "number"
+                },
+                "currency": {
+                    "pattern": "^[A-Z]{3}$",
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string"
+                }
+            },
+            "title": "Option Raw Schema",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionRawSchemaFail = `{"currency": "gbp", "amount": {"units": 12}}`
+
+const OptionRawSchemaPass = `{"currency": "GBP", "amount": 12.5, "reference": "abc"}`
@@ -1,31 +1,32 @@
 package testdata
 
 const OptionRequiredField = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/OptionRequiredField",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "OptionRequiredField": {
-            "required": [
-                "query",
-                "page_number"
-            ],
+            "additionalProperties": true,
             "properties": {
-                "query": {
-                    "type": "string"
-                },
                 "page_number": {
                     "type": "integer"
                 },
+                "query": {
+                    "type": "string"
+                },
                 "result_per_page": {
                     "type": "integer"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Option Required Field"
+            "required": [
+                "query",
+                "page_number"
+            ],
+            "title": "Option Required Field",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const OptionRequiredFieldFail = `{
 	"page_number": 4
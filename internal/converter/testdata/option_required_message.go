@@ -1,40 +1,41 @@
 package testdata
 
 const OptionRequiredMessage = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/OptionRequiredMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "OptionRequiredMessage": {
-            "required": [
-                "name2",
-                "timestamp2",
-                "id2",
-                "rating2",
-                "complete2"
-            ],
+            "additionalProperties": true,
             "properties": {
-                "name2": {
-                    "type": "string"
-                },
-                "timestamp2": {
-                    "type": "string"
+                "complete2": {
+                    "type": "boolean"
                 },
                 "id2": {
                     "type": "integer"
                 },
+                "name2": {
+                    "type": "string"
+                },
                 "rating2": {
                     "type": "number"
                 },
-                "complete2": {
-                    "type": "boolean"
+                "timestamp2": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Option Required Message"
+            "required": [
+                "name2",
+                "timestamp2",
+                "id2",
+                "rating2",
+                "complete2"
+            ],
+            "title": "Option Required Message",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const OptionRequiredMessageFail = `{
 	"name2": "some name",
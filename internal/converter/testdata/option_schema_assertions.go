@@ -0,0 +1,52 @@
+package testdata
+
+const OptionSchemaAssertions = `{
+    "$ref": "#/definitions/OptionSchemaAssertions",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionSchemaAssertions": {
+            "additionalProperties": false,
+            "description": "An order, which makes assertions about its own schema:",
+            "properties": {
+                "customer": {
+                    "description": "Who placed the order",
+                    "type": "string"
+                },
+                "items": {
+                    "additionalProperties": false,
+                    "description": "What was ordered",
+                    "items": {
+                        "$ref": "#/definitions/samples.OptionSchemaAssertions.Item"
+                    },
+                    "type": "array"
+                },
+                "labels": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "description": "Labels, by name",
+                    "type": "object"
+                }
+            },
+            "title": "Option Schema Assertions",
+            "type": "object"
+        },
+        "samples.OptionSchemaAssertions.Item": {
+            "additionalProperties": false,
+            "description": "Something which was ordered:",
+            "properties": {
+                "code": {
+                    "description": "The product code",
+                    "type": "string"
+                }
+            },
+            "title": "Item",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionSchemaAssertionsFail = `{"customer": "Alice", "items": [{"code": "A1", "colour": "red"}]}`
+
+const OptionSchemaAssertionsPass = `{"customer": "Alice", "items": [{"code": "A1"}], "labels": {"channel": "web"}}`
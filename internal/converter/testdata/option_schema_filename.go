@@ -0,0 +1,19 @@
+package testdata
+
+const OptionSchemaFilename = `{
+    "$ref": "#/definitions/OptionSchemaFilename",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionSchemaFilename": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Option Schema Filename",
+            "type": "object"
+        }
+    }
+}
+`
@@ -0,0 +1,79 @@
+package testdata
+
+const OptionStability = `{
+    "$ref": "#/definitions/OptionStability",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionStability": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "x-stability": "stable"
+                },
+                "nickname": {
+                    "type": "string",
+                    "x-stability": "alpha"
+                },
+                "preview": {
+                    "$ref": "#/definitions/samples.Preview",
+                    "additionalProperties": true
+                }
+            },
+            "title": "Option Stability",
+            "type": "object",
+            "x-stability": "beta"
+        },
+        "samples.Preview": {
+            "additionalProperties": true,
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            },
+            "title": "Preview",
+            "type": "object",
+            "x-stability": "alpha"
+        }
+    }
+}
+`
+
+const OptionStabilityPreview = `{
+    "$ref": "#/definitions/Preview",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Preview": {
+            "additionalProperties": true,
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            },
+            "title": "Preview",
+            "type": "object",
+            "x-stability": "alpha"
+        }
+    }
+}
+`
+
+const OptionStabilityExcludeAlpha = `{
+    "$ref": "#/definitions/OptionStability",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionStability": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "x-stability": "stable"
+                }
+            },
+            "title": "Option Stability",
+            "type": "object",
+            "x-stability": "beta"
+        }
+    }
+}
+`
@@ -0,0 +1,33 @@
+package testdata
+
+const OptionTimestampFormat = `{
+    "$ref": "#/definitions/OptionTimestampFormat",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OptionTimestampFormat": {
+            "additionalProperties": true,
+            "properties": {
+                "created_at": {
+                    "format": "date-time",
+                    "type": "string"
+                },
+                "opens_at": {
+                    "format": "date-time",
+                    "type": "string",
+                    "x-format-timezone": "Europe/London"
+                },
+                "settlement_date": {
+                    "format": "date",
+                    "type": "string"
+                }
+            },
+            "title": "Option Timestamp Format",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OptionTimestampFormatFail = `{"settlement_date": "2021-12-31T10:00:00Z"}`
+
+const OptionTimestampFormatPass = `{"created_at": "2021-12-31T10:00:00Z", "settlement_date": "2021-12-31", "opens_at": "2021-12-31T09:00:00Z"}`
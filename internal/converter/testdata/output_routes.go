@@ -0,0 +1,55 @@
+package testdata
+
+const InternalMessage = `{
+    "$ref": "#/definitions/InternalMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "InternalMessage": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Internal Message",
+            "type": "object"
+        }
+    }
+}
+`
+
+const OtherMessage = `{
+    "$ref": "#/definitions/OtherMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "OtherMessage": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Other Message",
+            "type": "object"
+        }
+    }
+}
+`
+
+const PublicMessage = `{
+    "$ref": "#/definitions/PublicMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "PublicMessage": {
+            "additionalProperties": false,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Public Message",
+            "type": "object"
+        }
+    }
+}
+`
@@ -0,0 +1,60 @@
+package testdata
+
+const PackageVersions = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order (v2beta1)",
+            "type": "object"
+        }
+    },
+    "id": "https://schemas.acme.com/v2beta1/Order.json",
+    "x-api-channel": "beta",
+    "x-api-version": "v2beta1"
+}
+`
+
+const PackageVersionsOff = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const PackageVersionsPrefixed = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order (v2beta1)",
+            "type": "object"
+        }
+    },
+    "x-api-channel": "beta",
+    "x-api-version": "v2beta1"
+}
+`
@@ -1,25 +1,26 @@
 package testdata
 
 const PayloadMessage = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/PayloadMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "PayloadMessage": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 },
                 "topology": {
                     "enum": [
@@ -47,12 +48,12 @@ const PayloadMessage = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Payload Message"
+            "title": "Payload Message",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const PayloadMessageFail = `{"topology": "MAP"}`
 
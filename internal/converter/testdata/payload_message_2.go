@@ -1,33 +1,27 @@
 package testdata
 
 const PayloadMessage2 = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/PayloadMessage2",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "PayloadMessage2": {
-            "required": [
-                "name",
-                "timestamp",
-                "id",
-                "rating",
-                "complete",
-                "topology"
-            ],
+            "additionalProperties": true,
+            "description": "PayloadMessage2 contains some common types  PayloadMessage2 is used throughout the test suite and can have multi-line comments",
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 },
                 "topology": {
                     "enum": [
@@ -55,13 +49,20 @@ const PayloadMessage2 = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
+            "required": [
+                "name",
+                "timestamp",
+                "id",
+                "rating",
+                "complete",
+                "topology"
+            ],
             "title": "Payload Message 2",
-            "description": "PayloadMessage2 contains some common types  PayloadMessage2 is used throughout the test suite and can have multi-line comments"
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const PayloadMessage2Fail = `{
 }`
@@ -1,46 +1,43 @@
 package testdata
 
 const Proto2NestedMessage = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/Proto2NestedMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "Proto2NestedMessage": {
-            "required": [
-                "payload"
-            ],
+            "additionalProperties": true,
             "properties": {
+                "description": {
+                    "type": "string"
+                },
                 "payload": {
                     "$ref": "#/definitions/samples.Proto2PayloadMessage",
                     "additionalProperties": false
-                },
-                "description": {
-                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Proto 2 Nested Message"
-        },
-        "samples.Proto2PayloadMessage": {
             "required": [
-                "name",
-                "id"
+                "payload"
             ],
+            "title": "Proto 2 Nested Message",
+            "type": "object"
+        },
+        "samples.Proto2PayloadMessage": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 },
                 "topology": {
                     "enum": [
@@ -68,12 +65,16 @@ const Proto2NestedMessage = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Proto 2 Payload Message"
+            "required": [
+                "name",
+                "id"
+            ],
+            "title": "Proto 2 Payload Message",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const Proto2NestedMessageFail = `{
 	"payload": {
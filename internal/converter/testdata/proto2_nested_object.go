@@ -1,51 +1,44 @@
 package testdata
 
 const Proto2NestedObject = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/Proto2NestedObject",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "Proto2NestedObject": {
-            "required": [
-                "payload",
-                "description"
-            ],
+            "additionalProperties": true,
             "properties": {
+                "description": {
+                    "type": "string"
+                },
                 "payload": {
                     "$ref": "#/definitions/samples.Proto2NestedObject.NestedPayload",
                     "additionalProperties": false
-                },
-                "description": {
-                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Proto 2 Nested Object"
-        },
-        "samples.Proto2NestedObject.NestedPayload": {
             "required": [
-                "name",
-                "timestamp",
-                "id",
-                "rating",
-                "complete",
-                "topology"
+                "payload",
+                "description"
             ],
+            "title": "Proto 2 Nested Object",
+            "type": "object"
+        },
+        "samples.Proto2NestedObject.NestedPayload": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 },
                 "topology": {
                     "enum": [
@@ -73,12 +66,20 @@ const Proto2NestedObject = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Nested Payload"
+            "required": [
+                "name",
+                "timestamp",
+                "id",
+                "rating",
+                "complete",
+                "topology"
+            ],
+            "title": "Nested Payload",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const Proto2NestedObjectFail = `{
 	"payload": {
@@ -1,29 +1,26 @@
 package testdata
 
 const Proto2PayloadMessage = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/Proto2PayloadMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "Proto2PayloadMessage": {
-            "required": [
-                "name",
-                "id"
-            ],
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "timestamp": {
-                    "type": "string"
+                "complete": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "rating": {
                     "type": "number"
                 },
-                "complete": {
-                    "type": "boolean"
+                "timestamp": {
+                    "type": "string"
                 },
                 "topology": {
                     "enum": [
@@ -51,12 +48,16 @@ const Proto2PayloadMessage = `{
                     "title": "Topology"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Proto 2 Payload Message"
+            "required": [
+                "name",
+                "id"
+            ],
+            "title": "Proto 2 Payload Message",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const Proto2PayloadMessageFail = `{
 	"complete": false
@@ -1,30 +1,31 @@
 package testdata
 
 const Proto2Required = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/Proto2Required",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "Proto2Required": {
-            "required": [
-                "query"
-            ],
+            "additionalProperties": true,
             "properties": {
-                "query": {
-                    "type": "string"
-                },
                 "page_number": {
                     "type": "integer"
                 },
+                "query": {
+                    "type": "string"
+                },
                 "result_per_page": {
                     "type": "integer"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Proto 2 Required"
+            "required": [
+                "query"
+            ],
+            "title": "Proto 2 Required",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const Proto2RequiredFail = `{
 	"page_number": 4
@@ -0,0 +1,513 @@
+package testdata
+
+const ProtoJSONScalars = `{
+    "$ref": "#/definitions/ProtoJSONScalars",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "ProtoJSONScalars": {
+            "additionalProperties": true,
+            "description": "Scalars which proto3's JSON mapping accepts in more than one encoding:",
+            "properties": {
+                "bytes_value": {
+                    "contentEncoding": "base64",
+                    "format": "binary",
+                    "pattern": "^(([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}(==)?|[A-Za-z0-9+/]{3}=?)?|([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}(==)?|[A-Za-z0-9_-]{3}=?)?)$",
+                    "type": "string"
+                },
+                "bytes_wrapper": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "pattern": "^(([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}(==)?|[A-Za-z0-9+/]{3}=?)?|([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}(==)?|[A-Za-z0-9_-]{3}=?)?)$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "double_value": {
+                    "oneOf": [
+                        {
+                            "type": "number"
+                        },
+                        {
+                            "enum": [
+                                "NaN",
+                                "Infinity",
+                                "-Infinity"
+                            ],
+                            "type": "string"
+                        },
+                        {
+                            "pattern": "^-?(0|[1-9][0-9]*)(\\.[0-9]+)?([eE][+-]?[0-9]+)?$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "double_wrapper": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        },
+                        {
+                            "enum": [
+                                "NaN",
+                                "Infinity",
+                                "-Infinity"
+                            ],
+                            "type": "string"
+                        },
+                        {
+                            "pattern": "^-?(0|[1-9][0-9]*)(\\.[0-9]+)?([eE][+-]?[0-9]+)?$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "fixed64_value": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^(0|[1-9][0-9]*)$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "float_value": {
+                    "oneOf": [
+                        {
+                            "type": "number"
+                        },
+                        {
+                            "enum": [
+                                "NaN",
+                                "Infinity",
+                                "-Infinity"
+                            ],
+                            "type": "string"
+                        },
+                        {
+                            "pattern": "^-?(0|[1-9][0-9]*)(\\.[0-9]+)?([eE][+-]?[0-9]+)?$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "int32_value": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^-?(0|[1-9][0-9]*)$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "int64_value": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^-?(0|[1-9][0-9]*)$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "map_of_int32_wrapper": {
+                    "additionalProperties": {
+                        "oneOf": [
+                            {
+                                "type": "integer"
+                            },
+                            {
+                                "pattern": "^-?(0|[1-9][0-9]*)$",
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "type": "object"
+                },
+                "repeated_sint32_value": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "integer"
+                            },
+                            {
+                                "pattern": "^-?(0|[1-9][0-9]*)$",
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "type": "array"
+                },
+                "uint32_value": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^(0|[1-9][0-9]*)$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "uint64_wrapper": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^(0|[1-9][0-9]*)$",
+                            "type": "string"
+                        }
+                    ]
+                }
+            },
+            "title": "Proto JSON Scalars",
+            "type": "object"
+        }
+    }
+}
+`
+
+const ProtoJSONScalarsFail = `{"int64_value": "007", "bytes_value": "AB+-"}`
+
+const ProtoJSONScalarsPass = `{"double_value": "NaN", "float_value": "1.5e3", "int32_value": "-12", "uint32_value": 7, "int64_value": "9007199254740993", "fixed64_value": 18, "bytes_value": "-_8=", "repeated_sint32_value": [1, "-2"], "double_wrapper": null, "uint64_wrapper": "12", "bytes_wrapper": "AAEC", "map_of_int32_wrapper": {"a": "1"}}`
+
+const ProtoJSONScalarsNullable = `{
+    "$ref": "#/definitions/ProtoJSONScalars",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "ProtoJSONScalars": {
+            "additionalProperties": true,
+            "description": "Scalars which proto3's JSON mapping accepts in more than one encoding:",
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ],
+            "properties": {
+                "bytes_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "contentEncoding": "base64",
+                            "format": "binary",
+                            "pattern": "^(([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}(==)?|[A-Za-z0-9+/]{3}=?)?|([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}(==)?|[A-Za-z0-9_-]{3}=?)?)$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "bytes_wrapper": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ],
+                    "pattern": "^(([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}(==)?|[A-Za-z0-9+/]{3}=?)?|([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}(==)?|[A-Za-z0-9_-]{3}=?)?)$"
+                },
+                "double_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        },
+                        {
+                            "enum": [
+                                "NaN",
+                                "Infinity",
+                                "-Infinity"
+                            ],
+                            "type": "string"
+                        },
+                        {
+                            "pattern": "^-?(0|[1-9][0-9]*)(\\.[0-9]+)?([eE][+-]?[0-9]+)?$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "double_wrapper": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        },
+                        {
+                            "enum": [
+                                "NaN",
+                                "Infinity",
+                                "-Infinity"
+                            ],
+                            "type": "string"
+                        },
+                        {
+                            "pattern": "^-?(0|[1-9][0-9]*)(\\.[0-9]+)?([eE][+-]?[0-9]+)?$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "fixed64_value": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^(0|[1-9][0-9]*)$",
+                            "type": "string"
+                        },
+                        {
+                            "type": "null"
+                        }
+                    ]
+                },
+                "float_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        },
+                        {
+                            "enum": [
+                                "NaN",
+                                "Infinity",
+                                "-Infinity"
+                            ],
+                            "type": "string"
+                        },
+                        {
+                            "pattern": "^-?(0|[1-9][0-9]*)(\\.[0-9]+)?([eE][+-]?[0-9]+)?$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "int32_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^-?(0|[1-9][0-9]*)$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "int64_value": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^-?(0|[1-9][0-9]*)$",
+                            "type": "string"
+                        },
+                        {
+                            "type": "null"
+                        }
+                    ]
+                },
+                "map_of_int32_wrapper": {
+                    "additionalProperties": {
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "integer"
+                            },
+                            {
+                                "pattern": "^-?(0|[1-9][0-9]*)$",
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "object"
+                        }
+                    ]
+                },
+                "repeated_sint32_value": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "integer"
+                            },
+                            {
+                                "pattern": "^-?(0|[1-9][0-9]*)$",
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "array"
+                        }
+                    ]
+                },
+                "uint32_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^(0|[1-9][0-9]*)$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "uint64_wrapper": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^(0|[1-9][0-9]*)$",
+                            "type": "string"
+                        }
+                    ]
+                }
+            },
+            "title": "Proto JSON Scalars"
+        }
+    }
+}
+`
+
+const ProtoJSONScalarsUnfaithful = `{
+    "$ref": "#/definitions/ProtoJSONScalars",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "ProtoJSONScalars": {
+            "additionalProperties": true,
+            "description": "Scalars which proto3's JSON mapping accepts in more than one encoding:",
+            "properties": {
+                "bytes_value": {
+                    "contentEncoding": "base64",
+                    "format": "binary",
+                    "pattern": "^[A-Za-z0-9+/_-]*={0,2}$",
+                    "type": "string"
+                },
+                "bytes_wrapper": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "double_value": {
+                    "type": "number"
+                },
+                "double_wrapper": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        }
+                    ]
+                },
+                "fixed64_value": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^[0-9]+$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "float_value": {
+                    "type": "number"
+                },
+                "int32_value": {
+                    "type": "integer"
+                },
+                "int64_value": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^-?[0-9]+$",
+                            "type": "string"
+                        }
+                    ]
+                },
+                "map_of_int32_wrapper": {
+                    "additionalProperties": {
+                        "type": "integer"
+                    },
+                    "type": "object"
+                },
+                "repeated_sint32_value": {
+                    "items": {
+                        "type": "integer"
+                    },
+                    "type": "array"
+                },
+                "uint32_value": {
+                    "type": "integer"
+                },
+                "uint64_wrapper": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                }
+            },
+            "title": "Proto JSON Scalars",
+            "type": "object"
+        }
+    }
+}
+`
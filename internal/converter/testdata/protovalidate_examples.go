@@ -0,0 +1,32 @@
+package testdata
+
+const ProtovalidateExamples = `{
+    "$ref": "#/definitions/ProtovalidateExamples",
+    "$schema": "http://json-schema.org/draft-06/schema#",
+    "definitions": {
+        "ProtovalidateExamples": {
+            "additionalProperties": true,
+            "properties": {
+                "age": {
+                    "examples": [
+                        42
+                    ],
+                    "type": "integer"
+                },
+                "email": {
+                    "examples": [
+                        "jane@example.com",
+                        "joe@example.com"
+                    ],
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Protovalidate Examples",
+            "type": "object"
+        }
+    }
+}
+`
@@ -0,0 +1,163 @@
+package testdata
+
+const RecursiveMapsNode = `{
+    "$ref": "#/definitions/Node",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Node": {
+            "additionalProperties": true,
+            "properties": {
+                "children": {
+                    "additionalProperties": {
+                        "$ref": "#/definitions/Node",
+                        "additionalProperties": true
+                    },
+                    "type": "object"
+                },
+                "edges": {
+                    "additionalProperties": false,
+                    "patternProperties": {
+                        "^-?[0-9]+$": {
+                            "$ref": "#/definitions/samples.Edge",
+                            "additionalProperties": true
+                        }
+                    },
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Node",
+            "type": "object"
+        },
+        "samples.Edge": {
+            "additionalProperties": true,
+            "properties": {
+                "alternatives": {
+                    "additionalProperties": {
+                        "$ref": "#/definitions/samples.Edge",
+                        "additionalProperties": true
+                    },
+                    "type": "object"
+                },
+                "target": {
+                    "$ref": "#/definitions/Node",
+                    "additionalProperties": true
+                },
+                "weight": {
+                    "type": "number"
+                }
+            },
+            "title": "Edge",
+            "type": "object"
+        }
+    }
+}
+`
+
+const RecursiveMapsNodeFail = `{
+    "name": "root",
+    "children": {
+        "a": {
+            "name": "a",
+            "children": {
+                "b": {"name": 2}
+            }
+        }
+    }
+}`
+
+const RecursiveMapsNodePass = `{
+    "name": "root",
+    "children": {
+        "a": {
+            "name": "a",
+            "children": {
+                "b": {"name": "b", "children": {}}
+            },
+            "edges": {
+                "-1": {"weight": 0.5, "target": {"name": "root"}}
+            }
+        }
+    }
+}`
+
+const RecursiveMapsEdge = `{
+    "$ref": "#/definitions/Edge",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Edge": {
+            "additionalProperties": true,
+            "properties": {
+                "alternatives": {
+                    "additionalProperties": {
+                        "$ref": "#/definitions/Edge",
+                        "additionalProperties": true
+                    },
+                    "type": "object"
+                },
+                "target": {
+                    "$ref": "#/definitions/samples.Node",
+                    "additionalProperties": true
+                },
+                "weight": {
+                    "type": "number"
+                }
+            },
+            "title": "Edge",
+            "type": "object"
+        },
+        "samples.Node": {
+            "additionalProperties": true,
+            "properties": {
+                "children": {
+                    "additionalProperties": {
+                        "$ref": "#/definitions/samples.Node",
+                        "additionalProperties": true
+                    },
+                    "type": "object"
+                },
+                "edges": {
+                    "additionalProperties": false,
+                    "patternProperties": {
+                        "^-?[0-9]+$": {
+                            "$ref": "#/definitions/Edge",
+                            "additionalProperties": true
+                        }
+                    },
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Node",
+            "type": "object"
+        }
+    }
+}
+`
+
+const RecursiveMapsEdgeFail = `{
+    "weight": 1,
+    "target": {
+        "name": "a",
+        "edges": {
+            "first": {"weight": 2}
+        }
+    }
+}`
+
+const RecursiveMapsEdgePass = `{
+    "weight": 1,
+    "target": {
+        "name": "a",
+        "edges": {
+            "1": {"weight": 2, "alternatives": {"detour": {"weight": 3, "target": {"name": "b"}}}}
+        }
+    },
+    "alternatives": {
+        "direct": {"weight": 0.1}
+    }
+}`
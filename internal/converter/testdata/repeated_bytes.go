@@ -0,0 +1,35 @@
+package testdata
+
+const RepeatedBytes = `{
+    "$ref": "#/definitions/RepeatedBytes",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "RepeatedBytes": {
+            "additionalProperties": true,
+            "properties": {
+                "checksum": {
+                    "contentEncoding": "base64",
+                    "format": "binary",
+                    "pattern": "^[A-Za-z0-9+/_-]*={0,2}$",
+                    "type": "string"
+                },
+                "chunks": {
+                    "items": {
+                        "contentEncoding": "base64",
+                        "format": "binary",
+                        "pattern": "^[A-Za-z0-9+/_-]*={0,2}$",
+                        "type": "string"
+                    },
+                    "type": "array"
+                }
+            },
+            "title": "Repeated Bytes",
+            "type": "object"
+        }
+    }
+}
+`
+
+const RepeatedBytesFail = `{"chunks": ["aGVsbG8=", "not base64!"]}`
+
+const RepeatedBytesPass = `{"checksum": "aGVsbG8=", "chunks": ["aGVsbG8=", "d29ybGQ"]}`
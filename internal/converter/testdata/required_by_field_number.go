@@ -0,0 +1,37 @@
+package testdata
+
+const RequiredByFieldNumber = `{
+    "$ref": "#/definitions/RequiredByFieldNumber",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "RequiredByFieldNumber": {
+            "additionalProperties": true,
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                }
+            },
+            "required": [
+                "name",
+                "account_id",
+                "region"
+            ],
+            "title": "Required By Field Number",
+            "type": "object"
+        }
+    }
+}
+`
+
+const RequiredByFieldNumberFail = `{"name": "acme", "account_id": "123"}`
+
+const RequiredByFieldNumberPass = `{"name": "acme", "account_id": "123", "region": "eu-west-1"}`
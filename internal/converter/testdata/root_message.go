@@ -0,0 +1,65 @@
+package testdata
+
+const RootMessageInvoice = `{
+    "$ref": "#/definitions/Invoice",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Invoice": {
+            "additionalProperties": true,
+            "properties": {
+                "number": {
+                    "type": "string"
+                }
+            },
+            "title": "Invoice",
+            "type": "object"
+        }
+    }
+}
+`
+
+const RootMessagePayment = `{
+    "$ref": "#/definitions/Payment",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Payment": {
+            "additionalProperties": true,
+            "properties": {
+                "amount": {
+                    "type": "string"
+                }
+            },
+            "title": "Payment",
+            "type": "object"
+        }
+    }
+}
+`
+
+const RootMessageOrder = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Customer": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Customer",
+            "type": "object"
+        },
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        }
+    }
+}
+`
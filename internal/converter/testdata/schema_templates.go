@@ -0,0 +1,20 @@
+package testdata
+
+const SchemaTemplates = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order, from acme.orders.v1 ({unknown})",
+            "type": "object"
+        }
+    },
+    "id": "https://schemas.acme.com/prod/acme.orders.v1/v1/Order.json"
+}
+`
@@ -21,7 +21,8 @@ const SecondEnum = `{
         }
     ],
     "title": "Second Enum"
-}`
+}
+`
 
 const SecondEnumFail = `"VALUE_3"`
 
@@ -1,33 +1,34 @@
 package testdata
 
 const SecondMessage = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/SecondMessage",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "SecondMessage": {
+            "additionalProperties": true,
             "properties": {
-                "name2": {
-                    "type": "string"
-                },
-                "timestamp2": {
-                    "type": "string"
+                "complete2": {
+                    "type": "boolean"
                 },
                 "id2": {
                     "type": "integer"
                 },
+                "name2": {
+                    "type": "string"
+                },
                 "rating2": {
                     "type": "number"
                 },
-                "complete2": {
-                    "type": "boolean"
+                "timestamp2": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Second Message"
+            "title": "Second Message",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const SecondMessageFail = `{"complete2": "hello"}`
 
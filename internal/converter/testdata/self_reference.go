@@ -1,27 +1,28 @@
 package testdata
 
 const SelfReference = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/Foo",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "Foo": {
+            "additionalProperties": true,
             "properties": {
-                "name": {
-                    "type": "string"
-                },
                 "bar": {
                     "items": {
                         "$ref": "#/definitions/Foo"
                     },
                     "type": "array"
+                },
+                "name": {
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Foo"
+            "title": "Foo",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const SelfReferenceFail = `{
 	"bar": [
@@ -0,0 +1,123 @@
+package testdata
+
+const SharedMessagesCreateOrder = `{
+    "$ref": "#/definitions/CreateOrder",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "CreateOrder": {
+            "additionalProperties": true,
+            "properties": {
+                "header": {
+                    "$ref": "common.json#/definitions/acme.RequestHeader",
+                    "additionalProperties": true
+                },
+                "sku": {
+                    "type": "string"
+                }
+            },
+            "title": "Create Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const SharedMessagesCancelOrder = `{
+    "$ref": "#/definitions/CancelOrder",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "CancelOrder": {
+            "additionalProperties": true,
+            "properties": {
+                "header": {
+                    "$ref": "common.json#/definitions/acme.RequestHeader",
+                    "additionalProperties": true
+                },
+                "order_id": {
+                    "type": "string"
+                }
+            },
+            "title": "Cancel Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const SharedMessagesCommon = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "RequestHeader": {
+            "additionalProperties": true,
+            "properties": {
+                "caller": {
+                    "$ref": "#/definitions/acme.Caller",
+                    "additionalProperties": true
+                },
+                "request_id": {
+                    "type": "string"
+                }
+            },
+            "title": "Request Header",
+            "type": "object"
+        },
+        "acme.Caller": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Caller",
+            "type": "object"
+        },
+        "acme.RequestHeader": {
+            "$ref": "#/definitions/RequestHeader"
+        }
+    }
+}
+`
+
+const SharedMessagesPrefixedCreateOrder = `{
+    "$ref": "#/definitions/CreateOrder",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "CreateOrder": {
+            "additionalProperties": true,
+            "properties": {
+                "header": {
+                    "$ref": "../common.json#/definitions/acme.RequestHeader",
+                    "additionalProperties": true
+                },
+                "sku": {
+                    "type": "string"
+                }
+            },
+            "title": "Create Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const SharedMessagesPrefixedCancelOrder = `{
+    "$ref": "#/definitions/CancelOrder",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "CancelOrder": {
+            "additionalProperties": true,
+            "properties": {
+                "header": {
+                    "$ref": "../common.json#/definitions/acme.RequestHeader",
+                    "additionalProperties": true
+                },
+                "order_id": {
+                    "type": "string"
+                }
+            },
+            "title": "Cancel Order",
+            "type": "object"
+        }
+    }
+}
+`
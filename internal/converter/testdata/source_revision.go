@@ -0,0 +1,117 @@
+package testdata
+
+const SourceRevision = `{
+    "$ref": "#/definitions/SourceRevision",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "SourceRevision": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "SOURCE_REVISION_STATUS_UNSPECIFIED",
+                        0,
+                        "SOURCE_REVISION_STATUS_ACTIVE",
+                        1
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ],
+                    "title": "Source Revision Status"
+                }
+            },
+            "title": "Source Revision",
+            "type": "object"
+        }
+    },
+    "x-generated-from": "v1.4.0-3-gabc1234"
+}
+`
+
+const SourceRevisionStatus = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "enum": [
+        "SOURCE_REVISION_STATUS_UNSPECIFIED",
+        0,
+        "SOURCE_REVISION_STATUS_ACTIVE",
+        1
+    ],
+    "oneOf": [
+        {
+            "type": "string"
+        },
+        {
+            "type": "integer"
+        }
+    ],
+    "title": "Source Revision Status",
+    "x-generated-from": "v1.4.0-3-gabc1234"
+}
+`
+
+const SourceRevisionDraft07 = `{
+    "$comment": "Generated from v1.4.0-3-gabc1234",
+    "$ref": "#/definitions/SourceRevision",
+    "$schema": "http://json-schema.org/draft-07/schema#",
+    "definitions": {
+        "SourceRevision": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "SOURCE_REVISION_STATUS_UNSPECIFIED",
+                        0,
+                        "SOURCE_REVISION_STATUS_ACTIVE",
+                        1
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ],
+                    "title": "Source Revision Status"
+                }
+            },
+            "title": "Source Revision",
+            "type": "object"
+        }
+    },
+    "x-generated-from": "v1.4.0-3-gabc1234"
+}
+`
+
+const SourceRevisionStatusDraft07 = `{
+    "$comment": "Generated from v1.4.0-3-gabc1234",
+    "$schema": "http://json-schema.org/draft-07/schema#",
+    "enum": [
+        "SOURCE_REVISION_STATUS_UNSPECIFIED",
+        0,
+        "SOURCE_REVISION_STATUS_ACTIVE",
+        1
+    ],
+    "oneOf": [
+        {
+            "type": "string"
+        },
+        {
+            "type": "integer"
+        }
+    ],
+    "title": "Source Revision Status",
+    "x-generated-from": "v1.4.0-3-gabc1234"
+}
+`
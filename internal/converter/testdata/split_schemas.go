@@ -0,0 +1,125 @@
+package testdata
+
+const SplitSchemas = `{
+    "$ref": "#/definitions/SplitSchemas",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "SplitSchemas": {
+            "additionalProperties": true,
+            "allOf": [
+                {
+                    "$ref": "#/definitions/SplitSchemas.payment"
+                },
+                {
+                    "$ref": "#/definitions/SplitSchemas.part1"
+                },
+                {
+                    "$ref": "#/definitions/SplitSchemas.part2"
+                }
+            ],
+            "title": "Split Schemas",
+            "type": "object"
+        },
+        "SplitSchemas.part1": {
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "SplitSchemas.part2": {
+            "properties": {
+                "notes": {
+                    "type": "string"
+                }
+            }
+        },
+        "SplitSchemas.payment": {
+            "properties": {
+                "card_number": {
+                    "type": "string"
+                },
+                "iban": {
+                    "type": "string"
+                }
+            }
+        }
+    }
+}
+`
+
+const SplitSchemasClosed = `{
+    "$ref": "#/definitions/SplitSchemas",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "SplitSchemas": {
+            "additionalProperties": false,
+            "properties": {
+                "card_number": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "iban": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                }
+            },
+            "title": "Split Schemas",
+            "type": "object"
+        }
+    }
+}
+`
+
+const SplitSchemasUnderThreshold = `{
+    "$ref": "#/definitions/SplitSchemas",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "SplitSchemas": {
+            "additionalProperties": true,
+            "properties": {
+                "card_number": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "iban": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                }
+            },
+            "title": "Split Schemas",
+            "type": "object"
+        }
+    }
+}
+`
+
+const SplitSchemasFail = `{"id": "1", "iban": 42}`
+
+const SplitSchemasPass = `{"id": "1", "name": "Order", "iban": "GB82WEST12345698765432", "notes": "Leave it on the porch"}`
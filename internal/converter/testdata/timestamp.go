@@ -1,22 +1,23 @@
 package testdata
 
 const Timestamp = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/Timestamp",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "Timestamp": {
+            "additionalProperties": true,
             "properties": {
                 "timestamp": {
-                    "type": "string",
-                    "format": "date-time"
+                    "format": "date-time",
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Timestamp"
+            "title": "Timestamp",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const TimestampFail = `{"timestamp": "twelve oclock"}`
 
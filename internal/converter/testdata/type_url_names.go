@@ -0,0 +1,58 @@
+package testdata
+
+const TypeURLNames = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        }
+    },
+    "id": "type.googleapis.com/acme.v1.Order"
+}
+`
+
+const TypeURLNamesDraft07 = `{
+    "$id": "type.googleapis.com/acme.v1.Order",
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-07/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const TypeURLNamesTemplated = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        }
+    },
+    "id": "https://schemas.acme.com/Order.json"
+}
+`
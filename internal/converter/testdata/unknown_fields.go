@@ -0,0 +1,122 @@
+package testdata
+
+const UnknownFieldsStrictOrder = `{
+    "$ref": "#/definitions/StrictOrder",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "StrictOrder": {
+            "additionalProperties": false,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Strict Order",
+            "type": "object"
+        }
+    }
+}
+`
+
+const UnknownFieldsLabels = `{
+    "$ref": "#/definitions/Labels",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Labels": {
+            "additionalProperties": true,
+            "properties": {
+                "map_of_strings": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                }
+            },
+            "title": "Labels",
+            "type": "object"
+        }
+    }
+}
+`
+
+const UnknownFieldsDiscardStrictOrder = `{
+    "$ref": "#/definitions/StrictOrder",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "StrictOrder": {
+            "additionalProperties": true,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Strict Order",
+            "type": "object",
+            "x-unknown-fields-policy": "discard"
+        }
+    }
+}
+`
+
+const UnknownFieldsDiscardLabels = `{
+    "$ref": "#/definitions/Labels",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Labels": {
+            "additionalProperties": true,
+            "properties": {
+                "map_of_strings": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                }
+            },
+            "title": "Labels",
+            "type": "object",
+            "x-unknown-fields-policy": "discard"
+        }
+    }
+}
+`
+
+const UnknownFieldsRejectStrictOrder = `{
+    "$ref": "#/definitions/StrictOrder",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "StrictOrder": {
+            "additionalProperties": false,
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            },
+            "title": "Strict Order",
+            "type": "object",
+            "x-unknown-fields-policy": "reject"
+        }
+    }
+}
+`
+
+const UnknownFieldsRejectLabels = `{
+    "$ref": "#/definitions/Labels",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Labels": {
+            "additionalProperties": false,
+            "properties": {
+                "map_of_strings": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                }
+            },
+            "title": "Labels",
+            "type": "object",
+            "x-unknown-fields-policy": "reject"
+        }
+    }
+}
+`
@@ -0,0 +1,28 @@
+package testdata
+
+const UnknownOptions = `{
+    "$ref": "#/definitions/UnknownOptions",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "UnknownOptions": {
+            "additionalProperties": true,
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "x-proto-option-50002": [
+                        "alice",
+                        "bob"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "x-proto-option-50001": "team-a"
+                }
+            },
+            "title": "Unknown Options",
+            "type": "object",
+            "x-proto-option-50003": "RETENTION_LONG"
+        }
+    }
+}
+`
@@ -0,0 +1,32 @@
+package testdata
+
+const UnresolvedTypes = `{
+    "$ref": "#/definitions/Order",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Order": {
+            "additionalProperties": true,
+            "properties": {
+                "customer": {
+                    "additionalProperties": true,
+                    "type": "object",
+                    "x-unresolved-type": "samples.Customer"
+                },
+                "lines": {
+                    "items": {
+                        "additionalProperties": true,
+                        "type": "object",
+                        "x-unresolved-type": "samples.Line"
+                    },
+                    "type": "array"
+                },
+                "status": {
+                    "x-unresolved-type": "samples.Status"
+                }
+            },
+            "title": "Order",
+            "type": "object"
+        }
+    }
+}
+`
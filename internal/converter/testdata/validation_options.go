@@ -1,15 +1,15 @@
 package testdata
 
 const ValidationOptions = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/ValidationOptions",
+    "$schema": "http://json-schema.org/draft-06/schema#",
     "definitions": {
         "ValidationOptions": {
+            "additionalProperties": true,
             "properties": {
-                "stringWithLengthConstraints": {
-                    "maxLength": 10,
-                    "minLength": 5,
-                    "type": "string"
+                "answerWithConstConstraint": {
+                    "const": 42,
+                    "type": "integer"
                 },
                 "luckyNumbersWithArrayConstraints": {
                     "items": {
@@ -18,14 +18,48 @@ const ValidationOptions = `{
                     "maxItems": 6,
                     "minItems": 2,
                     "type": "array"
+                },
+                "offsetOutsideRangeConstraints": {
+                    "anyOf": [
+                        {
+                            "exclusiveMinimum": 10
+                        },
+                        {
+                            "exclusiveMaximum": 0
+                        }
+                    ],
+                    "type": "integer"
+                },
+                "percentageWithRangeConstraints": {
+                    "maximum": 100,
+                    "minimum": 0,
+                    "type": "integer"
+                },
+                "ratingWithInConstraints": {
+                    "enum": [
+                        1,
+                        2.5,
+                        5
+                    ],
+                    "type": "number"
+                },
+                "ratioWithExclusiveRangeConstraints": {
+                    "exclusiveMaximum": 1,
+                    "exclusiveMinimum": 0,
+                    "type": "number"
+                },
+                "stringWithLengthConstraints": {
+                    "maxLength": 10,
+                    "minLength": 5,
+                    "type": "string"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Validation Options"
+            "title": "Validation Options",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const ValidationOptionsFail = `{
 	"stringWithLengthConstraints": "this string is way too long",
@@ -34,5 +68,10 @@ const ValidationOptionsFail = `{
 
 const ValidationOptionsPass = `{
 	"stringWithLengthConstraints": "thisisok",
-	"luckyNumbersWithArrayConstraints": [1,2,3,4]
+	"luckyNumbersWithArrayConstraints": [1,2,3,4],
+	"percentageWithRangeConstraints": 100,
+	"ratioWithExclusiveRangeConstraints": 0.5,
+	"answerWithConstConstraint": 42,
+	"offsetOutsideRangeConstraints": 11,
+	"ratingWithInConstraints": 2.5
 }`
@@ -0,0 +1,104 @@
+package testdata
+
+const Account = `{
+    "$ref": "#/definitions/Account",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Account": {
+            "additionalProperties": true,
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Account",
+            "type": "object"
+        }
+    }
+}
+`
+
+const AccountPreview = `{
+    "$ref": "#/definitions/Account",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Account": {
+            "additionalProperties": true,
+            "properties": {
+                "beta_features": {
+                    "$ref": "#/definitions/samples.BetaFeatures",
+                    "additionalProperties": true
+                },
+                "name": {
+                    "type": "string"
+                }
+            },
+            "title": "Account",
+            "type": "object"
+        },
+        "samples.BetaFeatures": {
+            "additionalProperties": true,
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            },
+            "title": "Beta Features",
+            "type": "object"
+        }
+    }
+}
+`
+
+const AccountInternalPreview = `{
+    "$ref": "#/definitions/Account",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "Account": {
+            "additionalProperties": true,
+            "properties": {
+                "beta_features": {
+                    "$ref": "#/definitions/samples.BetaFeatures",
+                    "additionalProperties": true
+                },
+                "name": {
+                    "type": "string"
+                },
+                "risk_score": {
+                    "type": "integer"
+                }
+            },
+            "title": "Account",
+            "type": "object"
+        },
+        "samples.BetaFeatures": {
+            "additionalProperties": true,
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            },
+            "title": "Beta Features",
+            "type": "object"
+        }
+    }
+}
+`
+
+const BetaFeatures = `{
+    "$ref": "#/definitions/BetaFeatures",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "BetaFeatures": {
+            "additionalProperties": true,
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            },
+            "title": "Beta Features",
+            "type": "object"
+        }
+    }
+}
+`
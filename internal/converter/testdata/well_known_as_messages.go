@@ -0,0 +1,162 @@
+package testdata
+
+const WellKnownAsMessages = `{
+    "$ref": "#/definitions/WellKnown",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "WellKnown": {
+            "additionalProperties": true,
+            "properties": {
+                "duration": {
+                    "$ref": "#/definitions/google.protobuf.Duration",
+                    "additionalProperties": true,
+                    "description": "This is a duration:"
+                },
+                "list_of_integers": {
+                    "items": {
+                        "$ref": "#/definitions/google.protobuf.Int32Value"
+                    },
+                    "type": "array"
+                },
+                "map_of_integers": {
+                    "additionalProperties": false,
+                    "patternProperties": {
+                        "^-?[0-9]+$": {
+                            "$ref": "#/definitions/google.protobuf.Int32Value",
+                            "additionalProperties": true
+                        }
+                    },
+                    "type": "object"
+                },
+                "map_of_scalar_integers": {
+                    "additionalProperties": false,
+                    "patternProperties": {
+                        "^-?[0-9]+$": {
+                            "type": "integer"
+                        }
+                    },
+                    "type": "object"
+                },
+                "string_value": {
+                    "$ref": "#/definitions/google.protobuf.StringValue",
+                    "additionalProperties": true
+                },
+                "struct": {
+                    "$ref": "#/definitions/google.protobuf.Struct",
+                    "additionalProperties": true
+                }
+            },
+            "title": "Well Known",
+            "type": "object"
+        },
+        "google.protobuf.Duration": {
+            "additionalProperties": true,
+            "properties": {
+                "nanos": {
+                    "type": "integer"
+                },
+                "seconds": {
+                    "oneOf": [
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "pattern": "^-?[0-9]+$",
+                            "type": "string"
+                        }
+                    ]
+                }
+            },
+            "type": "object"
+        },
+        "google.protobuf.Int32Value": {
+            "additionalProperties": true,
+            "properties": {
+                "value": {
+                    "type": "integer"
+                }
+            },
+            "type": "object"
+        },
+        "google.protobuf.ListValue": {
+            "additionalProperties": true,
+            "properties": {
+                "values": {
+                    "items": {
+                        "$ref": "#/definitions/google.protobuf.Value"
+                    },
+                    "type": "array"
+                }
+            },
+            "type": "object"
+        },
+        "google.protobuf.StringValue": {
+            "additionalProperties": true,
+            "properties": {
+                "value": {
+                    "type": "string"
+                }
+            },
+            "type": "object"
+        },
+        "google.protobuf.Struct": {
+            "additionalProperties": true,
+            "properties": {
+                "fields": {
+                    "additionalProperties": {
+                        "$ref": "#/definitions/google.protobuf.Value",
+                        "additionalProperties": true
+                    },
+                    "type": "object"
+                }
+            },
+            "type": "object"
+        },
+        "google.protobuf.Value": {
+            "additionalProperties": true,
+            "properties": {
+                "bool_value": {
+                    "type": "boolean"
+                },
+                "list_value": {
+                    "$ref": "#/definitions/google.protobuf.ListValue",
+                    "additionalProperties": true
+                },
+                "null_value": {
+                    "enum": [
+                        "NULL_VALUE",
+                        0,
+                        null
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                },
+                "number_value": {
+                    "type": "number"
+                },
+                "string_value": {
+                    "type": "string"
+                },
+                "struct_value": {
+                    "$ref": "#/definitions/google.protobuf.Struct",
+                    "additionalProperties": true
+                }
+            },
+            "type": "object"
+        }
+    }
+}
+`
+
+const WellKnownAsMessagesFail = `{"duration": "9s"}`
+
+const WellKnownAsMessagesPass = `{"duration": {"seconds": "9", "nanos": 500}, "string_value": {"value": "hello"}}`
@@ -0,0 +1,668 @@
+package testdata
+
+const WellKnownTypes = `{
+    "$ref": "#/definitions/WellKnownTypes",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "WellKnownTypes": {
+            "additionalProperties": true,
+            "description": "Every well-known type, in every position a field can use it:",
+            "properties": {
+                "any": {
+                    "additionalProperties": true,
+                    "properties": {
+                        "@type": {
+                            "description": "A URL identifying the type of the payload (eg \"type.googleapis.com/acme.Dog\"), whose fields accompany it.",
+                            "type": "string"
+                        }
+                    },
+                    "type": "object"
+                },
+                "bool_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "boolean"
+                        }
+                    ]
+                },
+                "bytes_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "double_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        }
+                    ]
+                },
+                "duration": {
+                    "format": "regex",
+                    "pattern": "^([0-9]+\\.?[0-9]*|\\.[0-9]+)s$",
+                    "type": "string"
+                },
+                "empty": {
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "field_mask": {
+                    "type": "string"
+                },
+                "float_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        }
+                    ]
+                },
+                "int32_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                },
+                "int64_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "list_value": {
+                    "type": "array"
+                },
+                "map_of_any": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "properties": {
+                            "@type": {
+                                "description": "A URL identifying the type of the payload (eg \"type.googleapis.com/acme.Dog\"), whose fields accompany it.",
+                                "type": "string"
+                            }
+                        },
+                        "type": "object"
+                    },
+                    "type": "object"
+                },
+                "map_of_bool_value": {
+                    "additionalProperties": {
+                        "type": "boolean"
+                    },
+                    "type": "object"
+                },
+                "map_of_bytes_value": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_double_value": {
+                    "additionalProperties": {
+                        "type": "number"
+                    },
+                    "type": "object"
+                },
+                "map_of_duration": {
+                    "additionalProperties": {
+                        "format": "regex",
+                        "pattern": "^([0-9]+\\.?[0-9]*|\\.[0-9]+)s$",
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_empty": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "object"
+                    },
+                    "type": "object"
+                },
+                "map_of_field_mask": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_float_value": {
+                    "additionalProperties": {
+                        "type": "number"
+                    },
+                    "type": "object"
+                },
+                "map_of_int32_value": {
+                    "additionalProperties": {
+                        "type": "integer"
+                    },
+                    "type": "object"
+                },
+                "map_of_int64_value": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_list_value": {
+                    "additionalProperties": {
+                        "type": "array"
+                    },
+                    "type": "object"
+                },
+                "map_of_null_value": {
+                    "additionalProperties": {
+                        "enum": [
+                            "NULL_VALUE",
+                            0,
+                            null
+                        ],
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "string"
+                            },
+                            {
+                                "type": "integer"
+                            }
+                        ]
+                    },
+                    "type": "object"
+                },
+                "map_of_string_value": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_struct": {
+                    "additionalProperties": {
+                        "additionalProperties": true,
+                        "type": "object"
+                    },
+                    "type": "object"
+                },
+                "map_of_timestamp": {
+                    "additionalProperties": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_uint32_value": {
+                    "additionalProperties": {
+                        "type": "integer"
+                    },
+                    "type": "object"
+                },
+                "map_of_uint64_value": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "map_of_value": {
+                    "additionalProperties": {
+                        "oneOf": [
+                            {
+                                "type": "array"
+                            },
+                            {
+                                "type": "boolean"
+                            },
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "number"
+                            },
+                            {
+                                "type": "object"
+                            },
+                            {
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "type": "object"
+                },
+                "null_value": {
+                    "enum": [
+                        "NULL_VALUE",
+                        0,
+                        null
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                },
+                "oneof_any": {
+                    "additionalProperties": true,
+                    "properties": {
+                        "@type": {
+                            "description": "A URL identifying the type of the payload (eg \"type.googleapis.com/acme.Dog\"), whose fields accompany it.",
+                            "type": "string"
+                        }
+                    },
+                    "type": "object"
+                },
+                "oneof_bool_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "boolean"
+                        }
+                    ]
+                },
+                "oneof_bytes_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "oneof_double_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        }
+                    ]
+                },
+                "oneof_duration": {
+                    "format": "regex",
+                    "pattern": "^([0-9]+\\.?[0-9]*|\\.[0-9]+)s$",
+                    "type": "string"
+                },
+                "oneof_empty": {
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "oneof_field_mask": {
+                    "type": "string"
+                },
+                "oneof_float_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        }
+                    ]
+                },
+                "oneof_int32_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                },
+                "oneof_int64_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "oneof_list_value": {
+                    "type": "array"
+                },
+                "oneof_null_value": {
+                    "enum": [
+                        "NULL_VALUE",
+                        0,
+                        null
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                },
+                "oneof_string_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "oneof_struct": {
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "oneof_timestamp": {
+                    "format": "date-time",
+                    "type": "string"
+                },
+                "oneof_uint32_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                },
+                "oneof_uint64_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "oneof_value": {
+                    "oneOf": [
+                        {
+                            "type": "array"
+                        },
+                        {
+                            "type": "boolean"
+                        },
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        },
+                        {
+                            "type": "object"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "repeated_any": {
+                    "items": {
+                        "additionalProperties": true,
+                        "properties": {
+                            "@type": {
+                                "description": "A URL identifying the type of the payload (eg \"type.googleapis.com/acme.Dog\"), whose fields accompany it.",
+                                "type": "string"
+                            }
+                        },
+                        "type": "object"
+                    },
+                    "type": "array"
+                },
+                "repeated_bool_value": {
+                    "items": {
+                        "type": "boolean"
+                    },
+                    "type": "array"
+                },
+                "repeated_bytes_value": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "repeated_double_value": {
+                    "items": {
+                        "type": "number"
+                    },
+                    "type": "array"
+                },
+                "repeated_duration": {
+                    "items": {
+                        "format": "regex",
+                        "pattern": "^([0-9]+\\.?[0-9]*|\\.[0-9]+)s$",
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "repeated_empty": {
+                    "items": {
+                        "type": "object"
+                    },
+                    "type": "array"
+                },
+                "repeated_field_mask": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "repeated_float_value": {
+                    "items": {
+                        "type": "number"
+                    },
+                    "type": "array"
+                },
+                "repeated_int32_value": {
+                    "items": {
+                        "type": "integer"
+                    },
+                    "type": "array"
+                },
+                "repeated_int64_value": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "repeated_list_value": {
+                    "items": {
+                        "type": "array"
+                    },
+                    "type": "array"
+                },
+                "repeated_null_value": {
+                    "items": {
+                        "enum": [
+                            "NULL_VALUE",
+                            0,
+                            null
+                        ],
+                        "oneOf": [
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "string"
+                            },
+                            {
+                                "type": "integer"
+                            }
+                        ]
+                    },
+                    "type": "array"
+                },
+                "repeated_string_value": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "repeated_struct": {
+                    "items": {
+                        "type": "object"
+                    },
+                    "type": "array"
+                },
+                "repeated_timestamp": {
+                    "items": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "repeated_uint32_value": {
+                    "items": {
+                        "type": "integer"
+                    },
+                    "type": "array"
+                },
+                "repeated_uint64_value": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "repeated_value": {
+                    "items": {
+                        "oneOf": [
+                            {
+                                "type": "array"
+                            },
+                            {
+                                "type": "boolean"
+                            },
+                            {
+                                "type": "null"
+                            },
+                            {
+                                "type": "number"
+                            },
+                            {
+                                "type": "object"
+                            },
+                            {
+                                "type": "string"
+                            }
+                        ]
+                    },
+                    "type": "array"
+                },
+                "string_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "struct": {
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                "timestamp": {
+                    "format": "date-time",
+                    "type": "string"
+                },
+                "uint32_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                },
+                "uint64_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "value": {
+                    "oneOf": [
+                        {
+                            "type": "array"
+                        },
+                        {
+                            "type": "boolean"
+                        },
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        },
+                        {
+                            "type": "object"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                }
+            },
+            "title": "Well Known Types",
+            "type": "object"
+        }
+    }
+}
+`
+
+const WellKnownTypesFail = `{"duration": 9, "timestamp": "yesterday", "repeated_int64_value": [12], "map_of_bool_value": {"x": "true"}}`
+
+const WellKnownTypesPass = `{
+    "any": {"@type": "type.googleapis.com/samples.Order", "id": 1},
+    "bool_value": null,
+    "duration": "1.5s",
+    "field_mask": "a.b,c",
+    "int64_value": "12",
+    "null_value": null,
+    "struct": {"a": [1, "b"]},
+    "timestamp": "2021-01-01T00:00:00Z",
+    "value": "anything",
+    "repeated_string_value": ["a", "b"],
+    "repeated_timestamp": ["2021-01-01T00:00:00Z"],
+    "map_of_bool_value": {"x": true},
+    "map_of_value": {"x": 1, "y": [true], "z": null},
+    "oneof_field_mask": "a.b"
+}`
@@ -1,54 +1,64 @@
 package testdata
 
 const WellKnown = `{
-    "$schema": "http://json-schema.org/draft-04/schema#",
     "$ref": "#/definitions/WellKnown",
+    "$schema": "http://json-schema.org/draft-04/schema#",
     "definitions": {
         "WellKnown": {
+            "additionalProperties": true,
             "properties": {
-                "string_value": {
-                    "additionalProperties": true,
+                "duration": {
+                    "description": "This is a duration:",
+                    "format": "regex",
+                    "pattern": "^([0-9]+\\.?[0-9]*|\\.[0-9]+)s$",
                     "type": "string"
                 },
-                "map_of_integers": {
-                    "additionalProperties": {
-                        "additionalProperties": true,
+                "list_of_integers": {
+                    "items": {
                         "type": "integer"
                     },
-                    "type": "object"
+                    "type": "array"
                 },
-                "map_of_scalar_integers": {
-                    "additionalProperties": {
-                        "type": "integer"
+                "map_of_integers": {
+                    "additionalProperties": false,
+                    "patternProperties": {
+                        "^-?[0-9]+$": {
+                            "type": "integer"
+                        }
                     },
                     "type": "object"
                 },
-                "list_of_integers": {
-                    "items": {
-                        "type": "integer",
-                        "title": "Int 32 Value",
-                        "description": "Wrapper message for ` + "`int32`" + `. The JSON representation for ` + "`Int32Value`" + ` is JSON number."
+                "map_of_scalar_integers": {
+                    "additionalProperties": false,
+                    "patternProperties": {
+                        "^-?[0-9]+$": {
+                            "type": "integer"
+                        }
                     },
-                    "type": "array"
+                    "type": "object"
                 },
-                "duration": {
-                    "pattern": "^([0-9]+\\.?[0-9]*|\\.[0-9]+)s$",
-                    "type": "string",
-                    "description": "This is a duration:",
-                    "format": "regex"
+                "string_value": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
                 },
                 "struct": {
                     "additionalProperties": true,
                     "type": "object"
                 }
             },
-            "additionalProperties": true,
-            "type": "object",
-            "title": "Well Known"
+            "title": "Well Known",
+            "type": "object"
         }
     }
-}`
+}
+`
 
 const WellKnownFail = `{"duration": "9"}`
 
-const WellKnownPass = `{"duration": "9s"}`
+const WellKnownPass = `{"duration": "9s", "string_value": null}`
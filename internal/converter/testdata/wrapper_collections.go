@@ -0,0 +1,47 @@
+package testdata
+
+const WrapperCollections = `{
+    "$ref": "#/definitions/WrapperCollections",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "WrapperCollections": {
+            "additionalProperties": true,
+            "properties": {
+                "ids": {
+                    "items": {
+                        "type": "string"
+                    },
+                    "type": "array"
+                },
+                "labels": {
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "type": "object"
+                },
+                "ratios": {
+                    "additionalProperties": false,
+                    "patternProperties": {
+                        "^-?[0-9]+$": {
+                            "type": "number"
+                        }
+                    },
+                    "type": "object"
+                },
+                "scores": {
+                    "items": {
+                        "type": "integer"
+                    },
+                    "type": "array"
+                }
+            },
+            "title": "Wrapper Collections",
+            "type": "object"
+        }
+    }
+}
+`
+
+const WrapperCollectionsPass = `{"scores": [1, 2], "ids": ["18446744073709551615"], "labels": {"a": "b"}, "ratios": {"1": 0.5}}`
+
+const WrapperCollectionsFail = `{"scores": [{"value": 1}], "labels": {"a": {"value": "b"}}}`
@@ -0,0 +1,97 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/iancoleman/orderedmap"
+)
+
+// typeSpecificKeywords are the keywords which only constrain instances of one type (eg "pattern" has nothing to say
+// about integers), so alternatives made of them can share a schema without changing what it accepts:
+var typeSpecificKeywords = map[string][]string{
+	"array":   {"additionalItems", "contains", "items", "maxContains", "maxItems", "minContains", "minItems", "prefixItems", "uniqueItems"},
+	"integer": {"exclusiveMaximum", "exclusiveMinimum", "maximum", "minimum", "multipleOf"},
+	"null":    {},
+	"number":  {"exclusiveMaximum", "exclusiveMinimum", "maximum", "minimum", "multipleOf"},
+	"object":  {"additionalProperties", "dependencies", "maxProperties", "minProperties", "patternProperties", "properties", "propertyNames", "required"},
+	"string":  {"format", "maxLength", "minLength", "pattern"},
+}
+
+// typeArrays declares alternative types with "type" arrays (eg `"type": ["integer", "string"]`) instead of "oneOf"
+// (or "anyOf") throughout a document. Validators which coerce types (eg Ajv, in Fastify and Express middleware) only
+// coerce to the types listed in "type", so this is what lets them accept (eg) query string parameters:
+func typeArrays(jsonSchemaJSON []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(jsonSchemaJSON))
+	decoder.UseNumber()
+	decoded, err := decodeOrderedJSON(decoder)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := decoded.(*orderedmap.OrderedMap)
+	if !ok {
+		return jsonSchemaJSON, nil
+	}
+
+	walkSchema(root, func(schema *orderedmap.OrderedMap) {
+		for _, keyword := range []string{"oneOf", "anyOf"} {
+			alternatives, ok := getKeyword(schema, keyword)
+			if !ok {
+				continue
+			}
+			if merged, ok := mergeTypeAlternatives(alternatives); ok && !sharesKeywords(schema, merged) {
+				schema.Delete(keyword)
+				for _, mergedKeyword := range merged.Keys() {
+					value, _ := merged.Get(mergedKeyword)
+					schema.Set(mergedKeyword, value)
+				}
+			}
+		}
+	})
+
+	return json.MarshalIndent(root, "", "    ")
+}
+
+// mergeTypeAlternatives merges alternatives into a single schema with a "type" array, as long as each one is just a
+// different type (with keywords specific to it). Integers and numbers can't be told apart by their keywords, so they
+// don't merge with each other:
+func mergeTypeAlternatives(alternatives []interface{}) (*orderedmap.OrderedMap, bool) {
+	if len(alternatives) < 2 {
+		return nil, false
+	}
+
+	merged := orderedmap.New()
+	merged.Set("type", nil) // Leading the other keywords
+	var types []interface{}
+	seenTypes := make(map[string]bool)
+	for _, alternative := range alternatives {
+		alternative, ok := alternative.(*orderedmap.OrderedMap)
+		if !ok {
+			return nil, false
+		}
+		jsonType, _ := alternative.Get("type")
+		typeName, ok := jsonType.(string)
+		if !ok || seenTypes[typeName] {
+			return nil, false
+		}
+		if (typeName == "integer" && seenTypes["number"]) || (typeName == "number" && seenTypes["integer"]) {
+			return nil, false
+		}
+		seenTypes[typeName] = true
+		types = append(types, typeName)
+
+		for _, keyword := range alternative.Keys() {
+			if keyword == "type" {
+				continue
+			}
+			if !contains(typeSpecificKeywords[typeName], keyword) {
+				return nil, false
+			}
+			value, _ := alternative.Get(keyword)
+			merged.Set(keyword, value)
+		}
+	}
+
+	merged.Set("type", types)
+	return merged, true
+}
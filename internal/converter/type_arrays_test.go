@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeArrays(t *testing.T) {
+
+	// Alternatives which are just different types become type arrays (keeping their type-specific keywords), but anything
+	// else (eg enums, integers vs numbers, or keywords the schema already has) is left alone:
+	declared, err := typeArrays([]byte(`{
+		"$ref": "#/definitions/Order",
+		"definitions": {
+			"Order": {
+				"type": "object",
+				"properties": {
+					"id": {"oneOf": [{"type": "integer", "minimum": 1}, {"type": "string", "pattern": "^[0-9]+$"}], "description": "The ID"},
+					"note": {"anyOf": [{"type": "null"}, {"type": "string"}]},
+					"status": {"oneOf": [{"type": "string", "enum": ["OPEN"]}, {"type": "integer", "enum": [1]}]},
+					"amount": {"oneOf": [{"type": "integer"}, {"type": "number"}]},
+					"labels": {"oneOf": [{"type": "null"}, {"type": "array", "items": {"oneOf": [{"type": "null"}, {"type": "boolean"}]}}]},
+					"code": {"pattern": "^[A-Z]+$", "oneOf": [{"type": "null"}, {"type": "string", "pattern": "^[a-z]+$"}]}
+				}
+			}
+		}
+	}`))
+	require.NoError(t, err)
+
+	var schema struct {
+		Definitions map[string]struct {
+			Properties map[string]map[string]interface{} `json:"properties"`
+		} `json:"definitions"`
+	}
+	require.NoError(t, json.Unmarshal(declared, &schema))
+	properties := schema.Definitions["Order"].Properties
+	assert.Equal(t, map[string]interface{}{"type": []interface{}{"integer", "string"}, "minimum": 1.0, "pattern": "^[0-9]+$", "description": "The ID"}, properties["id"])
+	assert.Equal(t, map[string]interface{}{"type": []interface{}{"null", "string"}}, properties["note"])
+	assert.Contains(t, properties["status"], "oneOf")
+	assert.Contains(t, properties["amount"], "oneOf")
+	assert.Equal(t, []interface{}{"null", "array"}, properties["labels"]["type"])
+	assert.Equal(t, map[string]interface{}{"type": []interface{}{"null", "boolean"}}, properties["labels"]["items"])
+	assert.Contains(t, properties["code"], "oneOf")
+
+	// Type arrays lead the other keywords:
+	assert.True(t, strings.Index(string(declared), `"type": [`) < strings.Index(string(declared), `"minimum"`))
+
+	_, err = typeArrays([]byte(`{"type": `))
+	assert.Error(t, err)
+}
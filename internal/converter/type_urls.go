@@ -0,0 +1,18 @@
+package converter
+
+import "github.com/alecthomas/jsonschema"
+
+// typeURL is the type URL of a message (eg "type.googleapis.com/acme.v1.Order"), as found in the "@type" of Any payloads:
+func typeURL(pkgName, msgName string) string {
+	return anyTypeURLPrefix + qualifiedName(pkgName, msgName)
+}
+
+// stampTypeURL identifies a schema by the type URL of its message, so that routers and registries can resolve it by
+// exactly the identifier they find in payloads (our other ID parameters take precedence, as they're more specific):
+func (c *Converter) stampTypeURL(pkgName, msgName string, jsonSchemaType *jsonschema.Type) {
+	if !c.Flags.TypeURLNames {
+		return
+	}
+
+	setSchemaID(jsonSchemaType, typeURL(pkgName, msgName))
+}
@@ -0,0 +1,12 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeURL(t *testing.T) {
+	assert.Equal(t, "type.googleapis.com/acme.v1.Order", typeURL("acme.v1", "Order"))
+	assert.Equal(t, "type.googleapis.com/Order", typeURL("", "Order"))
+}
@@ -1,9 +1,10 @@
 package converter
 
 import (
-	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/alecthomas/jsonschema"
 	"github.com/iancoleman/orderedmap"
@@ -15,30 +16,15 @@ import (
 	protoc_gen_validate "github.com/envoyproxy/protoc-gen-validate/validate"
 )
 
-var (
-	globalPkg = newProtoPackage(nil, "")
-
-	wellKnownTypes = map[string]bool{
-		"DoubleValue": true,
-		"FloatValue":  true,
-		"Int64Value":  true,
-		"UInt64Value": true,
-		"Int32Value":  true,
-		"UInt32Value": true,
-		"BoolValue":   true,
-		"StringValue": true,
-		"BytesValue":  true,
-		"Value":       true,
-		"Duration":    true,
-		"Struct":      true,
-	}
-)
+// base64Pattern matches the base64 strings which proto3's JSON mapping uses for bytes (it accepts both the standard and
+// URL-safe alphabets, with or without padding):
+const base64Pattern = "^[A-Za-z0-9+/_-]*={0,2}$"
 
 func (c *Converter) registerEnum(pkgName string, enum *descriptor.EnumDescriptorProto) {
-	pkg := globalPkg
+	pkg := c.rootPkg
 	if pkgName != "" {
 		for _, node := range strings.Split(pkgName, ".") {
-			if pkg == globalPkg && node == "" {
+			if pkg == c.rootPkg && node == "" {
 				// Skips leading "."
 				continue
 			}
@@ -54,10 +40,10 @@ func (c *Converter) registerEnum(pkgName string, enum *descriptor.EnumDescriptor
 }
 
 func (c *Converter) registerType(pkgName string, msgDesc *descriptor.DescriptorProto) {
-	pkg := globalPkg
+	pkg := c.rootPkg
 	if pkgName != "" {
 		for _, node := range strings.Split(pkgName, ".") {
-			if pkg == globalPkg && node == "" {
+			if pkg == c.rootPkg && node == "" {
 				// Skips leading "."
 				continue
 			}
@@ -75,13 +61,20 @@ func (c *Converter) registerType(pkgName string, msgDesc *descriptor.DescriptorP
 // Convert a proto "field" (essentially a type-switch with some recursion):
 func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDescriptorProto, msgDesc *descriptor.DescriptorProto, duplicatedMessages map[*descriptor.DescriptorProto]string, messageFlags ConverterFlags) (*jsonschema.Type, error) {
 
+	// Fields with a raw schema get exactly that (for when the automatic mapping gets it wrong):
+	if rawSchema := rawFieldSchema(desc); rawSchema != "" {
+		return c.convertRawSchema(desc, rawSchema)
+	}
+
 	// Prepare a new jsonschema.Type for our eventual return value:
 	jsonSchemaType := &jsonschema.Type{}
 
-	// Generate a description from src comments (if available)
+	// Generate a description from src comments (if available), and the title / description options:
+	var title, description string
 	if src := c.sourceInfo.GetField(desc); src != nil {
-		jsonSchemaType.Title, jsonSchemaType.Description = c.formatTitleAndDescription(nil, src)
+		title, description = c.formatTitleAndDescription(nil, src)
 	}
+	c.setFieldDocs(desc, jsonSchemaType, title, description)
 
 	// Switch the types, and pick a JSONSchema equivalent:
 	switch desc.GetType() {
@@ -89,13 +82,32 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 	// Float32:
 	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
 		descriptor.FieldDescriptorProto_TYPE_FLOAT:
-		if messageFlags.AllowNullValues {
+		numberDef := &jsonschema.Type{Type: gojsonschema.TYPE_NUMBER}
+
+		// Custom field options from protoc-gen-jsonschema:
+		if opt := proto.GetExtension(desc.GetOptions(), protoc_gen_jsonschema.E_FieldOptions); opt != nil {
+			if fieldOptions, ok := opt.(*protoc_gen_jsonschema.FieldOptions); ok && fieldOptions.GetMultipleOf() > 0 {
+				setExtras(numberDef, map[string]interface{}{"multipleOf": fieldOptions.GetMultipleOf()})
+			}
+		}
+
+		// Custom field options from protoc-gen-validate:
+		setExtras(numberDef, c.numericRules(desc))
+
+		// Optionally accept every encoding proto3's JSON mapping does (quoted numbers, "NaN" and infinities):
+		if messageFlags.FaithfulProtoJSON {
+			jsonSchemaType.OneOf = faithfulNumber(numberDef, desc.GetType())
+			if messageFlags.AllowNullValues {
+				jsonSchemaType.OneOf = append([]*jsonschema.Type{{Type: gojsonschema.TYPE_NULL}}, jsonSchemaType.OneOf...)
+			}
+		} else if messageFlags.AllowNullValues {
 			jsonSchemaType.OneOf = []*jsonschema.Type{
 				{Type: gojsonschema.TYPE_NULL},
-				{Type: gojsonschema.TYPE_NUMBER},
+				numberDef,
 			}
 		} else {
-			jsonSchemaType.Type = gojsonschema.TYPE_NUMBER
+			jsonSchemaType.Type = numberDef.Type
+			setExtras(jsonSchemaType, numberDef.Extras)
 		}
 
 	// Int32:
@@ -104,13 +116,25 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 		descriptor.FieldDescriptorProto_TYPE_FIXED32,
 		descriptor.FieldDescriptorProto_TYPE_SFIXED32,
 		descriptor.FieldDescriptorProto_TYPE_SINT32:
-		if messageFlags.AllowNullValues {
+		integerDef := &jsonschema.Type{Type: gojsonschema.TYPE_INTEGER}
+
+		// Custom field options from protoc-gen-validate:
+		setExtras(integerDef, c.numericRules(desc))
+
+		// Optionally accept every encoding proto3's JSON mapping does (quoted numbers):
+		if messageFlags.FaithfulProtoJSON {
+			jsonSchemaType.OneOf = faithfulNumber(integerDef, desc.GetType())
+			if messageFlags.AllowNullValues {
+				jsonSchemaType.OneOf = append([]*jsonschema.Type{{Type: gojsonschema.TYPE_NULL}}, jsonSchemaType.OneOf...)
+			}
+		} else if messageFlags.AllowNullValues {
 			jsonSchemaType.OneOf = []*jsonschema.Type{
 				{Type: gojsonschema.TYPE_NULL},
-				{Type: gojsonschema.TYPE_INTEGER},
+				integerDef,
 			}
 		} else {
-			jsonSchemaType.Type = gojsonschema.TYPE_INTEGER
+			jsonSchemaType.Type = integerDef.Type
+			setExtras(jsonSchemaType, integerDef.Extras)
 		}
 
 	// Int64:
@@ -120,20 +144,23 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
 		descriptor.FieldDescriptorProto_TYPE_SINT64:
 
-		// As integer:
-		if c.Flags.DisallowBigIntsAsStrings {
+		// As integer (with any protoc-gen-validate rules, which can't apply to strings):
+		integerDef := &jsonschema.Type{Type: gojsonschema.TYPE_INTEGER}
+		setExtras(integerDef, c.numericRules(desc))
+		if c.Flags.DisallowBigIntsAsStrings && !messageFlags.FaithfulProtoJSON {
 			if messageFlags.AllowNullValues {
 				jsonSchemaType.OneOf = []*jsonschema.Type{
-					{Type: gojsonschema.TYPE_INTEGER},
+					integerDef,
 					{Type: gojsonschema.TYPE_NULL},
 				}
 			} else {
-				jsonSchemaType.Type = gojsonschema.TYPE_INTEGER
+				jsonSchemaType.Type = integerDef.Type
+				setExtras(jsonSchemaType, integerDef.Extras)
 			}
 		}
 
-		// As string:
-		if !c.Flags.DisallowBigIntsAsStrings {
+		// Or as a plain string (as older releases did):
+		if messageFlags.BigIntsAsPlainStrings && !c.Flags.DisallowBigIntsAsStrings && !messageFlags.FaithfulProtoJSON {
 			if messageFlags.AllowNullValues {
 				jsonSchemaType.OneOf = []*jsonschema.Type{
 					{Type: gojsonschema.TYPE_STRING},
@@ -142,6 +169,22 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 			} else {
 				jsonSchemaType.Type = gojsonschema.TYPE_STRING
 			}
+			break
+		}
+
+		// Or as a string of digits (which is how proto3's JSON mapping writes them, and what it accepts as well as numbers,
+		// along with any other quoted number literals when we're being faithful to it):
+		if !c.Flags.DisallowBigIntsAsStrings || messageFlags.FaithfulProtoJSON {
+			jsonSchemaType.OneOf = []*jsonschema.Type{
+				integerDef,
+				{Type: gojsonschema.TYPE_STRING, Pattern: mapKeyPatterns[desc.GetType()]},
+			}
+			if messageFlags.FaithfulProtoJSON {
+				jsonSchemaType.OneOf = faithfulNumber(integerDef, desc.GetType())
+			}
+			if messageFlags.AllowNullValues {
+				jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: gojsonschema.TYPE_NULL})
+			}
 		}
 
 	// String:
@@ -154,6 +197,14 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 				stringDef.MinLength = int(fieldOptions.GetMinLength())
 				stringDef.MaxLength = int(fieldOptions.GetMaxLength())
 				stringDef.Pattern = fieldOptions.GetPattern()
+
+				// Decimals (as strings) get a pattern matching their precision and scale:
+				if fieldOptions.GetPrecision() > 0 {
+					if stringDef.Pattern == "" {
+						stringDef.Pattern = decimalPattern(fieldOptions.GetPrecision(), fieldOptions.GetScale())
+					}
+					setExtras(stringDef, map[string]interface{}{"x-precision": fieldOptions.GetPrecision(), "x-scale": fieldOptions.GetScale()})
+				}
 			}
 		}
 
@@ -168,6 +219,11 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 			}
 		}
 
+		// Optionally infer a format from conventional field names (eg "contact_email"):
+		if messageFlags.InferFormats {
+			inferStringFormat(desc.GetName(), stringDef)
+		}
+
 		if messageFlags.AllowNullValues {
 			jsonSchemaType.OneOf = []*jsonschema.Type{
 				{Type: gojsonschema.TYPE_NULL},
@@ -178,23 +234,44 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 			jsonSchemaType.MinLength = stringDef.MinLength
 			jsonSchemaType.MaxLength = stringDef.MaxLength
 			jsonSchemaType.Pattern = stringDef.Pattern
+			jsonSchemaType.Format = stringDef.Format
+			jsonSchemaType.AnyOf = stringDef.AnyOf
+			setExtras(jsonSchemaType, stringDef.Extras)
 		}
 
-	// Bytes:
+	// Bytes (base64-encoded, with either the standard or the URL-safe alphabet, and optional padding):
 	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		bytesDef := &jsonschema.Type{
+			Type:    gojsonschema.TYPE_STRING,
+			Format:  "binary",
+			Pattern: base64Pattern,
+		}
+		setExtras(bytesDef, map[string]interface{}{"contentEncoding": "base64"})
+
+		// Older releases described the encoding with a keyword of their own (and no pattern):
+		if messageFlags.BinaryEncoding {
+			bytesDef = &jsonschema.Type{
+				Type:           gojsonschema.TYPE_STRING,
+				Format:         "binary",
+				BinaryEncoding: "base64",
+			}
+		}
+
+		// Optionally only accept what proto3's JSON mapping does (one alphabet or the other, and padding in the right places):
+		if messageFlags.FaithfulProtoJSON {
+			bytesDef.Pattern = protojsonBase64Pattern
+		}
 		if messageFlags.AllowNullValues {
 			jsonSchemaType.OneOf = []*jsonschema.Type{
 				{Type: gojsonschema.TYPE_NULL},
-				{
-					Type:           gojsonschema.TYPE_STRING,
-					Format:         "binary",
-					BinaryEncoding: "base64",
-				},
+				bytesDef,
 			}
 		} else {
-			jsonSchemaType.Type = gojsonschema.TYPE_STRING
-			jsonSchemaType.Format = "binary"
-			jsonSchemaType.BinaryEncoding = "base64"
+			jsonSchemaType.Type = bytesDef.Type
+			jsonSchemaType.Format = bytesDef.Format
+			jsonSchemaType.Pattern = bytesDef.Pattern
+			jsonSchemaType.BinaryEncoding = bytesDef.BinaryEncoding
+			setExtras(jsonSchemaType, bytesDef.Extras)
 		}
 
 	// ENUM:
@@ -203,12 +280,23 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 		// Go through all the enums we have, see if we can match any to this field.
 		fullEnumIdentifier := strings.TrimPrefix(desc.GetTypeName(), ".")
 		matchedEnum, _, ok := c.lookupEnum(curPkg, fullEnumIdentifier)
+		if !ok && c.Flags.LenientLookup {
+			return c.unresolvedFieldType(desc, &jsonschema.Type{}), nil
+		}
 		if !ok {
 			return nil, fmt.Errorf("unable to resolve enum type: %s", desc.GetType().String())
 		}
 
+		// Fields can have the prefix trimmed from their ENUM's values (just for them):
+		enumFlags := messageFlags
+		if opt := proto.GetExtension(desc.GetOptions(), protoc_gen_jsonschema.E_FieldOptions); opt != nil {
+			if fieldOptions, ok := opt.(*protoc_gen_jsonschema.FieldOptions); ok && fieldOptions.GetEnumsTrimPrefix() {
+				enumFlags.EnumsTrimPrefix = true
+			}
+		}
+
 		// We already have a converter for standalone ENUMs, so just use that:
-		enumSchema, err := c.convertEnumType(matchedEnum, messageFlags)
+		enumSchema, err := c.convertEnumType(matchedEnum, enumFlags)
 		if err != nil {
 			switch err {
 			case errIgnored:
@@ -218,6 +306,14 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 		}
 
 		jsonSchemaType = &enumSchema
+		if fullEnumIdentifier == "google.protobuf.NullValue" && !messageFlags.NonNullableValues {
+			convertNullValueType(jsonSchemaType)
+		}
+
+		// Optionally define the ENUM once (and refer to it from every field which uses it, unless it's been trimmed for this one):
+		if messageFlags.EnumsAsDefinitions && err == nil && enumFlags.EnumsTrimPrefix == messageFlags.EnumsTrimPrefix {
+			jsonSchemaType = c.enumDefinitionRef(fullEnumIdentifier, jsonSchemaType)
+		}
 
 	// Bool:
 	case descriptor.FieldDescriptorProto_TYPE_BOOL:
@@ -233,7 +329,22 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 	// Group (object):
 	case descriptor.FieldDescriptorProto_TYPE_GROUP, descriptor.FieldDescriptorProto_TYPE_MESSAGE:
 
-		switch desc.GetTypeName() {
+		// Any fields may be restricted to particular payload types:
+		if len(anyFieldTypes(desc)) > 0 {
+			anyJSONSchemaTypes, err := c.convertAnyTypes(curPkg, desc, duplicatedMessages)
+			if err != nil {
+				return nil, err
+			}
+			if len(anyJSONSchemaTypes) > 0 {
+				if messageFlags.AllowNullValues {
+					anyJSONSchemaTypes = append([]*jsonschema.Type{{Type: gojsonschema.TYPE_NULL}}, anyJSONSchemaTypes...)
+				}
+				jsonSchemaType.OneOf = anyJSONSchemaTypes
+				break
+			}
+		}
+
+		switch c.wellKnownTypeName(desc) {
 		// Make sure that durations match a particular string pattern (eg 3.4s):
 		case ".google.protobuf.Duration":
 			jsonSchemaType.Type = gojsonschema.TYPE_STRING
@@ -242,16 +353,32 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 		case ".google.protobuf.Timestamp":
 			jsonSchemaType.Type = gojsonschema.TYPE_STRING
 			jsonSchemaType.Format = "date-time"
+
+			// Custom field options from protoc-gen-jsonschema:
+			if opt := proto.GetExtension(desc.GetOptions(), protoc_gen_jsonschema.E_FieldOptions); opt != nil {
+				if fieldOptions, ok := opt.(*protoc_gen_jsonschema.FieldOptions); ok {
+
+					// Business dates only have a date component:
+					if fieldOptions.GetDateOnly() {
+						jsonSchemaType.Format = "date"
+					}
+
+					// Declare the time-zone these timestamps are expressed in:
+					if fieldOptions.GetTimezone() != "" {
+						setExtras(jsonSchemaType, map[string]interface{}{"x-format-timezone": fieldOptions.GetTimezone()})
+					}
+				}
+			}
 		default:
 			jsonSchemaType.Type = gojsonschema.TYPE_OBJECT
 			if desc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_OPTIONAL {
-				jsonSchemaType.AdditionalProperties = []byte("true")
+				setAdditionalProperties(jsonSchemaType, true)
 			}
 			if desc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REQUIRED {
-				jsonSchemaType.AdditionalProperties = []byte("false")
+				setAdditionalProperties(jsonSchemaType, false)
 			}
 			if messageFlags.DisallowAdditionalProperties {
-				jsonSchemaType.AdditionalProperties = []byte("false")
+				setAdditionalProperties(jsonSchemaType, false)
 			}
 		}
 
@@ -273,13 +400,37 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 			}
 		}
 
-		if len(jsonSchemaType.Enum) > 0 {
-			jsonSchemaType.Items.Enum = jsonSchemaType.Enum
-			jsonSchemaType.Enum = nil
+		// ENUMs carry all of their constraints (values, and any constants) into the items, just like map values do:
+		jsonSchemaType.Items.Type = jsonSchemaType.Type
+		jsonSchemaType.Items.OneOf = jsonSchemaType.OneOf
+		jsonSchemaType.Items.Enum = jsonSchemaType.Enum
+		jsonSchemaType.Items.Ref = jsonSchemaType.Ref
+		jsonSchemaType.Enum = nil
+		jsonSchemaType.Ref = ""
+
+		// Older releases only gave the items of ENUMs their values:
+		if messageFlags.UntypedEnumItems && len(jsonSchemaType.Items.Enum) > 0 {
+			jsonSchemaType.Items.Type = ""
 			jsonSchemaType.Items.OneOf = nil
-		} else {
-			jsonSchemaType.Items.Type = jsonSchemaType.Type
-			jsonSchemaType.Items.OneOf = jsonSchemaType.OneOf
+		}
+
+		// Strings (and bytes) carry their patterns, formats and encodings into the items too (apart from well-known types
+		// converted the way older releases did, which left them on the array):
+		if !messageFlags.LegacyWellKnownTypes || c.wellKnownTypeName(desc) == "" {
+			jsonSchemaType.Items.Pattern, jsonSchemaType.Pattern = jsonSchemaType.Pattern, ""
+			jsonSchemaType.Items.Format, jsonSchemaType.Format = jsonSchemaType.Format, ""
+			jsonSchemaType.Items.AnyOf, jsonSchemaType.AnyOf = jsonSchemaType.AnyOf, nil
+			jsonSchemaType.Items.BinaryEncoding, jsonSchemaType.BinaryEncoding = jsonSchemaType.BinaryEncoding, ""
+		}
+
+		// As do the extra keywords which constrain values (eg multipleOf), which would do nothing on the array itself
+		// (unlike the ones documenting the field):
+		for keyword, value := range jsonSchemaType.Extras {
+			if fieldDocKeywords[keyword] {
+				continue
+			}
+			setExtras(jsonSchemaType.Items, map[string]interface{}{keyword: value})
+			delete(jsonSchemaType.Extras, keyword)
 		}
 
 		if messageFlags.AllowNullValues {
@@ -298,6 +449,10 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 	if jsonSchemaType.Type == gojsonschema.TYPE_OBJECT {
 
 		recordType, pkgName, ok := c.lookupType(curPkg, desc.GetTypeName())
+		if !ok && c.Flags.LenientLookup {
+			setAdditionalProperties(jsonSchemaType, true)
+			return c.unresolvedFieldType(desc, jsonSchemaType), nil
+		}
 		if !ok {
 			return nil, fmt.Errorf("no such message type named %s", desc.GetTypeName())
 		}
@@ -323,17 +478,29 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 			}
 
 			// Make sure we have a "value":
-			value, valuePresent := recursedJSONSchemaType.Properties.Get("value")
+			value, valuePresent := recursedJSONSchemaType.Properties.Get(c.renamedProperty("value"))
 			if !valuePresent {
 				return nil, fmt.Errorf("Unable to find 'value' property of MAP type")
 			}
 
-			// Marshal the "value" properties to JSON (because that's how we can pass on AdditionalProperties):
-			additionalPropertiesJSON, err := json.Marshal(value)
-			if err != nil {
+			// The "value" schema describes every property of the map:
+			valueJSONSchemaType, ok := value.(*jsonschema.Type)
+			if !ok {
+				return nil, fmt.Errorf("Unable to find schema of 'value' property of MAP type")
+			}
+
+			// Keys which aren't strings get a pattern (eg integers), which every property of the map has to match:
+			if keyPattern := mapKeyPattern(recordType); keyPattern != "" && !messageFlags.UnconstrainedMapKeys {
+				jsonSchemaType.PatternProperties = map[string]*jsonschema.Type{keyPattern: valueJSONSchemaType}
+				setAdditionalProperties(jsonSchemaType, false)
+			} else {
+				setAdditionalPropertiesSchema(jsonSchemaType, valueJSONSchemaType)
+			}
+
+			// Maps may also describe the keys they expect:
+			if err := c.applyKnownKeys(desc, jsonSchemaType, valueJSONSchemaType); err != nil {
 				return nil, err
 			}
-			jsonSchemaType.AdditionalProperties = additionalPropertiesJSON
 
 		// Arrays:
 		case desc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED:
@@ -351,17 +518,49 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 		// Not maps, not arrays:
 		default:
 
+			// Truncated messages (see nesting_depth.go) accept anything:
+			if _, truncated := recursedJSONSchemaType.Extras[truncatedKeyword]; truncated {
+				return recursedJSONSchemaType, nil
+			}
+
+			// Wrappers with alternatives (see faithful_protojson) can be null too (unless they already can be, or are map values):
+			if recursedJSONSchemaType.OneOf != nil && messageFlags.FaithfulProtoJSON && !messageFlags.AllowNullValues && c.isWrapperField(desc) && !msgDesc.GetOptions().GetMapEntry() {
+				nullableJSONSchemaType := *recursedJSONSchemaType
+				nullableJSONSchemaType.OneOf = append([]*jsonschema.Type{{Type: gojsonschema.TYPE_NULL}}, recursedJSONSchemaType.OneOf...)
+				return &nullableJSONSchemaType, nil
+			}
+
 			// If we've got optional types then just take those:
 			if recursedJSONSchemaType.OneOf != nil {
 				return recursedJSONSchemaType, nil
 			}
 
+			// Wrapper types can also be null in proto3's JSON mapping (it's how they tell "unset" from the default value),
+			// but map values can't:
+			if c.isWrapperField(desc) && !msgDesc.GetOptions().GetMapEntry() && !messageFlags.NonNullableWrappers {
+				jsonSchemaType.OneOf = []*jsonschema.Type{
+					{Type: gojsonschema.TYPE_NULL},
+					{Type: recursedJSONSchemaType.Type, Pattern: recursedJSONSchemaType.Pattern},
+				}
+				jsonSchemaType.Type = ""
+				clearAdditionalProperties(jsonSchemaType)
+				return jsonSchemaType, nil
+			}
+
 			// If we're not an object then set the type from whatever we recursed:
 			if recursedJSONSchemaType.Type != gojsonschema.TYPE_OBJECT {
 				jsonSchemaType.Type = recursedJSONSchemaType.Type
 			}
 
+			// Wrapper types unwrap to scalars (wherever they're used), which can't have additional properties:
+			if recursedJSONSchemaType.Type != "" && recursedJSONSchemaType.Type != gojsonschema.TYPE_OBJECT && !messageFlags.NonNullableWrappers {
+				clearAdditionalProperties(jsonSchemaType)
+			}
+
 			// Assume the attrbutes of the recursed value:
+			if recursedJSONSchemaType.Pattern != "" {
+				jsonSchemaType.Pattern = recursedJSONSchemaType.Pattern
+			}
 			jsonSchemaType.Properties = recursedJSONSchemaType.Properties
 			jsonSchemaType.Ref = recursedJSONSchemaType.Ref
 			jsonSchemaType.Required = recursedJSONSchemaType.Required
@@ -393,22 +592,47 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 func (c *Converter) convertMessageType(curPkg *ProtoPackage, msgDesc *descriptor.DescriptorProto) (*jsonschema.Schema, error) {
 
 	// Get a list of any nested messages in our schema:
+	started := time.Now()
 	duplicatedMessages, err := c.findNestedMessages(curPkg, msgDesc)
 	if err != nil {
 		return nil, err
 	}
+	c.reportTiming(phaseResolution, started)
 
-	// Build up a list of JSONSchema type definitions for every message:
+	// Build up a list of JSONSchema type definitions for every message (and any ENUMs they define once):
+	started = time.Now()
 	definitions := jsonschema.Definitions{}
+	c.enumDefinitions = definitions
+	defer func() { c.enumDefinitions = nil }()
+	depths := c.definitionDepths(curPkg, msgDesc)
+	defer func() { c.messageDepth = 0 }()
 	for refmsgDesc, name := range duplicatedMessages {
-		refType, err := c.recursiveConvertMessageType(curPkg, refmsgDesc, "", duplicatedMessages, true)
+
+		// Shared messages are defined in the shared schema instead:
+		if refmsgDesc != msgDesc && c.isSharedMessage(name) {
+			continue
+		}
+
+		// Definitions count towards the maximum depth from wherever they're referenced (and those which only truncated
+		// messages would refer to are left out):
+		c.messageDepth = depths[refmsgDesc]
+		if c.maxDepth > 0 && c.messageDepth > c.maxDepth+1 {
+			continue
+		}
+
+		refType, err := c.convertMessageDefinition(curPkg, refmsgDesc, duplicatedMessages)
 		if err != nil {
 			return nil, err
 		}
 
+		// Optionally split up large messages:
+		c.splitLargeMessage(refmsgDesc, name, refType, definitions)
+
 		// Add the schema to our definitions:
 		definitions[name] = refType
 	}
+	c.addTypedPayloadDefinitions(definitions)
+	c.reportTiming(phaseRecursion, started)
 
 	// Put together a JSON schema with our discovered definitions, and a $ref for the root type:
 	newJSONSchema := &jsonschema.Schema{
@@ -435,9 +659,17 @@ func (c *Converter) findNestedMessages(curPkg *ProtoPackage, msgDesc *descriptor
 	// Now filter them:
 	result := make(map[*descriptor.DescriptorProto]string)
 	for message, messageName := range nestedMessages {
-		if !message.GetOptions().GetMapEntry() && !strings.HasPrefix(messageName, ".google.protobuf.") {
-			result[message] = strings.TrimLeft(messageName, ".")
+		if message.GetOptions().GetMapEntry() || (strings.HasPrefix(messageName, wellKnownTypePrefix) && !c.Flags.WellKnownTypesAsMessages) {
+			continue
+		}
+
+		// Referenced messages are defined under whatever our naming strategy calls them (apart from shared messages,
+		// which the shared schema defines under their fully-qualified names):
+		messageName = strings.TrimLeft(messageName, ".")
+		if message != msgDesc && !c.isSharedMessage(messageName) {
+			messageName = c.naming().DefinitionName(messageName)
 		}
+		result[message] = messageName
 	}
 
 	return result, nil
@@ -456,11 +688,38 @@ func (c *Converter) recursiveFindNestedMessages(curPkg *ProtoPackage, msgDesc *d
 			continue
 		}
 
+		// Alpha (restricted, or excluded by number) fields may be left out (along with whatever they reference), and raw schemas don't reference anything:
+		if c.excludedField(msgDesc, desc) || rawFieldSchema(desc) != "" {
+			continue
+		}
+
+		// Payload types permitted in Any fields need definitions too:
+		for _, anyType := range anyFieldTypes(desc) {
+			if recordType, _, ok := c.lookupType(curPkg, anyType); ok {
+				if err := c.recursiveFindNestedMessages(curPkg, recordType, anyType, nestedMessages); err != nil {
+					return err
+				}
+			}
+		}
+
 		typeName := desc.GetTypeName()
 		recordType, _, ok := c.lookupType(curPkg, typeName)
+		if !ok && c.Flags.LenientLookup {
+			continue // It'll be allowed to be anything
+		}
 		if !ok {
 			return fmt.Errorf("no such message type named %s", typeName)
 		}
+		if c.excludedMessage(recordType) {
+			continue
+		}
+
+		// Shared messages are only referenced (they're defined in the shared schema):
+		if c.isSharedMessage(typeName) {
+			nestedMessages[recordType] = typeName
+			continue
+		}
+
 		if err := c.recursiveFindNestedMessages(curPkg, recordType, typeName, nestedMessages); err != nil {
 			return err
 		}
@@ -469,12 +728,8 @@ func (c *Converter) recursiveFindNestedMessages(curPkg *ProtoPackage, msgDesc *d
 	return nil
 }
 
-func (c *Converter) recursiveConvertMessageType(curPkg *ProtoPackage, msgDesc *descriptor.DescriptorProto, pkgName string, duplicatedMessages map[*descriptor.DescriptorProto]string, ignoreDuplicatedMessages bool) (*jsonschema.Type, error) {
-
-	// Prepare a new jsonschema:
-	jsonSchemaType := new(jsonschema.Type)
-
-	// Set some per-message flags from config and options:
+// messageFlags returns the flags a message is converted with (from config, its options and the unknown fields policy):
+func (c *Converter) messageFlags(msgDesc *descriptor.DescriptorProto) ConverterFlags {
 	messageFlags := c.Flags
 
 	// Custom message options from protoc-gen-jsonschema:
@@ -502,45 +757,47 @@ func (c *Converter) recursiveConvertMessageType(curPkg *ProtoPackage, msgDesc *d
 			}
 		}
 	}
+	c.applyUnknownFieldsPolicy(&messageFlags)
+
+	return messageFlags
+}
+
+func (c *Converter) recursiveConvertMessageType(curPkg *ProtoPackage, msgDesc *descriptor.DescriptorProto, pkgName string, duplicatedMessages map[*descriptor.DescriptorProto]string, ignoreDuplicatedMessages bool) (*jsonschema.Type, error) {
+
+	// Prepare a new jsonschema:
+	jsonSchemaType := new(jsonschema.Type)
+
+	// Set some per-message flags from config and options:
+	messageFlags := c.messageFlags(msgDesc)
+	if c.Flags.ExplainConfig {
+		c.explainMessageConfig(msgDesc, messageFlags)
+	}
 
 	// Generate a description from src comments (if available)
 	if src := c.sourceInfo.GetMessage(msgDesc); src != nil {
-		jsonSchemaType.Title, jsonSchemaType.Description = c.formatTitleAndDescription(strPtr(msgDesc.GetName()), src)
-	}
-
-	// Handle google's well-known types:
-	if msgDesc.Name != nil && wellKnownTypes[*msgDesc.Name] && pkgName == ".google.protobuf" {
-		switch *msgDesc.Name {
-		case "DoubleValue", "FloatValue":
-			jsonSchemaType.Type = gojsonschema.TYPE_NUMBER
-		case "Int32Value", "UInt32Value":
-			jsonSchemaType.Type = gojsonschema.TYPE_INTEGER
-		case "Int64Value", "UInt64Value":
-			// BigInt as ints
-			if messageFlags.DisallowBigIntsAsStrings {
-				jsonSchemaType.Type = gojsonschema.TYPE_INTEGER
-			} else {
+		var description string
+		jsonSchemaType.Title, description = c.formatTitleAndDescription(strPtr(msgDesc.GetName()), src)
+		c.setDescription(jsonSchemaType, description)
+	}
 
-				// BigInt as strings
-				jsonSchemaType.Type = gojsonschema.TYPE_STRING
-			}
+	// Optionally preserve any message options we don't recognise:
+	if messageFlags.PreserveUnknownOptions {
+		setExtras(jsonSchemaType, c.unknownOptions(msgDesc.GetOptions()))
+	}
 
-		case "BoolValue":
-			jsonSchemaType.Type = gojsonschema.TYPE_BOOLEAN
-		case "BytesValue", "StringValue":
-			jsonSchemaType.Type = gojsonschema.TYPE_STRING
-		case "Value":
-			jsonSchemaType.OneOf = []*jsonschema.Type{
-				{Type: gojsonschema.TYPE_ARRAY},
-				{Type: gojsonschema.TYPE_BOOLEAN},
-				{Type: gojsonschema.TYPE_NUMBER},
-				{Type: gojsonschema.TYPE_OBJECT},
-				{Type: gojsonschema.TYPE_STRING},
-			}
-		case "Duration":
-			jsonSchemaType.Type = gojsonschema.TYPE_STRING
-		case "Struct":
-			jsonSchemaType.Type = gojsonschema.TYPE_OBJECT
+	// Declare the stability of this message:
+	if stability := c.messageStability(msgDesc); stability != "" {
+		setExtras(jsonSchemaType, map[string]interface{}{stabilityKeyword: stability})
+	}
+
+	// Handle google's well-known types (unless we're converting them as ordinary messages):
+	if convertWellKnownType, ok := c.wellKnownTypeConverter(msgDesc.GetName()); ok && pkgName == wellKnownPackage {
+		convertWellKnownType(jsonSchemaType, messageFlags)
+
+		// Wrappers which already have alternatives (see faithful_protojson) just gain null:
+		if messageFlags.AllowNullValues && jsonSchemaType.OneOf != nil && wrapperTypes[msgDesc.GetName()] {
+			jsonSchemaType.OneOf = append([]*jsonschema.Type{{Type: gojsonschema.TYPE_NULL}}, jsonSchemaType.OneOf...)
+			return jsonSchemaType, nil
 		}
 
 		// If we're allowing nulls then prepare a OneOf:
@@ -558,13 +815,28 @@ func (c *Converter) recursiveConvertMessageType(curPkg *ProtoPackage, msgDesc *d
 	// Set defaults:
 	jsonSchemaType.Properties = orderedmap.New()
 
+	// Check that the message name will suit schema consumers:
+	if !msgDesc.GetOptions().GetMapEntry() {
+		c.checkIdentifier("message_name", msgDesc.GetName())
+	}
+
 	// Look up references:
 	if refName, ok := duplicatedMessages[msgDesc]; ok && !ignoreDuplicatedMessages {
 		return &jsonschema.Type{
-			Ref: fmt.Sprintf("%s%s", c.refPrefix, refName),
+			Ref: c.definitionRef(refName),
 		}, nil
 	}
 
+	// Guard against pathologically deep nesting (references don't nest, so cycles never get this far):
+	if c.messageDepth > c.deepestMessageDepth {
+		c.deepestMessageDepth = c.messageDepth
+	}
+	if truncatedJSONSchemaType := c.truncatedMessage(msgDesc); truncatedJSONSchemaType != nil {
+		return truncatedJSONSchemaType, nil
+	}
+	c.messageDepth++
+	defer func() { c.messageDepth-- }()
+
 	// Optionally allow NULL values:
 	if messageFlags.AllowNullValues {
 		jsonSchemaType.OneOf = []*jsonschema.Type{
@@ -576,15 +848,28 @@ func (c *Converter) recursiveConvertMessageType(curPkg *ProtoPackage, msgDesc *d
 	}
 
 	// disallowAdditionalProperties will prevent validation where extra fields are found (outside of the schema):
-	if messageFlags.DisallowAdditionalProperties {
-		jsonSchemaType.AdditionalProperties = []byte("false")
-	} else {
-		jsonSchemaType.AdditionalProperties = []byte("true")
-	}
+	setAdditionalProperties(jsonSchemaType, !messageFlags.DisallowAdditionalProperties)
+	c.stampUnknownFieldsPolicy(msgDesc, jsonSchemaType)
 
 	c.logger.WithField("message_str", msgDesc.String()).Trace("Converting message")
+	var bannedFields []*jsonschema.Type
+	var oneofs [][]*jsonschema.Type
+	oneofPositions := make(map[int32]int)
+	propertyFields := make(map[string]string)
 	for _, fieldDesc := range msgDesc.GetField() {
 
+		// Alpha (restricted, or excluded by number) fields, and fields of alpha (or restricted) messages, can be left out of (public) schemas:
+		if c.excludedField(msgDesc, fieldDesc) {
+			c.logger.WithField("field_name", fieldDesc.GetName()).WithField("message_name", msgDesc.GetName()).Debug("Skipping excluded field")
+			continue
+		}
+		if fieldDesc.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+			if recordType, _, ok := c.lookupType(curPkg, fieldDesc.GetTypeName()); ok && c.excludedMessage(recordType) {
+				c.logger.WithField("field_name", fieldDesc.GetName()).WithField("message_name", msgDesc.GetName()).Debug("Skipping field of excluded message")
+				continue
+			}
+		}
+
 		// Custom field options from protoc-gen-jsonschema:
 		if opt := proto.GetExtension(fieldDesc.GetOptions(), protoc_gen_jsonschema.E_FieldOptions); opt != nil {
 			if fieldOptions, ok := opt.(*protoc_gen_jsonschema.FieldOptions); ok {
@@ -595,44 +880,95 @@ func (c *Converter) recursiveConvertMessageType(curPkg *ProtoPackage, msgDesc *d
 					continue
 				}
 
+				// "Banned" fields are skipped, and data which still contains them is rejected:
+				if fieldOptions.GetBanned() {
+					c.logger.WithField("field_name", fieldDesc.GetName()).WithField("message_name", msgDesc.GetName()).Debug("Banning field")
+					for _, fieldName := range c.fieldNames(fieldDesc) {
+						bannedFields = append(bannedFields, &jsonschema.Type{Required: []string{fieldName}})
+					}
+					continue
+				}
+
 				// "Required" fields are added to the list of required attributes in our schema:
 				if fieldOptions.GetRequired() {
 					c.logger.WithField("field_name", fieldDesc.GetName()).WithField("message_name", msgDesc.GetName()).Debug("Marking required field")
-					if c.Flags.UseJSONFieldnamesOnly {
-						jsonSchemaType.Required = append(jsonSchemaType.Required, fieldDesc.GetJsonName())
-					} else {
-						jsonSchemaType.Required = append(jsonSchemaType.Required, fieldDesc.GetName())
-					}
+					jsonSchemaType.Required = append(jsonSchemaType.Required, c.fieldNames(fieldDesc)[0])
 				}
 			}
 		}
 
+		// Explicitly optional fields can also be null (which is how some encoders write them when they aren't set):
+		fieldFlags := messageFlags
+		if c.Flags.AllowNullOptionals && c.optionalField(msgDesc, fieldDesc) {
+			fieldFlags.AllowNullValues = true
+		}
+
 		// Convert the field into a JSONSchema type:
-		recursedJSONSchemaType, err := c.convertField(curPkg, fieldDesc, msgDesc, duplicatedMessages, messageFlags)
+		recursedJSONSchemaType, err := c.convertField(curPkg, fieldDesc, msgDesc, duplicatedMessages, fieldFlags)
 		if err != nil {
 			c.logger.WithError(err).WithField("field_name", fieldDesc.GetName()).WithField("message_name", msgDesc.GetName()).Error("Failed to convert field")
 			return nil, err
 		}
 		c.logger.WithField("field_name", fieldDesc.GetName()).WithField("type", recursedJSONSchemaType.Type).Trace("Converted field")
 
-		// If this field is part of a OneOf declaration then build that here:
-		if c.Flags.EnforceOneOf && fieldDesc.OneofIndex != nil {
-			jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Required: []string{fieldDesc.GetName()}})
+		// Optionally annotate standard AIP fields (pagination, field masks):
+		if c.Flags.AIPConventions {
+			recursedJSONSchemaType = c.applyAIPConventions(fieldDesc, recursedJSONSchemaType, messageFlags)
 		}
 
-		// Figure out which field names we want to use:
-		switch {
-		case c.Flags.UseJSONFieldnamesOnly:
-			jsonSchemaType.Properties.Set(fieldDesc.GetJsonName(), recursedJSONSchemaType)
-		case c.Flags.UseProtoAndJSONFieldNames:
-			jsonSchemaType.Properties.Set(fieldDesc.GetName(), recursedJSONSchemaType)
-			jsonSchemaType.Properties.Set(fieldDesc.GetJsonName(), recursedJSONSchemaType)
-		default:
-			jsonSchemaType.Properties.Set(fieldDesc.GetName(), recursedJSONSchemaType)
+		// Apply any naming rules which match this field:
+		if err := c.applyNamingRules(fieldDesc, recursedJSONSchemaType); err != nil {
+			return nil, err
+		}
+
+		// Repeated fields may need to contain particular elements:
+		if err := c.applyContainsConstraint(fieldDesc, recursedJSONSchemaType); err != nil {
+			return nil, err
+		}
+
+		// Only maps have keys to describe:
+		if err := c.checkKnownKeys(curPkg, fieldDesc); err != nil {
+			return nil, err
+		}
+
+		// Carry any protovalidate examples through:
+		c.setProtovalidateExamples(fieldDesc, recursedJSONSchemaType)
+
+		// Optionally preserve any field options we don't recognise:
+		if messageFlags.PreserveUnknownOptions {
+			setExtras(recursedJSONSchemaType, c.unknownOptions(fieldDesc.GetOptions()))
+		}
+
+		// Declare the stability of this field:
+		if stability := c.fieldStability(fieldDesc); stability != "" {
+			setExtras(recursedJSONSchemaType, map[string]interface{}{stabilityKeyword: stability})
+		}
+
+		// If this field is part of a OneOf declaration then collect it here:
+		if c.enforcedOneof(fieldDesc) {
+			position, ok := oneofPositions[fieldDesc.GetOneofIndex()]
+			if !ok {
+				position = len(oneofs)
+				oneofPositions[fieldDesc.GetOneofIndex()] = position
+				oneofs = append(oneofs, nil)
+			}
+			oneofs[position] = append(oneofs[position], c.oneofAlternative(fieldDesc))
+		}
+
+		// Figure out which field names we want to use (and check that they'll suit schema consumers):
+		for _, propertyName := range c.fieldNames(fieldDesc) {
+			c.checkIdentifier("property_name", propertyName)
+
+			// Two fields under the same property would silently lose one of them:
+			if otherFieldName, ok := propertyFields[propertyName]; ok && otherFieldName != fieldDesc.GetName() {
+				return nil, fmt.Errorf("fields %s and %s of message %s both map to the JSON property %q", otherFieldName, fieldDesc.GetName(), msgDesc.GetName(), propertyName)
+			}
+			propertyFields[propertyName] = fieldDesc.GetName()
+			jsonSchemaType.Properties.Set(propertyName, recursedJSONSchemaType)
 		}
 
 		// Enforce all_fields_required:
-		if messageFlags.AllFieldsRequired && len(jsonSchemaType.OneOf) == 0 && jsonSchemaType.Properties != nil {
+		if messageFlags.AllFieldsRequired && len(jsonSchemaType.OneOf) == 0 && len(oneofs) == 0 && jsonSchemaType.Properties != nil {
 			for _, property := range jsonSchemaType.Properties.Keys() {
 				jsonSchemaType.Required = append(jsonSchemaType.Required, property)
 			}
@@ -640,11 +976,18 @@ func (c *Converter) recursiveConvertMessageType(curPkg *ProtoPackage, msgDesc *d
 
 		// Look for required fields by the proto2 "required" flag:
 		if fieldDesc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REQUIRED && fieldDesc.OneofIndex == nil {
-			if c.Flags.UseJSONFieldnamesOnly {
-				jsonSchemaType.Required = append(jsonSchemaType.Required, fieldDesc.GetJsonName())
-			} else {
-				jsonSchemaType.Required = append(jsonSchemaType.Required, fieldDesc.GetName())
-			}
+			jsonSchemaType.Required = append(jsonSchemaType.Required, c.fieldNames(fieldDesc)[0])
+		}
+	}
+
+	// Each OneOf needs exactly one of its fields:
+	enforceOneofs(jsonSchemaType, oneofs)
+
+	// Reject objects containing any banned fields:
+	if len(bannedFields) > 0 {
+		jsonSchemaType.Not = &jsonschema.Type{
+			Type:  gojsonschema.TYPE_OBJECT,
+			AnyOf: bannedFields,
 		}
 	}
 
@@ -653,12 +996,83 @@ func (c *Converter) recursiveConvertMessageType(curPkg *ProtoPackage, msgDesc *d
 		jsonSchemaType.Properties = nil
 	}
 
-	// Dedupe required fields:
+	// Dedupe required fields (optionally putting them in field number order):
 	jsonSchemaType.Required = dedupe(jsonSchemaType.Required)
+	if c.Flags.RequiredByFieldNumber {
+		c.sortByFieldNumber(msgDesc, jsonSchemaType.Required)
+	}
 
 	return jsonSchemaType, nil
 }
 
+// decimalPattern builds a pattern matching decimal strings with at most the given precision (total digits) and scale (fractional digits):
+func decimalPattern(precision, scale int32) string {
+	integerDigits := "0"
+	if precision > scale {
+		integerDigits = fmt.Sprintf(`\d{1,%d}`, precision-scale)
+	}
+	if scale <= 0 {
+		return fmt.Sprintf(`^-?%s$`, integerDigits)
+	}
+	return fmt.Sprintf(`^-?%s(\.\d{1,%d})?$`, integerDigits, scale)
+}
+
+// excludedField tells us whether a field should be left out of generated schemas (by its stability, visibility or number,
+// although the key and value of map entries are never left out):
+func (c *Converter) excludedField(msgDesc *descriptor.DescriptorProto, fieldDesc *descriptor.FieldDescriptorProto) bool {
+	if c.excludedAsAlpha(c.fieldStability(fieldDesc)) || !c.visible(fieldDesc.GetOptions()) {
+		return true
+	}
+	return !msgDesc.GetOptions().GetMapEntry() && c.excludedFieldNumber(fieldDesc.GetNumber())
+}
+
+// excludedMessage tells us whether a message should be left out of generated schemas (by its stability, visibility or deprecation):
+func (c *Converter) excludedMessage(msgDesc *descriptor.DescriptorProto) bool {
+	return c.excludedAsAlpha(c.messageStability(msgDesc)) || !c.visible(msgDesc.GetOptions()) || c.skippedAsDeprecated(msgDesc.GetOptions().GetDeprecated())
+}
+
+// fieldNames returns the property name(s) a field will appear under (depending on our naming strategy, unless the field
+// names its property itself):
+func (c *Converter) fieldNames(fieldDesc *descriptor.FieldDescriptorProto) []string {
+	if propertyName := fieldPropertyName(fieldDesc); propertyName != "" {
+		return []string{propertyName}
+	}
+
+	var names []string
+	for _, name := range c.naming().PropertyNames(fieldDesc) {
+		names = append(names, c.renamedProperty(name))
+	}
+	return dedupe(names)
+}
+
+// fieldPropertyName returns the property name a field has been given with the property_name option (if it has one):
+func fieldPropertyName(fieldDesc *descriptor.FieldDescriptorProto) string {
+	opt := proto.GetExtension(fieldDesc.GetOptions(), protoc_gen_jsonschema.E_FieldOptions)
+	if fieldOptions, ok := opt.(*protoc_gen_jsonschema.FieldOptions); ok {
+		return fieldOptions.GetPropertyName()
+	}
+	return ""
+}
+
+// sortByFieldNumber puts property names into the order of their field numbers (anything else stays at the end):
+func (c *Converter) sortByFieldNumber(msgDesc *descriptor.DescriptorProto, propertyNames []string) {
+	fieldNumbers := make(map[string]int32)
+	for _, fieldDesc := range msgDesc.GetField() {
+		for _, propertyName := range c.fieldNames(fieldDesc) {
+			fieldNumbers[propertyName] = fieldDesc.GetNumber()
+		}
+	}
+
+	sort.SliceStable(propertyNames, func(i, j int) bool {
+		iNumber, iOK := fieldNumbers[propertyNames[i]]
+		jNumber, jOK := fieldNumbers[propertyNames[j]]
+		if iOK && jOK {
+			return iNumber < jNumber
+		}
+		return iOK && !jOK
+	})
+}
+
 func dedupe(inputStrings []string) []string {
 	appended := make(map[string]bool)
 	outputStrings := []string{}
@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"github.com/alecthomas/jsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	unknownFieldsDiscard       = "discard" // protojson.UnmarshalOptions{DiscardUnknown: true}
+	unknownFieldsReject        = "reject"  // protojson.UnmarshalOptions{} (which fails on unknown fields)
+	unknownFieldsPolicyKeyword = "x-unknown-fields-policy"
+)
+
+// applyUnknownFieldsPolicy makes a message's additional properties mirror how the protojson consumers of its schema treat
+// unknown fields (which takes precedence over flags and message options, since the schema can't be any stricter or looser
+// than the consumers are):
+func (c *Converter) applyUnknownFieldsPolicy(messageFlags *ConverterFlags) {
+	switch c.unknownFieldsPolicy {
+	case unknownFieldsDiscard:
+		messageFlags.DisallowAdditionalProperties = false
+	case unknownFieldsReject:
+		messageFlags.DisallowAdditionalProperties = true
+	}
+}
+
+// stampUnknownFieldsPolicy records the unknown fields policy on a message schema, so that producers and consumers can
+// check that they agree:
+func (c *Converter) stampUnknownFieldsPolicy(msgDesc *descriptor.DescriptorProto, jsonSchemaType *jsonschema.Type) {
+	if c.unknownFieldsPolicy == "" || msgDesc.GetOptions().GetMapEntry() {
+		return
+	}
+	setExtras(jsonSchemaType, map[string]interface{}{unknownFieldsPolicyKeyword: c.unknownFieldsPolicy})
+}
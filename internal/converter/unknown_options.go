@@ -0,0 +1,181 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alecthomas/jsonschema"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const unknownOptionPrefix = "x-proto-option-"
+
+// newExtensionResolver builds a registry of every extension defined in the proto files we've been given,
+// which allows us to decode custom options that this plugin doesn't know about:
+func newExtensionResolver(fileDescs []*descriptor.FileDescriptorProto) (*protoregistry.Types, error) {
+	files, err := protodesc.NewFiles(&descriptor.FileDescriptorSet{File: fileDescs})
+	if err != nil {
+		return nil, err
+	}
+
+	types := new(protoregistry.Types)
+	var registerErr error
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		registerErr = registerExtensions(types, file.Extensions(), file.Messages())
+		return registerErr == nil
+	})
+
+	return types, registerErr
+}
+
+// registerExtensions registers extensions (including those nested within messages) as dynamic types:
+func registerExtensions(types *protoregistry.Types, extensions protoreflect.ExtensionDescriptors, messages protoreflect.MessageDescriptors) error {
+	for i := 0; i < extensions.Len(); i++ {
+		if err := types.RegisterExtension(dynamicpb.NewExtensionType(extensions.Get(i))); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < messages.Len(); i++ {
+		if err := registerExtensions(types, messages.Get(i).Extensions(), messages.Get(i).Messages()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unknownOptions returns any options which were not recognised (keyed "x-proto-option-<extension number>"),
+// decoded to JSON if we can resolve the extension, or as their raw wire values if we can't:
+func (c *Converter) unknownOptions(options proto.Message) map[string]interface{} {
+	if options == nil || !options.ProtoReflect().IsValid() {
+		return nil
+	}
+
+	unknownFields := options.ProtoReflect().GetUnknown()
+	if len(unknownFields) == 0 {
+		return nil
+	}
+
+	// Group the unknown fields by number (repeated options may be spread across several entries):
+	var numbers []protowire.Number
+	fieldBytesByNumber := make(map[protowire.Number][]byte)
+	rawValuesByNumber := make(map[protowire.Number]interface{})
+	for len(unknownFields) > 0 {
+		number, wireType, tagLength := protowire.ConsumeTag(unknownFields)
+		if tagLength < 0 {
+			break
+		}
+		fieldLength := protowire.ConsumeFieldValue(number, wireType, unknownFields[tagLength:])
+		if fieldLength < 0 {
+			break
+		}
+		if _, ok := fieldBytesByNumber[number]; !ok {
+			numbers = append(numbers, number)
+		}
+		fieldBytesByNumber[number] = append(fieldBytesByNumber[number], unknownFields[:tagLength+fieldLength]...)
+		if value, ok := rawWireValue(wireType, unknownFields[tagLength:tagLength+fieldLength]); ok {
+			rawValuesByNumber[number] = value
+		}
+		unknownFields = unknownFields[tagLength+fieldLength:]
+	}
+
+	extras := make(map[string]interface{})
+	for _, number := range numbers {
+		key := fmt.Sprintf("%s%d", unknownOptionPrefix, number)
+		if value, ok := c.resolveUnknownOption(options, number, fieldBytesByNumber[number]); ok {
+			extras[key] = value
+		} else if value, ok := rawValuesByNumber[number]; ok {
+			extras[key] = value
+		}
+	}
+
+	return extras
+}
+
+// resolveUnknownOption decodes an option using the extensions found in the request:
+func (c *Converter) resolveUnknownOption(options proto.Message, number protowire.Number, fieldBytes []byte) (interface{}, bool) {
+	if c.extensionTypes == nil {
+		return nil, false
+	}
+
+	extensionType, err := c.extensionTypes.FindExtensionByNumber(options.ProtoReflect().Descriptor().FullName(), number)
+	if err != nil {
+		return nil, false
+	}
+
+	decoded := options.ProtoReflect().New().Interface()
+	if err := (proto.UnmarshalOptions{Resolver: c.extensionTypes}).Unmarshal(fieldBytes, decoded); err != nil {
+		c.logger.WithError(err).WithField("extension", extensionType.TypeDescriptor().FullName()).Debug("Unable to decode option")
+		return nil, false
+	}
+
+	fieldDescriptor := extensionType.TypeDescriptor()
+	value := decoded.ProtoReflect().Get(fieldDescriptor)
+	if fieldDescriptor.IsList() {
+		var values []interface{}
+		for i := 0; i < value.List().Len(); i++ {
+			values = append(values, protoValueToJSON(fieldDescriptor, value.List().Get(i)))
+		}
+		return values, true
+	}
+
+	return protoValueToJSON(fieldDescriptor, value), true
+}
+
+// protoValueToJSON converts a single (non-list) proto value into something which will marshal to sensible JSON:
+func protoValueToJSON(fieldDescriptor protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	switch fieldDescriptor.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		messageJSON, err := protojson.Marshal(value.Message().Interface())
+		if err != nil {
+			return nil
+		}
+		return json.RawMessage(messageJSON)
+	case protoreflect.EnumKind:
+		if enumValue := fieldDescriptor.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return int32(value.Enum())
+	default:
+		return value.Interface()
+	}
+}
+
+// rawWireValue returns the raw value of an option we can't decode:
+func rawWireValue(wireType protowire.Type, valueBytes []byte) (interface{}, bool) {
+	switch wireType {
+	case protowire.VarintType:
+		value, n := protowire.ConsumeVarint(valueBytes)
+		return value, n >= 0
+	case protowire.Fixed32Type:
+		value, n := protowire.ConsumeFixed32(valueBytes)
+		return value, n >= 0
+	case protowire.Fixed64Type:
+		value, n := protowire.ConsumeFixed64(valueBytes)
+		return value, n >= 0
+	case protowire.BytesType:
+		value, n := protowire.ConsumeBytes(valueBytes)
+		return value, n >= 0
+	default:
+		return nil, false
+	}
+}
+
+// setExtras adds extra (non-standard) keywords to a schema type:
+func setExtras(jsonSchemaType *jsonschema.Type, extras map[string]interface{}) {
+	if len(extras) == 0 {
+		return
+	}
+	if jsonSchemaType.Extras == nil {
+		jsonSchemaType.Extras = make(map[string]interface{})
+	}
+	for key, value := range extras {
+		jsonSchemaType.Extras[key] = value
+	}
+}
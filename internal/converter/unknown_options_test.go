@@ -0,0 +1,25 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestUnresolvableUnknownOptions(t *testing.T) {
+
+	// Options which can't be resolved from the request (see the UnknownOptions sample for those which can) are left as raw values:
+	var unknownOptions []byte
+	unknownOptions = protowire.AppendTag(unknownOptions, 50002, protowire.VarintType)
+	unknownOptions = protowire.AppendVarint(unknownOptions, 7)
+	unknownOptions = protowire.AppendTag(unknownOptions, 50004, protowire.BytesType)
+	unknownOptions = protowire.AppendString(unknownOptions, "team-a")
+	fieldOptions := &descriptor.FieldOptions{}
+	fieldOptions.ProtoReflect().SetUnknown(unknownOptions)
+
+	protoConverter := New(newTestLogger())
+	assert.Equal(t, map[string]interface{}{"x-proto-option-50002": uint64(7), "x-proto-option-50004": []byte("team-a")}, protoConverter.unknownOptions(fieldOptions))
+	assert.Nil(t, protoConverter.unknownOptions(&descriptor.FieldOptions{}))
+}
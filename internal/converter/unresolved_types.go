@@ -0,0 +1,23 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/xeipuuv/gojsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const unresolvedTypeKeyword = "x-unresolved-type"
+
+// unresolvedFieldType stands in for a field whose type isn't in the request (eg when imports were left out), with the
+// lenient_lookup parameter. Anything is allowed in its place, and it carries the name of the type it should have been:
+func (c *Converter) unresolvedFieldType(desc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type) *jsonschema.Type {
+	c.logger.WithField("field_name", desc.GetName()).WithField("type_name", desc.GetTypeName()).Warn("Unable to find the type of a field - allowing anything in its place")
+	setExtras(jsonSchemaType, map[string]interface{}{unresolvedTypeKeyword: strings.TrimPrefix(desc.GetTypeName(), ".")})
+
+	if desc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return &jsonschema.Type{Type: gojsonschema.TYPE_ARRAY, Items: jsonSchemaType}
+	}
+	return jsonSchemaType
+}
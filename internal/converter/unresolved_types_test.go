@@ -0,0 +1,15 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnresolvedTypesWithoutLenientLookup(t *testing.T) {
+
+	// Types from an import which protoc wasn't given (the first file read) can't be looked up unless lenient_lookup is set:
+	fileDescriptorSet := mustReadProtoFiles(t, sampleProtoDirectory, "UnresolvedTypes.proto")
+	_, err := convertTestRequest(fileRequest("UnresolvedTypes.proto", "", fileDescriptorSet.GetFile()[1:]...))
+	assert.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package converter
+
+import (
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+const defaultUploadOrderFileName = "upload_order.json"
+
+// dependencyOrder puts generated files into an order where every schema comes after the schemas it refers to (eg the
+// shared schema), keeping them in the order they were generated otherwise. Registries which validate references can then
+// take them in a single pass:
+func (c *Converter) dependencyOrder(files []*plugin.CodeGeneratorResponse_File) []*plugin.CodeGeneratorResponse_File {
+	generated := make(map[string]bool)
+	for _, file := range files {
+		generated[file.GetName()] = true
+	}
+
+	// Work out which of the other files each one refers to:
+	dependencies := make(map[string][]string)
+	for _, file := range files {
+		for _, refFileName := range schemaFileRefs(file.GetContent()) {
+			if refFileName = path.Join(path.Dir(file.GetName()), refFileName); generated[refFileName] && refFileName != file.GetName() {
+				dependencies[file.GetName()] = append(dependencies[file.GetName()], refFileName)
+			}
+		}
+	}
+
+	// Depth-first, so dependencies go ahead of the files which need them:
+	filesByName := make(map[string]*plugin.CodeGeneratorResponse_File)
+	for _, file := range files {
+		filesByName[file.GetName()] = file
+	}
+	ordered := make([]*plugin.CodeGeneratorResponse_File, 0, len(files))
+	visited := make(map[string]bool)
+	var visit func(fileName string)
+	visit = func(fileName string) {
+		if visited[fileName] {
+			return // Already ordered (or a cycle, which no order can satisfy)
+		}
+		visited[fileName] = true
+		for _, dependency := range dependencies[fileName] {
+			visit(dependency)
+		}
+		ordered = append(ordered, filesByName[fileName])
+	}
+	for _, file := range files {
+		visit(file.GetName())
+	}
+	return ordered
+}
+
+// uploadOrderFile lists the generated schemas in dependency order:
+func (c *Converter) uploadOrderFile(files []*plugin.CodeGeneratorResponse_File) (*plugin.CodeGeneratorResponse_File, error) {
+	fileNames := []string{}
+	for _, file := range c.dependencyOrder(files) {
+		fileNames = append(fileNames, file.GetName())
+	}
+	uploadOrderJSON, err := json.MarshalIndent(fileNames, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	if uploadOrderJSON, err = c.generatedJSON(uploadOrderJSON); err != nil {
+		return nil, err
+	}
+	return &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(defaultUploadOrderFileName),
+		Content: proto.String(string(uploadOrderJSON)),
+	}, nil
+}
+
+// schemaFileRefs finds the files a schema refers to (with references such as "common.json#/definitions/Money"), in name order:
+func schemaFileRefs(content string) []string {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(content), &decoded); err != nil {
+		return nil
+	}
+
+	var refFileNames []string
+	seen := make(map[string]bool)
+	var find func(value interface{})
+	find = func(value interface{}) {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			if ref, ok := value["$ref"].(string); ok {
+				refFileName := strings.SplitN(ref, "#", 2)[0]
+				if refFileName != "" && !strings.Contains(refFileName, "://") && !seen[refFileName] {
+					seen[refFileName] = true
+					refFileNames = append(refFileNames, refFileName)
+				}
+			}
+			for _, nested := range value {
+				find(nested)
+			}
+		case []interface{}:
+			for _, nested := range value {
+				find(nested)
+			}
+		}
+	}
+	find(decoded)
+	sort.Strings(refFileNames)
+	return refFileNames
+}
@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	plugin "google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestDependencyOrder(t *testing.T) {
+
+	schemaFile := func(name, content string) *plugin.CodeGeneratorResponse_File {
+		return &plugin.CodeGeneratorResponse_File{Name: proto.String(name), Content: proto.String(content)}
+	}
+	files := []*plugin.CodeGeneratorResponse_File{
+		schemaFile("v1/Order.json", `{"properties": {"header": {"$ref": "../common.json#/definitions/acme.RequestHeader"}, "lines": {"items": {"$ref": "Line.json"}}}}`),
+		schemaFile("v1/Line.json", `{"properties": {"sku": {"type": "string"}, "self": {"$ref": "#/definitions/Line"}}}`),
+		schemaFile("Customer.json", `{"properties": {"address": {"$ref": "https://schemas.acme.com/Address.json"}}}`),
+		schemaFile("common.json", `{"definitions": {"acme.RequestHeader": {"type": "object"}}}`),
+	}
+
+	// Dependencies (relative to the file) come first, and everything else stays where it was:
+	uploadOrderFile, err := New(newTestLogger()).uploadOrderFile(files)
+	require.NoError(t, err)
+	assert.Equal(t, defaultUploadOrderFileName, uploadOrderFile.GetName())
+	var fileNames []string
+	require.NoError(t, json.Unmarshal([]byte(uploadOrderFile.GetContent()), &fileNames))
+	assert.Equal(t, []string{"common.json", "v1/Line.json", "v1/Order.json", "Customer.json"}, fileNames)
+}
@@ -0,0 +1,93 @@
+package converter
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Parameter describes a generator parameter (eg "allow_null_values" or "max_depth=<depth>"):
+type Parameter struct {
+	Name  string
+	Usage string
+}
+
+// valueParameters are the generator parameters which take values (or otherwise aren't ConverterFlags):
+var valueParameters = []Parameter{
+	{Name: "bundle=<file|package>", Usage: "Generate one schema per proto file or per package instead of one per message"},
+	{Name: "cache_file=<path>", Usage: "Re-use previously generated schemas for unchanged proto files"},
+	{Name: "collection=<postman|insomnia>", Usage: "Package the services of each file into a client collection, with example request bodies"},
+	{Name: "config_file=<path>", Usage: "Load a JSON config file"},
+	{Name: "debug", Usage: "Enable debug logging (the same as log_level=debug)"},
+	{Name: "docs_precedence=<options|comments|concatenate>", Usage: "Which title and description wins when a field has them in both comments and options (the loser is kept under an x- keyword)"},
+	{Name: "envelope=<message>", Usage: "Wrap every message schema in an envelope message, in place of its payload field"},
+	{Name: "envelope_payload_field=<field>", Usage: "The envelope field to replace with each message (defaults to payload)"},
+	{Name: "exclude_field_numbers=<from>-<to>+...", Usage: "Leave fields numbered within these ranges out of schemas"},
+	{Name: "file_extension=<extension>", Usage: "Specify a custom file extension for generated schemas"},
+	{Name: "id_template=<template>", Usage: "A template for the IDs of schemas (eg https://schemas.acme.com/{package}/{message}.json)"},
+	{Name: "list_style=<array|paginated>", Usage: "The style of list schemas (see generate_list_schemas)"},
+	{Name: "locale=<language>", Usage: "Pick one language from comments written in several"},
+	{Name: "log_file=<path>", Usage: "Send all logging to a file instead of STDERR"},
+	{Name: "log_level=<level>", Usage: "How much to log (trace, debug, info, warn or error), defaults to warn"},
+	{Name: "max_depth=<depth>", Usage: "Truncate messages nested (inlined or referenced) more deeply than this (defaults to 100, 0 for no limit)"},
+	{Name: "messages=[<message>+...]", Usage: "Only generate schemas for these messages"},
+	{Name: "output_dir=<dir>", Usage: "Write generated schemas straight into a directory (atomically, resuming interrupted runs) instead of through protoc"},
+	{Name: "post_process_cmd=<command>", Usage: "Pipe each generated schema through a command"},
+	{Name: "preset=<ajv-strict|fastify|legacy|legacy_output|openapi3|protojson-faithful>", Usage: "Turn on a coherent set of parameters for the ecosystem schemas are used in"},
+	{Name: "publish_batch_size=<n>", Usage: "Upload this many schemas at a time, in parallel"},
+	{Name: "publish_content_addressed", Usage: "Publish schemas under a path containing the SHA-256 digest of their content"},
+	{Name: "publish_rate=<n>", Usage: "Start at most this many uploads per second"},
+	{Name: "publish_retries=<n>", Usage: "Retry failed uploads this many times, with exponential backoff"},
+	{Name: "publish_state_file=<file>", Usage: "Record what has been published, so an interrupted publish can be resumed"},
+	{Name: "publish_url=<url>", Usage: "Publish generated schemas to an https://, s3:// or gs:// target after generation"},
+	{Name: "publish_version=<version>", Usage: "Publish schemas under a versioned path"},
+	{Name: "root=<message>", Usage: "Only generate a schema for this message, with the other messages of its file as definitions"},
+	{Name: "route=<packages>=<dir>[;<flag>...]", Usage: "Route schemas from matching packages into a different output directory"},
+	{Name: "rules_file=<path>", Usage: "Load a JSON rules file of schema fragments for fields following naming conventions"},
+	{Name: "schema_id_base=<url>", Usage: "A base URL to build IDs for versioned schemas from"},
+	{Name: "schema_version=<version>", Usage: "Target a specific JSON-Schema draft (draft-04, draft-06, draft-07, 2019-09, 2020-12 or openapi3)"},
+	{Name: "shared_messages=<message>+...", Usage: "Hoist these messages into a shared schema, which every usage references"},
+	{Name: "shared_schema_file=<name>", Usage: "The name of the shared schema file (defaults to common.json)"},
+	{Name: "signing_key=<path>", Usage: "Sign the SHA256SUMS file with an (unencrypted) PEM-encoded ECDSA P-256 or Ed25519 key, as SHA256SUMS.sig"},
+	{Name: "source_revision=<revision>", Usage: "Stamp every schema with the source revision it was generated from"},
+	{Name: "split_threshold=<properties>", Usage: "Split messages with more than this many properties into subschemas composed with allOf"},
+	{Name: "template_var=<name>=<value>", Usage: "A variable for id_template and title_template (can be given more than once)"},
+	{Name: "title_template=<template>", Usage: "A template for the titles of schemas"},
+	{Name: "type_cache_size=<entries>", Usage: "How many resolved type names to remember (defaults to 65536, 0 turns caching off)"},
+	{Name: "unknown_fields=<discard|reject>", Usage: "Allow (or reject) additional properties to mirror how protojson consumers treat unknown fields, recorded as x-unknown-fields-policy"},
+	{Name: "visibility_labels=<label>+...", Usage: "Include fields and messages restricted to these visibility labels"},
+}
+
+// Parameters lists every generator parameter (the flags of ConverterFlags, and those which take values), in name order:
+func Parameters() []Parameter {
+	parameters := append([]Parameter{}, valueParameters...)
+	flagsType := reflect.TypeOf(ConverterFlags{})
+	for name, field := range flagFields {
+		parameters = append(parameters, Parameter{Name: name, Usage: flagsType.Field(field).Tag.Get("usage")})
+	}
+	sort.Slice(parameters, func(i, j int) bool {
+		return parameters[i].Name < parameters[j].Name
+	})
+	return parameters
+}
+
+// flagFields maps the names of the ConverterFlags which can be set by name (those with a parameter tag) to their fields:
+var flagFields = func() map[string]int {
+	fields := make(map[string]int)
+	flagsType := reflect.TypeOf(ConverterFlags{})
+	for i := 0; i < flagsType.NumField(); i++ {
+		if name, ok := flagsType.Field(i).Tag.Lookup("parameter"); ok {
+			fields[name] = i
+		}
+	}
+	return fields
+}()
+
+// taggedFlagNames lists the names of the ConverterFlags which can be set by name, in name order:
+func taggedFlagNames() []string {
+	names := make([]string, 0, len(flagFields))
+	for name := range flagFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
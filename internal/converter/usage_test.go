@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParameters(t *testing.T) {
+	parameters := Parameters()
+	assert.Len(t, parameters, len(flagNames)+len(valueParameters))
+
+	// Every parameter is described, and they're listed in name order:
+	assert.True(t, sort.SliceIsSorted(parameters, func(i, j int) bool { return parameters[i].Name < parameters[j].Name }))
+	for _, parameter := range parameters {
+		assert.NotEmpty(t, parameter.Usage, parameter.Name)
+	}
+	assert.Contains(t, parameters, Parameter{Name: "json_fieldnames", Usage: "Use JSON field names only"})
+}
+
+func TestFlagParameters(t *testing.T) {
+
+	// Every tagged flag is turned on (and nothing else) by its parameter, which the usage text lists:
+	for _, name := range flagNames {
+		protoConverter := New(newTestLogger())
+		protoConverter.parseGeneratorParameters(name)
+		var expectedFlags ConverterFlags
+		assert.True(t, expectedFlags.set(name, true), name)
+		assert.Equal(t, expectedFlags, protoConverter.Flags, name)
+		assert.Contains(t, Parameters(), Parameter{Name: name, Usage: reflect.TypeOf(ConverterFlags{}).Field(flagFields[name]).Tag.Get("usage")})
+	}
+
+	// Names which aren't flags don't set anything:
+	var flags ConverterFlags
+	assert.False(t, flags.set("no_such_flag", true))
+	assert.Equal(t, ConverterFlags{}, flags)
+}
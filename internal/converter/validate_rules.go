@@ -0,0 +1,111 @@
+package converter
+
+import (
+	protoc_gen_validate "github.com/envoyproxy/protoc-gen-validate/validate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// numericRuleTypes are the protoc-gen-validate rules for numeric fields, which all have the same fields (const, lt, lte, gt, gte, in):
+var numericRuleTypes = map[protoreflect.Name]bool{
+	"double":   true,
+	"fixed32":  true,
+	"fixed64":  true,
+	"float":    true,
+	"int32":    true,
+	"int64":    true,
+	"sfixed32": true,
+	"sfixed64": true,
+	"sint32":   true,
+	"sint64":   true,
+	"uint32":   true,
+	"uint64":   true,
+}
+
+// numericRules translates protoc-gen-validate rules for a numeric field (eg `[(validate.rules).int32 = {gte: 1, lt: 100}]`)
+// into JSON-Schema keywords. Values are kept as extras, so that fractional bounds aren't truncated:
+func (c *Converter) numericRules(desc *descriptor.FieldDescriptorProto) map[string]interface{} {
+	fieldRules, ok := proto.GetExtension(desc.GetOptions(), protoc_gen_validate.E_Rules).(*protoc_gen_validate.FieldRules)
+	if !ok || fieldRules == nil {
+		return nil
+	}
+	fieldRulesMessage := fieldRules.ProtoReflect()
+	typeField := fieldRulesMessage.WhichOneof(fieldRulesMessage.Descriptor().Oneofs().ByName("type"))
+	if typeField == nil || !numericRuleTypes[typeField.Name()] {
+		return nil
+	}
+	rules := fieldRulesMessage.Get(typeField).Message()
+	rule := func(name protoreflect.Name) (interface{}, bool) {
+		field := rules.Descriptor().Fields().ByName(name)
+		if field == nil || !rules.Has(field) {
+			return nil, false
+		}
+		return rules.Get(field).Interface(), true
+	}
+
+	keywords := make(map[string]interface{})
+	if value, ok := rule("const"); ok {
+		keywords["const"] = value
+		c.schemaVersion = versionDraft06 // Const requires draft-06
+	}
+	if field := rules.Descriptor().Fields().ByName("in"); field != nil && rules.Has(field) {
+		var values []interface{}
+		for list, index := rules.Get(field).List(), 0; index < list.Len(); index++ {
+			values = append(values, list.Get(index).Interface())
+		}
+		keywords["enum"] = values
+	}
+
+	// Bounds (exclusive ones are numbers rather than flags, which requires draft-06):
+	lowerBound := make(map[string]interface{})
+	upperBound := make(map[string]interface{})
+	if value, ok := rule("gte"); ok {
+		lowerBound["minimum"] = value
+	}
+	if value, ok := rule("gt"); ok {
+		lowerBound["exclusiveMinimum"] = value
+		c.schemaVersion = versionDraft06
+	}
+	if value, ok := rule("lte"); ok {
+		upperBound["maximum"] = value
+	}
+	if value, ok := rule("lt"); ok {
+		upperBound["exclusiveMaximum"] = value
+		c.schemaVersion = versionDraft06
+	}
+
+	// A lower bound above the upper bound means "outside of the range" (eg {gt: 10, lt: 5}), so either bound will do:
+	if len(lowerBound) > 0 && len(upperBound) > 0 && boundValue(lowerBound) > boundValue(upperBound) {
+		keywords["anyOf"] = []map[string]interface{}{lowerBound, upperBound}
+		return keywords
+	}
+	for keyword, value := range lowerBound {
+		keywords[keyword] = value
+	}
+	for keyword, value := range upperBound {
+		keywords[keyword] = value
+	}
+	return keywords
+}
+
+// boundValue returns the value of a (single) bound as a float, so that bounds of any numeric type can be compared:
+func boundValue(bound map[string]interface{}) float64 {
+	for _, value := range bound {
+		switch value := value.(type) {
+		case float32:
+			return float64(value)
+		case float64:
+			return value
+		case int32:
+			return float64(value)
+		case int64:
+			return float64(value)
+		case uint32:
+			return float64(value)
+		case uint64:
+			return float64(value)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/chrusty/protoc-gen-jsonschema/internal/converter/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumericRules(t *testing.T) {
+
+	// Each of these breaks exactly one numeric rule (the rest of the document is valid):
+	for _, document := range []string{
+		`{"percentageWithRangeConstraints": -1}`,
+		`{"percentageWithRangeConstraints": 101}`,
+		`{"ratioWithExclusiveRangeConstraints": 0}`,
+		`{"ratioWithExclusiveRangeConstraints": 1}`,
+		`{"answerWithConstConstraint": 41}`,
+		`{"offsetOutsideRangeConstraints": 5}`,
+		`{"ratingWithInConstraints": 3}`,
+	} {
+		valid, err := validateSchema(testdata.ValidationOptions, document)
+		require.NoError(t, err)
+		assert.False(t, valid, document)
+	}
+
+	// Values on (inclusive) bounds, and either side of an inverted range, are fine:
+	for _, document := range []string{
+		`{"percentageWithRangeConstraints": 0}`,
+		`{"offsetOutsideRangeConstraints": -1}`,
+		`{"offsetOutsideRangeConstraints": 11}`,
+		`{"ratingWithInConstraints": 5}`,
+	} {
+		valid, err := validateSchema(testdata.ValidationOptions, document)
+		require.NoError(t, err)
+		assert.True(t, valid, document)
+	}
+}
@@ -0,0 +1,64 @@
+package converter
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// The (google.api.*_visibility) options all share this extension number (see google/api/visibility.proto):
+	visibilityExtensionNumber protowire.Number = 72295727
+
+	// The "restriction" field of google.api.VisibilityRule:
+	visibilityRestrictionFieldNumber protowire.Number = 2
+)
+
+// visibilityRestriction returns the labels an element has been restricted to by a (google.api.*_visibility) option
+// (we don't link google's API protos, so these always arrive as unknown fields):
+func visibilityRestriction(options proto.Message) []string {
+	if options == nil || !options.ProtoReflect().IsValid() {
+		return nil
+	}
+
+	var labels []string
+	rangeBytesFields(options.ProtoReflect().GetUnknown(), func(number protowire.Number, rule []byte) {
+		if number == visibilityExtensionNumber {
+			labels = append(labels, visibilityRuleLabels(rule)...)
+		}
+	})
+
+	return labels
+}
+
+// visibilityRuleLabels decodes the comma-separated restriction labels from an encoded google.api.VisibilityRule:
+func visibilityRuleLabels(rule []byte) []string {
+	var labels []string
+	rangeBytesFields(rule, func(number protowire.Number, restriction []byte) {
+		if number != visibilityRestrictionFieldNumber {
+			return
+		}
+		for _, label := range strings.Split(string(restriction), ",") {
+			if label = strings.TrimSpace(label); label != "" {
+				labels = append(labels, label)
+			}
+		}
+	})
+	return labels
+}
+
+// visible tells us whether an element should be included, given the visibility labels we've been configured with
+// (unrestricted elements are always visible, restricted ones need at least one of their labels to be selected):
+func (c *Converter) visible(options proto.Message) bool {
+	labels := visibilityRestriction(options)
+	if len(labels) == 0 {
+		return true
+	}
+	for _, label := range labels {
+		if contains(c.visibilityLabels, label) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/iancoleman/orderedmap"
+	"github.com/xeipuuv/gojsonschema"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	anyTypeDescription  = "A URL identifying the type of the payload (eg \"type.googleapis.com/acme.Dog\"), whose fields accompany it."
+	wellKnownTypePrefix = ".google.protobuf."
+	wellKnownPackage    = ".google.protobuf"
+)
+
+// wellKnownTypes maps google's well-known types to their proto3 JSON representations
+// (see https://developers.google.com/protocol-buffers/docs/proto3#json), instead of converting them as ordinary messages:
+var wellKnownTypes = map[string]func(jsonSchemaType *jsonschema.Type, messageFlags ConverterFlags){
+	"Any":         convertAnyType,
+	"BoolValue":   scalarWellKnownType(gojsonschema.TYPE_BOOLEAN),
+	"BytesValue":  convertBytesWellKnownType,
+	"DoubleValue": faithfulWellKnownType(descriptor.FieldDescriptorProto_TYPE_DOUBLE, gojsonschema.TYPE_NUMBER, scalarWellKnownType(gojsonschema.TYPE_NUMBER)),
+	"Duration":    scalarWellKnownType(gojsonschema.TYPE_STRING),
+	"Empty":       scalarWellKnownType(gojsonschema.TYPE_OBJECT),
+	"FieldMask":   scalarWellKnownType(gojsonschema.TYPE_STRING),
+	"FloatValue":  faithfulWellKnownType(descriptor.FieldDescriptorProto_TYPE_FLOAT, gojsonschema.TYPE_NUMBER, scalarWellKnownType(gojsonschema.TYPE_NUMBER)),
+	"Int32Value":  faithfulWellKnownType(descriptor.FieldDescriptorProto_TYPE_INT32, gojsonschema.TYPE_INTEGER, scalarWellKnownType(gojsonschema.TYPE_INTEGER)),
+	"Int64Value":  faithfulWellKnownType(descriptor.FieldDescriptorProto_TYPE_INT64, gojsonschema.TYPE_INTEGER, convertBigIntWellKnownType),
+	"ListValue":   scalarWellKnownType(gojsonschema.TYPE_ARRAY),
+	"StringValue": scalarWellKnownType(gojsonschema.TYPE_STRING),
+	"Struct":      scalarWellKnownType(gojsonschema.TYPE_OBJECT),
+	"Timestamp":   convertTimestampType,
+	"UInt32Value": faithfulWellKnownType(descriptor.FieldDescriptorProto_TYPE_UINT32, gojsonschema.TYPE_INTEGER, scalarWellKnownType(gojsonschema.TYPE_INTEGER)),
+	"UInt64Value": faithfulWellKnownType(descriptor.FieldDescriptorProto_TYPE_UINT64, gojsonschema.TYPE_INTEGER, convertBigIntWellKnownType),
+	"Value":       convertValueType,
+}
+
+// legacyWellKnownTypes are the well-known types older releases followed the JSON mapping for (see
+// legacy_well_known_types), converting the rest as ordinary messages:
+var legacyWellKnownTypes = map[string]bool{
+	"BoolValue":   true,
+	"BytesValue":  true,
+	"DoubleValue": true,
+	"Duration":    true,
+	"FloatValue":  true,
+	"Int32Value":  true,
+	"Int64Value":  true,
+	"StringValue": true,
+	"Struct":      true,
+	"UInt32Value": true,
+	"UInt64Value": true,
+	"Value":       true,
+}
+
+// wrapperTypes are the well-known types which wrap a single scalar (and can be null, to tell "unset" from the default value):
+var wrapperTypes = map[string]bool{
+	"BoolValue":   true,
+	"BytesValue":  true,
+	"DoubleValue": true,
+	"FloatValue":  true,
+	"Int32Value":  true,
+	"Int64Value":  true,
+	"StringValue": true,
+	"UInt32Value": true,
+	"UInt64Value": true,
+}
+
+// wellKnownTypeName returns the (fully-qualified) name of a field's well-known type (eg ".google.protobuf.Timestamp"),
+// or "" if it isn't one (or if we're converting well-known types as ordinary messages):
+func (c *Converter) wellKnownTypeName(desc *descriptor.FieldDescriptorProto) string {
+	if c.Flags.WellKnownTypesAsMessages || !strings.HasPrefix(desc.GetTypeName(), wellKnownTypePrefix) {
+		return ""
+	}
+	return desc.GetTypeName()
+}
+
+// wellKnownTypeConverter returns the converter for a well-known type (by its name within google.protobuf), unless we're
+// converting it as an ordinary message:
+func (c *Converter) wellKnownTypeConverter(name string) (func(*jsonschema.Type, ConverterFlags), bool) {
+	convertWellKnownType, ok := wellKnownTypes[name]
+	if !ok || c.Flags.WellKnownTypesAsMessages || (c.Flags.LegacyWellKnownTypes && !legacyWellKnownTypes[name]) {
+		return nil, false
+	}
+	return convertWellKnownType, true
+}
+
+// isWrapperField tells us whether a field holds one of the wrapper types (eg google.protobuf.StringValue):
+func (c *Converter) isWrapperField(desc *descriptor.FieldDescriptorProto) bool {
+	return wrapperTypes[strings.TrimPrefix(c.wellKnownTypeName(desc), wellKnownTypePrefix)]
+}
+
+// scalarWellKnownType represents a well-known type with a single JSON type:
+func scalarWellKnownType(jsonType string) func(*jsonschema.Type, ConverterFlags) {
+	return func(jsonSchemaType *jsonschema.Type, _ ConverterFlags) {
+		jsonSchemaType.Type = jsonType
+	}
+}
+
+// convertBigIntWellKnownType represents 64-bit wrappers as strings (unless we're disallowing big integers as strings):
+func convertBigIntWellKnownType(jsonSchemaType *jsonschema.Type, messageFlags ConverterFlags) {
+	if messageFlags.DisallowBigIntsAsStrings {
+		jsonSchemaType.Type = gojsonschema.TYPE_INTEGER
+	} else {
+		jsonSchemaType.Type = gojsonschema.TYPE_STRING
+	}
+}
+
+// convertTimestampType represents google.protobuf.Timestamp as an RFC 3339 string:
+func convertTimestampType(jsonSchemaType *jsonschema.Type, _ ConverterFlags) {
+	jsonSchemaType.Type = gojsonschema.TYPE_STRING
+	jsonSchemaType.Format = "date-time"
+}
+
+// convertValueType represents google.protobuf.Value as any of the JSON types (including null, unless we've been asked not
+// to accept it):
+func convertValueType(jsonSchemaType *jsonschema.Type, messageFlags ConverterFlags) {
+	jsonSchemaType.OneOf = []*jsonschema.Type{
+		{Type: gojsonschema.TYPE_ARRAY},
+		{Type: gojsonschema.TYPE_BOOLEAN},
+		{Type: gojsonschema.TYPE_NULL},
+		{Type: gojsonschema.TYPE_NUMBER},
+		{Type: gojsonschema.TYPE_OBJECT},
+		{Type: gojsonschema.TYPE_STRING},
+	}
+	if messageFlags.NonNullableValues {
+		jsonSchemaType.OneOf = append(jsonSchemaType.OneOf[:2], jsonSchemaType.OneOf[3:]...)
+	}
+}
+
+// convertNullValueType lets google.protobuf.NullValue be null (which is how the JSON mapping represents it), as well as
+// its (ENUM) name and number:
+func convertNullValueType(jsonSchemaType *jsonschema.Type) {
+	jsonSchemaType.Enum = append(jsonSchemaType.Enum, nil)
+	for _, alternative := range jsonSchemaType.OneOf {
+		if alternative.Type == gojsonschema.TYPE_NULL {
+			return
+		}
+	}
+	switch {
+	case jsonSchemaType.OneOf != nil:
+		jsonSchemaType.OneOf = append([]*jsonschema.Type{{Type: gojsonschema.TYPE_NULL}}, jsonSchemaType.OneOf...)
+	case jsonSchemaType.Type != "":
+		jsonSchemaType.OneOf = []*jsonschema.Type{{Type: gojsonschema.TYPE_NULL}, {Type: jsonSchemaType.Type}}
+		jsonSchemaType.Type = ""
+	}
+}
+
+// convertAnyType represents google.protobuf.Any as an object identified by "@type" (alongside the fields of its payload):
+func convertAnyType(jsonSchemaType *jsonschema.Type, _ ConverterFlags) {
+	jsonSchemaType.Type = gojsonschema.TYPE_OBJECT
+	jsonSchemaType.Properties = orderedmap.New()
+	jsonSchemaType.Properties.Set(anyTypeProperty, &jsonschema.Type{Type: gojsonschema.TYPE_STRING, Description: anyTypeDescription})
+	setAdditionalProperties(jsonSchemaType, true)
+}
@@ -0,0 +1,520 @@
+// Package reverse builds best-effort .proto skeletons from JSON-Schemas (generated by protoc-gen-jsonschema, or edited by hand).
+// This helps to reconcile schemas which have drifted away from the protos they came from: the skeleton can be diffed against
+// the original proto, but it will always need a human to review it (field numbers in particular can't be recovered).
+package reverse
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/orderedmap"
+	"github.com/iancoleman/strcase"
+)
+
+const (
+	definitionsPrefix = "#/definitions/"
+	structTypeName    = "google.protobuf.Struct"
+	timestampTypeName = "google.protobuf.Timestamp"
+	valueTypeName     = "google.protobuf.Value"
+)
+
+// wellKnownImports are the files which define the well-known types a skeleton can use:
+var wellKnownImports = map[string]string{
+	structTypeName:    "google/protobuf/struct.proto",
+	timestampTypeName: "google/protobuf/timestamp.proto",
+	valueTypeName:     "google/protobuf/struct.proto",
+}
+
+// mapKeyTypes are the types of map keys which protoc-gen-jsonschema constrains with patterns:
+var mapKeyTypes = map[string]string{
+	"^(true|false)$": "bool",
+	"^-?[0-9]+$":     "int64",
+	"^[0-9]+$":       "uint64",
+}
+
+var (
+	identifierPattern    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	nonIdentifierPattern = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+)
+
+// Skeleton is a .proto file being reconstructed from JSON-Schemas:
+type Skeleton struct {
+	pkgName     string
+	definitions map[string]string
+	imports     map[string]bool
+	messages    []*message
+	enums       []*enum
+	names       map[string]bool
+}
+
+type message struct {
+	name        string
+	description string
+	fields      []*field
+}
+
+type field struct {
+	name        string
+	typeName    string
+	repeated    bool
+	required    bool
+	description string
+}
+
+type enum struct {
+	name        string
+	description string
+	values      []enumValue
+}
+
+type enumValue struct {
+	name   string
+	number int
+}
+
+// New returns an empty skeleton for the given proto package:
+func New(pkgName string) *Skeleton {
+	return &Skeleton{
+		pkgName:     pkgName,
+		definitions: make(map[string]string),
+		imports:     make(map[string]bool),
+		names:       make(map[string]bool),
+	}
+}
+
+// AddSchema adds the messages and enums described by a JSON-Schema to the skeleton.
+// Definitions become messages (or enums), and so does a root schema with properties of its own (named after its title, or the fallback name):
+func (s *Skeleton) AddSchema(fallbackName string, schemaJSON []byte) error {
+	root := orderedmap.New()
+	if err := json.Unmarshal(schemaJSON, root); err != nil {
+		return fmt.Errorf("unable to parse schema %s: %v", fallbackName, err)
+	}
+
+	// Name every definition first (so that references can be resolved in any order). Schemas generated for different
+	// messages often include the same definitions, so each one is only converted the first time we see it:
+	refNames := make(map[string]string)
+	var newDefinitions []string
+	definitions, _ := object(root, "definitions")
+	if definitions != nil {
+		for _, definitionName := range definitions.Keys() {
+			if isAlias(definitions, definitionName) {
+				continue
+			}
+			shortName := definitionName[strings.LastIndex(definitionName, ".")+1:]
+			if name, ok := s.definitions[shortName]; ok {
+				refNames[definitionName] = name
+				continue
+			}
+			refNames[definitionName] = s.uniqueName(shortName)
+			s.definitions[shortName] = refNames[definitionName]
+			newDefinitions = append(newDefinitions, definitionName)
+		}
+		for _, definitionName := range definitions.Keys() {
+			if isAlias(definitions, definitionName) {
+				definition, _ := object(definitions, definitionName)
+				refNames[definitionName] = refNames[strings.TrimPrefix(stringValue(definition, "$ref"), definitionsPrefix)]
+			}
+		}
+	}
+
+	// Now convert them:
+	for _, definitionName := range newDefinitions {
+		definition, _ := object(definitions, definitionName)
+		s.addDefinition(refNames[definitionName], definition, refNames)
+	}
+
+	// The root schema may describe a message of its own:
+	if properties, _ := object(root, "properties"); properties != nil {
+		shortName := strcase.ToCamel(stringValue(root, "title"))
+		if shortName == "" {
+			shortName = strcase.ToCamel(fallbackName)
+		}
+		if _, ok := s.definitions[shortName]; !ok {
+			s.definitions[shortName] = s.uniqueName(shortName)
+			s.addDefinition(s.definitions[shortName], root, refNames)
+		}
+	}
+
+	return nil
+}
+
+// addDefinition converts a (named) schema into an enum or a message:
+func (s *Skeleton) addDefinition(name string, definition *orderedmap.OrderedMap, refNames map[string]string) {
+	if isEnum(definition) {
+		s.addEnum(name, definition)
+		return
+	}
+	s.addMessage(name, definition, refNames)
+}
+
+// addMessage converts an object schema into a message (along with any messages and enums defined inline by its properties):
+func (s *Skeleton) addMessage(name string, definition *orderedmap.OrderedMap, refNames map[string]string) {
+	msg := &message{
+		name:        name,
+		description: stringValue(definition, "description"),
+	}
+	s.messages = append(s.messages, msg)
+
+	required := make(map[string]bool)
+	if requiredNames, ok := definition.Get("required"); ok {
+		if requiredNames, ok := requiredNames.([]interface{}); ok {
+			for _, requiredName := range requiredNames {
+				required[fmt.Sprint(requiredName)] = true
+			}
+		}
+	}
+
+	properties, _ := object(definition, "properties")
+	if properties == nil {
+		return
+	}
+	seen := make(map[string]bool)
+	for _, propertyName := range properties.Keys() {
+		property, _ := object(properties, propertyName)
+		if property == nil {
+			continue
+		}
+
+		// Schemas with both proto and JSON field names list every field twice:
+		fieldName := fieldName(propertyName)
+		if seen[fieldName] {
+			continue
+		}
+		seen[fieldName] = true
+
+		typeName, repeated := s.propertyType(name, fieldName, property, refNames)
+		msg.fields = append(msg.fields, &field{
+			name:        fieldName,
+			typeName:    typeName,
+			repeated:    repeated,
+			required:    required[propertyName],
+			description: stringValue(property, "description"),
+		})
+	}
+}
+
+// addEnum converts a schema with a list of allowed values into an enum:
+func (s *Skeleton) addEnum(name string, definition *orderedmap.OrderedMap) {
+	en := &enum{
+		name:        name,
+		description: stringValue(definition, "description"),
+	}
+	s.enums = append(s.enums, en)
+
+	// Values are listed as names (often followed by their numbers), or as a oneOf of constants:
+	var allowedValues []interface{}
+	if values, ok := definition.Get("enum"); ok {
+		allowedValues, _ = values.([]interface{})
+	}
+	if alternatives, ok := definition.Get("oneOf"); ok && len(allowedValues) == 0 {
+		for _, alternative := range alternatives.([]interface{}) {
+			if alternative, ok := toObject(alternative); ok {
+				if constant, ok := alternative.Get("const"); ok {
+					allowedValues = append(allowedValues, constant)
+				}
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	for index := 0; index < len(allowedValues); index++ {
+		var valueName string
+		number := len(en.values)
+		switch allowedValue := allowedValues[index].(type) {
+		case string:
+			valueName = strcase.ToScreamingSnake(allowedValue)
+			if index+1 < len(allowedValues) {
+				if nextValue, ok := allowedValues[index+1].(float64); ok {
+					number = int(nextValue)
+					index++ // This is the number of the name
+				}
+			}
+		case float64:
+			number = int(allowedValue)
+			valueName = fmt.Sprintf("%s_%d", strcase.ToScreamingSnake(name), number)
+		default:
+			continue
+		}
+		if seen[valueName] {
+			continue // Eg lowercase variants
+		}
+		seen[valueName] = true
+		en.values = append(en.values, enumValue{name: valueName, number: number})
+	}
+
+	// Proto3 enums have to start with zero:
+	sort.SliceStable(en.values, func(i, j int) bool { return en.values[i].number < en.values[j].number })
+}
+
+// propertyType works out the proto type of a property (and whether it is repeated), defining inline messages and enums as it goes:
+func (s *Skeleton) propertyType(messageName, fieldName string, property *orderedmap.OrderedMap, refNames map[string]string) (string, bool) {
+	if ref := stringValue(property, "$ref"); ref != "" {
+		if refName, ok := refNames[strings.TrimPrefix(ref, definitionsPrefix)]; ok {
+			return refName, false
+		}
+		return s.wellKnownType(valueTypeName), false
+	}
+
+	if isEnum(property) {
+		name, isNew := s.inlineName(messageName, fieldName, property)
+		if isNew {
+			s.addEnum(name, property)
+		}
+		return name, false
+	}
+
+	switch jsonType(property) {
+	case "array":
+		items, _ := object(property, "items")
+		if items == nil {
+			return s.wellKnownType(valueTypeName), true
+		}
+		itemType, nested := s.propertyType(messageName, fieldName, items, refNames)
+		if nested {
+			return s.wellKnownType(valueTypeName), true // Arrays of arrays can't be repeated fields
+		}
+		return itemType, true
+	case "boolean":
+		return "bool", false
+	case "integer":
+		return "int64", false
+	case "number":
+		return "double", false
+	case "object":
+		if properties, _ := object(property, "properties"); properties != nil {
+			name, isNew := s.inlineName(messageName, fieldName, property)
+			if isNew {
+				s.addMessage(name, property, refNames)
+			}
+			return name, false
+		}
+		if keyType, values := mapValues(property); values != nil {
+			valueType, repeated := s.propertyType(messageName, fieldName+"_value", values, refNames)
+			if repeated {
+				valueType = s.wellKnownType(valueTypeName)
+			}
+			return fmt.Sprintf("map<%s, %s>", keyType, valueType), false
+		}
+		return s.wellKnownType(structTypeName), false
+	case "string":
+		switch {
+		case stringValue(property, "format") == "date-time":
+			return s.wellKnownType(timestampTypeName), false
+		case stringValue(property, "contentEncoding") == "base64" || stringValue(property, "binaryEncoding") != "" || stringValue(property, "format") == "binary":
+			return "bytes", false
+		}
+		return "string", false
+	}
+
+	return s.wellKnownType(valueTypeName), false
+}
+
+// wellKnownType uses one of google's well-known types (importing the file which defines it):
+func (s *Skeleton) wellKnownType(typeName string) string {
+	s.imports[wellKnownImports[typeName]] = true
+	return typeName
+}
+
+// inlineName names a message or enum which a property defines inline: after its title if it has one (in which case
+// it may well have been seen before), otherwise after the message and field it belongs to:
+func (s *Skeleton) inlineName(messageName, fieldName string, property *orderedmap.OrderedMap) (string, bool) {
+	shortName := strcase.ToCamel(stringValue(property, "title"))
+	if shortName == "" {
+		return s.uniqueName(messageName + strcase.ToCamel(fieldName)), true
+	}
+	if name, ok := s.definitions[shortName]; ok {
+		return name, false
+	}
+	s.definitions[shortName] = s.uniqueName(shortName)
+	return s.definitions[shortName], true
+}
+
+// uniqueName makes sure that no two messages or enums get the same name:
+func (s *Skeleton) uniqueName(name string) string {
+	if name == "" || !identifierPattern.MatchString(name) {
+		name = strcase.ToCamel(nonIdentifierPattern.ReplaceAllString(name, "_"))
+	}
+	if name == "" {
+		name = "Message"
+	}
+	uniqueName := name
+	for suffix := 2; s.names[uniqueName]; suffix++ {
+		uniqueName = fmt.Sprintf("%s%d", name, suffix)
+	}
+	s.names[uniqueName] = true
+	return uniqueName
+}
+
+// Proto renders the skeleton as the content of a .proto file:
+func (s *Skeleton) Proto() []byte {
+	var proto strings.Builder
+
+	proto.WriteString("// Generated by protoc-gen-jsonschema (reverse) from JSON-Schemas: review before use, field numbers follow property order.\n")
+	proto.WriteString("syntax = \"proto3\";\n")
+	if s.pkgName != "" {
+		fmt.Fprintf(&proto, "\npackage %s;\n", s.pkgName)
+	}
+
+	// Imports:
+	var imports []string
+	for importName := range s.imports {
+		imports = append(imports, importName)
+	}
+	sort.Strings(imports)
+	if len(imports) > 0 {
+		proto.WriteString("\n")
+	}
+	for _, importName := range imports {
+		fmt.Fprintf(&proto, "import %q;\n", importName)
+	}
+
+	// Enums and messages, in alphabetical order:
+	sort.Slice(s.enums, func(i, j int) bool { return s.enums[i].name < s.enums[j].name })
+	sort.Slice(s.messages, func(i, j int) bool { return s.messages[i].name < s.messages[j].name })
+	for _, en := range s.enums {
+		proto.WriteString("\n")
+		writeComment(&proto, "", en.description)
+		fmt.Fprintf(&proto, "enum %s {\n", en.name)
+		if len(en.values) == 0 || en.values[0].number != 0 {
+			fmt.Fprintf(&proto, "  %s_UNSPECIFIED = 0;\n", strcase.ToScreamingSnake(en.name))
+		}
+		for _, value := range en.values {
+			fmt.Fprintf(&proto, "  %s = %d;\n", value.name, value.number)
+		}
+		proto.WriteString("}\n")
+	}
+	for _, msg := range s.messages {
+		proto.WriteString("\n")
+		writeComment(&proto, "", msg.description)
+		fmt.Fprintf(&proto, "message %s {\n", msg.name)
+		for number, field := range msg.fields {
+			writeComment(&proto, "  ", field.description)
+			label := ""
+			if field.repeated {
+				label = "repeated "
+			}
+			fmt.Fprintf(&proto, "  %s%s %s = %d;", label, field.typeName, field.name, number+1)
+			if field.required {
+				proto.WriteString(" // Required")
+			}
+			proto.WriteString("\n")
+		}
+		proto.WriteString("}\n")
+	}
+
+	return []byte(proto.String())
+}
+
+// writeComment writes a description as a (leading) comment:
+func writeComment(proto *strings.Builder, indent, description string) {
+	if description == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSpace(description), "\n") {
+		fmt.Fprintf(proto, "%s// %s\n", indent, strings.TrimSpace(line))
+	}
+}
+
+// fieldName turns a property name into a proto field name (properties generated from protos are usually fine as they are):
+func fieldName(propertyName string) string {
+	if identifierPattern.MatchString(propertyName) && strings.ToLower(propertyName) == propertyName {
+		return propertyName
+	}
+	return strcase.ToSnake(propertyName)
+}
+
+// isAlias tells us whether a definition only refers to another one (eg the fully-qualified names of shared messages):
+func isAlias(definitions *orderedmap.OrderedMap, definitionName string) bool {
+	definition, _ := object(definitions, definitionName)
+	return definition != nil && stringValue(definition, "$ref") != "" && len(definition.Keys()) == 1
+}
+
+// jsonType returns the (non-null) type of a schema, which may be given directly or as alternatives (eg a nullable field):
+func jsonType(schema *orderedmap.OrderedMap) string {
+	if typeName := stringValue(schema, "type"); typeName != "" {
+		return typeName
+	}
+	if alternatives, ok := schema.Get("oneOf"); ok {
+		if alternatives, ok := alternatives.([]interface{}); ok {
+			for _, alternative := range alternatives {
+				if alternative, ok := toObject(alternative); ok {
+					if typeName := stringValue(alternative, "type"); typeName != "" && typeName != "null" {
+						return typeName
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// isEnum tells us whether a schema only allows particular values (as an enum list, or as a oneOf of constants):
+func isEnum(schema *orderedmap.OrderedMap) bool {
+	if _, ok := schema.Get("enum"); ok {
+		return true
+	}
+	alternatives, ok := schema.Get("oneOf")
+	if !ok {
+		return false
+	}
+	alternativeList, ok := alternatives.([]interface{})
+	if !ok || len(alternativeList) == 0 {
+		return false
+	}
+	for _, alternative := range alternativeList {
+		alternative, ok := toObject(alternative)
+		if !ok {
+			return false
+		}
+		if _, ok := alternative.Get("const"); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// mapValues returns the type of the keys of a map, and the schema of its values (from additionalProperties, or a single pattern):
+func mapValues(schema *orderedmap.OrderedMap) (string, *orderedmap.OrderedMap) {
+	if values, _ := object(schema, "additionalProperties"); values != nil {
+		return "string", values
+	}
+	if patternProperties, _ := object(schema, "patternProperties"); patternProperties != nil && len(patternProperties.Keys()) == 1 {
+		keyPattern := patternProperties.Keys()[0]
+		values, _ := object(patternProperties, keyPattern)
+		if keyType, ok := mapKeyTypes[keyPattern]; ok {
+			return keyType, values
+		}
+		return "string", values
+	}
+	return "", nil
+}
+
+// object returns a keyword of a schema which is itself an object:
+func object(schema *orderedmap.OrderedMap, keyword string) (*orderedmap.OrderedMap, bool) {
+	value, ok := schema.Get(keyword)
+	if !ok {
+		return nil, false
+	}
+	return toObject(value)
+}
+
+// toObject copes with nested objects being decoded as values (rather than pointers):
+func toObject(value interface{}) (*orderedmap.OrderedMap, bool) {
+	switch value := value.(type) {
+	case orderedmap.OrderedMap:
+		return &value, true
+	case *orderedmap.OrderedMap:
+		return value, true
+	}
+	return nil, false
+}
+
+// stringValue returns a keyword of a schema which is a string (or "" if it isn't one):
+func stringValue(schema *orderedmap.OrderedMap, keyword string) string {
+	value, _ := schema.Get(keyword)
+	stringValue, _ := value.(string)
+	return stringValue
+}
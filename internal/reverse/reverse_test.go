@@ -0,0 +1,63 @@
+package reverse
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chrusty/protoc-gen-jsonschema/internal/converter/testdata"
+)
+
+func TestSkeleton(t *testing.T) {
+	skeleton := New("samples")
+
+	// Generated schemas (which share some of their definitions):
+	require.NoError(t, skeleton.AddSchema("Enumception", []byte(testdata.EnumCeption)))
+	require.NoError(t, skeleton.AddSchema("PayloadMessage", []byte(testdata.PayloadMessage)))
+	require.NoError(t, skeleton.AddSchema("MapKeys", []byte(testdata.MapKeys)))
+	require.NoError(t, skeleton.AddSchema("EnumsAsDefinitions", []byte(testdata.EnumsAsDefinitions)))
+
+	// A hand-written one (without a title):
+	require.NoError(t, skeleton.AddSchema("order-line", []byte(`{
+		"type": "object",
+		"required": ["sku"],
+		"properties": {
+			"sku": {"type": "string", "description": "Stock keeping unit"},
+			"orderedAt": {"type": "string", "format": "date-time"},
+			"quantity": {"oneOf": [{"type": "null"}, {"type": "integer"}]},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"delivery": {"type": "object", "properties": {"address": {"type": "string"}}},
+			"status": {"enum": ["pending", "shipped"]},
+			"extras": {"type": "object"}
+		}
+	}`)))
+
+	proto := string(skeleton.Proto())
+	assert.Contains(t, proto, "package samples;\n")
+	assert.Contains(t, proto, "import \"google/protobuf/struct.proto\";\nimport \"google/protobuf/timestamp.proto\";\n")
+
+	// Messages and enums are only defined once:
+	assert.Equal(t, 1, strings.Count(proto, "message PayloadMessage {"))
+	assert.Equal(t, 1, strings.Count(proto, "enum Topology {"))
+	assert.Contains(t, proto, "  repeated PayloadMessage payloads = 7;\n")
+	assert.Contains(t, proto, "  Topology topology = 6;\n")
+
+	// Enum values keep their numbers (and proto3 enums start at zero):
+	assert.Contains(t, proto, "// FailureModes enum\nenum FailureModes {\n  RECURSION_ERROR = 0;\n  SYNTAX_ERROR = 1;\n}\n")
+	assert.Contains(t, proto, "enum OrderLineStatus {\n  PENDING = 0;\n  SHIPPED = 1;\n}\n")
+
+	// Maps (with their key types), referenced enums, and fields named after JSON properties:
+	assert.Contains(t, proto, "  map<int64, string> map_of_int32_keys = 2;\n")
+	assert.Contains(t, proto, "  map<bool, string> map_of_bool_keys = 1;\n")
+	assert.Contains(t, proto, "  map<string, Status> statuses_by_region = 5;\n")
+	assert.Contains(t, proto, "  FailureModes failure_mode = 2;\n")
+
+	// Hand-written schemas get what we can work out:
+	assert.Contains(t, proto, "message OrderLine {\n  // Stock keeping unit\n  string sku = 1; // Required\n  google.protobuf.Timestamp ordered_at = 2;\n  int64 quantity = 3;\n  repeated string tags = 4;\n  OrderLineDelivery delivery = 5;\n  OrderLineStatus status = 6;\n  google.protobuf.Struct extras = 7;\n}\n")
+	assert.Contains(t, proto, "message OrderLineDelivery {\n  string address = 1;\n}\n")
+
+	// Anything which isn't JSON is an error:
+	assert.Error(t, skeleton.AddSchema("broken", []byte(`{"type": `)))
+}
@@ -0,0 +1,53 @@
+// Package converter exposes the protoc-gen-jsonschema conversion logic for use as a Go library.
+//
+// Example (generating a schema for a message compiled into your binary):
+//
+//	schemaJSON, err := converter.New(logrus.New()).ConvertMessage((&mypb.MyMessage{}).ProtoReflect().Descriptor())
+//
+// Schemas come back as JSON, rendered just as they would be in files (for whichever draft the "schema_version" parameter
+// targets). Single enums and fields can be converted too (with ConvertEnum and ConvertField), for tools which only need part of a message.
+//
+// Bespoke naming conventions can be followed by giving NewWithOptions a NamingStrategy (usually embedding one of the
+// built-in strategies, to only override some of the names).
+package converter
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/chrusty/protoc-gen-jsonschema/internal/converter"
+)
+
+// Converter converts protos to JSONSchemas:
+type Converter = converter.Converter
+
+// ConverterFlags control the behaviour of the converter:
+type ConverterFlags = converter.ConverterFlags
+
+// New returns a configured *Converter (defaulting to draft-04 version):
+func New(logger *logrus.Logger) *Converter {
+	return converter.New(logger)
+}
+
+// ConvertOptions configure a Converter made by NewWithOptions:
+type ConvertOptions = converter.ConvertOptions
+
+// NewWithOptions returns a *Converter configured with the given options (give each goroutine its own to convert concurrently):
+func NewWithOptions(logger *logrus.Logger, options ConvertOptions) *Converter {
+	return converter.NewWithOptions(logger, options)
+}
+
+// NamingStrategy decides what generated schemas (and the things in them) are called:
+type NamingStrategy = converter.NamingStrategy
+
+// The built-in naming strategies:
+var (
+	ProtoNaming           = converter.ProtoNaming
+	JSONNaming            = converter.JSONNaming
+	ProtoAndJSONNaming    = converter.ProtoAndJSONNaming
+	PackagePrefixedNaming = converter.PackagePrefixedNaming
+)
+
+// SlugDefinitions wraps a naming strategy so that its definition names are always safe to address by fragment:
+func SlugDefinitions(strategy NamingStrategy) NamingStrategy {
+	return converter.SlugDefinitions(strategy)
+}
@@ -0,0 +1,141 @@
+// Package jsonschemavalidate validates JSON payloads against schemas generated by protoc-gen-jsonschema.
+//
+// Generate your schemas with the "generate_index" parameter, then:
+//
+//	validator, err := jsonschemavalidate.Load("path/to/schemas")
+//	err = validator.Validate("samples.PayloadMessage", payload)
+package jsonschemavalidate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// IndexFileName is the name of the index generated by protoc-gen-jsonschema (with the "generate_index" parameter):
+const IndexFileName = "index.json"
+
+// supportedVersions are the drafts which gojsonschema can validate against (by $schema, without a trailing "#"):
+var supportedVersions = map[string]bool{
+	"http://json-schema.org/draft-04/schema": true,
+	"http://json-schema.org/draft-06/schema": true,
+	"http://json-schema.org/draft-07/schema": true,
+}
+
+// Validator holds compiled schemas, keyed by fully-qualified proto name:
+type Validator struct {
+	schemas map[string]*gojsonschema.Schema
+}
+
+// ValidationError is returned when a JSON payload doesn't satisfy its schema:
+type ValidationError struct {
+	FullName string
+	Errors   []string
+}
+
+// Error returns a summary of every validation failure:
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("JSON does not validate against %s: %s", e.FullName, strings.Join(e.Errors, "; "))
+}
+
+// Load reads the generated index from a directory, and compiles every schema it lists. Schemas are loaded by their
+// file:// URLs (with one loader), so references between them (eg to the shared schema of shared_messages) resolve.
+// Only drafts 04, 06 and 07 are supported: schemas generated for later drafts (with the "schema_version" parameter set to
+// 2019-09 or 2020-12) fail to load, rather than being validated by rules they weren't written for:
+func Load(directory string) (*Validator, error) {
+	indexJSON, err := ioutil.ReadFile(filepath.Join(directory, IndexFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]string)
+	if err := json.Unmarshal(indexJSON, &index); err != nil {
+		return nil, fmt.Errorf("unable to parse schema index: %v", err)
+	}
+
+	validator := &Validator{
+		schemas: make(map[string]*gojsonschema.Schema, len(index)),
+	}
+	schemaLoader := gojsonschema.NewSchemaLoader()
+	for fullName, schemaFileName := range index {
+		schemaFilePath := filepath.Join(directory, filepath.FromSlash(schemaFileName))
+		if err := checkSchemaVersion(schemaFilePath); err != nil {
+			return nil, fmt.Errorf("unable to compile schema %s (%s): %v", fullName, schemaFileName, err)
+		}
+		schemaURL, err := fileURL(schemaFilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		schema, err := schemaLoader.Compile(gojsonschema.NewReferenceLoader(schemaURL))
+		if err != nil {
+			return nil, fmt.Errorf("unable to compile schema %s (%s): %v", fullName, schemaFileName, err)
+		}
+		validator.schemas[fullName] = schema
+	}
+
+	return validator, nil
+}
+
+// checkSchemaVersion makes sure that a schema was written for a draft we can validate against (those without a $schema
+// are left for gojsonschema to work out):
+func checkSchemaVersion(fileName string) error {
+	schemaJSON, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	var schema struct {
+		Version string `json:"$schema"`
+	}
+	if err := json.Unmarshal(schemaJSON, &schema); err != nil {
+		return err
+	}
+	if schema.Version != "" && !supportedVersions[strings.TrimSuffix(schema.Version, "#")] {
+		return fmt.Errorf("unsupported $schema %s (only drafts 04, 06 and 07 can be validated)", schema.Version)
+	}
+	return nil
+}
+
+// fileURL returns the file:// URL of a file (which the references in its schema are resolved against):
+func fileURL(fileName string) (string, error) {
+	absFileName, err := filepath.Abs(fileName)
+	if err != nil {
+		return "", err
+	}
+
+	// Windows paths (eg C:\schemas) need a leading slash too:
+	urlPath := filepath.ToSlash(absFileName)
+	if !strings.HasPrefix(urlPath, "/") {
+		urlPath = "/" + urlPath
+	}
+	return (&url.URL{Scheme: "file", Path: urlPath}).String(), nil
+}
+
+// Validate checks a JSON payload against the schema for the given fully-qualified proto name (eg "samples.PayloadMessage"):
+func (v *Validator) Validate(fullName string, json []byte) error {
+	schema, ok := v.schemas[strings.TrimPrefix(fullName, ".")]
+	if !ok {
+		return fmt.Errorf("no schema found for %s", fullName)
+	}
+
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(json))
+	if err != nil {
+		return err
+	}
+
+	if !result.Valid() {
+		validationError := &ValidationError{FullName: fullName}
+		for _, resultError := range result.Errors() {
+			validationError.Errors = append(validationError.Errors, resultError.String())
+		}
+		return validationError
+	}
+
+	return nil
+}
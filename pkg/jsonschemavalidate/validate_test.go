@@ -0,0 +1,131 @@
+package jsonschemavalidate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testIndex  = `{"samples.PayloadMessage": "samples/PayloadMessage.json"}`
+	testSchema = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/PayloadMessage",
+    "definitions": {
+        "PayloadMessage": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": false,
+            "type": "object"
+        }
+    }
+}`
+	testSharedIndex  = `{"samples.NestedMessage": "samples/NestedMessage.json"}`
+	testSharedSchema = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "$ref": "#/definitions/NestedMessage",
+    "definitions": {
+        "NestedMessage": {
+            "properties": {
+                "payload": {
+                    "$ref": "../common.json#/definitions/samples.PayloadMessage"
+                }
+            },
+            "type": "object"
+        }
+    }
+}`
+	testCommonSchema = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "definitions": {
+        "samples.PayloadMessage": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": false,
+            "type": "object"
+        }
+    }
+}`
+)
+
+func TestValidate(t *testing.T) {
+
+	// Write an index and schema to a temporary directory:
+	directory, err := ioutil.TempDir("", "jsonschemavalidate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+	assert.NoError(t, os.MkdirAll(filepath.Join(directory, "samples"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, IndexFileName), []byte(testIndex), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, "samples", "PayloadMessage.json"), []byte(testSchema), 0644))
+
+	// Load the schemas:
+	validator, err := Load(directory)
+	assert.NoError(t, err)
+
+	// Valid payloads pass:
+	assert.NoError(t, validator.Validate("samples.PayloadMessage", []byte(`{"name": "something"}`)))
+	assert.NoError(t, validator.Validate(".samples.PayloadMessage", []byte(`{}`)))
+
+	// Invalid payloads fail with a ValidationError:
+	err = validator.Validate("samples.PayloadMessage", []byte(`{"name": 12345}`))
+	assert.Error(t, err)
+	_, isValidationError := err.(*ValidationError)
+	assert.True(t, isValidationError)
+
+	// Unknown messages fail:
+	assert.Error(t, validator.Validate("samples.Unknown", []byte(`{}`)))
+}
+
+func TestValidateSharedMessages(t *testing.T) {
+
+	// Write an index, a schema and the shared schema it refers to (as shared_messages generates them) to a temporary directory:
+	directory, err := ioutil.TempDir("", "jsonschemavalidate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+	assert.NoError(t, os.MkdirAll(filepath.Join(directory, "samples"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, IndexFileName), []byte(testSharedIndex), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, "samples", "NestedMessage.json"), []byte(testSharedSchema), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, "common.json"), []byte(testCommonSchema), 0644))
+
+	// References to the shared schema resolve:
+	validator, err := Load(directory)
+	assert.NoError(t, err)
+	assert.NoError(t, validator.Validate("samples.NestedMessage", []byte(`{"payload": {"name": "something"}}`)))
+	assert.Error(t, validator.Validate("samples.NestedMessage", []byte(`{"payload": {"name": 12345}}`)))
+	assert.Error(t, validator.Validate("samples.NestedMessage", []byte(`{"payload": {"unknown": true}}`)))
+}
+
+func TestValidateUnsupportedDrafts(t *testing.T) {
+	directory, err := ioutil.TempDir("", "jsonschemavalidate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+	assert.NoError(t, os.MkdirAll(filepath.Join(directory, "samples"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, IndexFileName), []byte(testIndex), 0644))
+
+	// Drafts 06 and 07 load just as 04 does:
+	for _, version := range []string{"http://json-schema.org/draft-06/schema#", "http://json-schema.org/draft-07/schema#"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, "samples", "PayloadMessage.json"), []byte(strings.Replace(testSchema, "http://json-schema.org/draft-04/schema#", version, 1)), 0644))
+		validator, err := Load(directory)
+		assert.NoError(t, err, version)
+		assert.NoError(t, validator.Validate("samples.PayloadMessage", []byte(`{"name": "something"}`)))
+	}
+
+	// Later drafts fail to load, saying why:
+	for _, version := range []string{"https://json-schema.org/draft/2019-09/schema", "https://json-schema.org/draft/2020-12/schema"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, "samples", "PayloadMessage.json"), []byte(strings.Replace(testSchema, "http://json-schema.org/draft-04/schema#", version, 1)), 0644))
+		_, err := Load(directory)
+		if assert.Error(t, err, version) {
+			assert.Contains(t, err.Error(), "unsupported $schema "+version)
+		}
+	}
+}